@@ -143,23 +143,121 @@ func (PredefinedPeriod) EnumDescriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{1}
 }
 
-type MarketsBalancesRequest struct {
+type AlertRuleType int32
+
+const (
+	AlertRuleType_ALERT_RULE_TYPE_NULL AlertRuleType = 0
+	// balance of asset drops below threshold
+	AlertRuleType_ALERT_RULE_TYPE_BALANCE_BELOW AlertRuleType = 1
+	// quote price moves more than threshold percent in window_minutes
+	AlertRuleType_ALERT_RULE_TYPE_PRICE_CHANGE AlertRuleType = 2
+	// market becomes inactive
+	AlertRuleType_ALERT_RULE_TYPE_MARKET_INACTIVE AlertRuleType = 3
+)
+
+// Enum value maps for AlertRuleType.
+var (
+	AlertRuleType_name = map[int32]string{
+		0: "ALERT_RULE_TYPE_NULL",
+		1: "ALERT_RULE_TYPE_BALANCE_BELOW",
+		2: "ALERT_RULE_TYPE_PRICE_CHANGE",
+		3: "ALERT_RULE_TYPE_MARKET_INACTIVE",
+	}
+	AlertRuleType_value = map[string]int32{
+		"ALERT_RULE_TYPE_NULL":            0,
+		"ALERT_RULE_TYPE_BALANCE_BELOW":   1,
+		"ALERT_RULE_TYPE_PRICE_CHANGE":    2,
+		"ALERT_RULE_TYPE_MARKET_INACTIVE": 3,
+	}
+)
+
+func (x AlertRuleType) Enum() *AlertRuleType {
+	p := new(AlertRuleType)
+	*p = x
+	return p
+}
+
+func (x AlertRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tdexa_v1_analytics_proto_enumTypes[2].Descriptor()
+}
+
+func (AlertRuleType) Type() protoreflect.EnumType {
+	return &file_tdexa_v1_analytics_proto_enumTypes[2]
+}
+
+func (x AlertRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRuleType.Descriptor instead.
+func (AlertRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+type AlertAsset int32
+
+const (
+	AlertAsset_ALERT_ASSET_NULL  AlertAsset = 0
+	AlertAsset_ALERT_ASSET_BASE  AlertAsset = 1
+	AlertAsset_ALERT_ASSET_QUOTE AlertAsset = 2
+)
+
+// Enum value maps for AlertAsset.
+var (
+	AlertAsset_name = map[int32]string{
+		0: "ALERT_ASSET_NULL",
+		1: "ALERT_ASSET_BASE",
+		2: "ALERT_ASSET_QUOTE",
+	}
+	AlertAsset_value = map[string]int32{
+		"ALERT_ASSET_NULL":  0,
+		"ALERT_ASSET_BASE":  1,
+		"ALERT_ASSET_QUOTE": 2,
+	}
+)
+
+func (x AlertAsset) Enum() *AlertAsset {
+	p := new(AlertAsset)
+	*p = x
+	return p
+}
+
+func (x AlertAsset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertAsset) Descriptor() protoreflect.EnumDescriptor {
+	return file_tdexa_v1_analytics_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertAsset) Type() protoreflect.EnumType {
+	return &file_tdexa_v1_analytics_proto_enumTypes[3]
+}
+
+func (x AlertAsset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertAsset.Descriptor instead.
+func (AlertAsset) EnumDescriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+type SubscribeMarketsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// time_range fetch balances from time range
-	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	// fetch balances for specific one or more market's, if no market_id is passed balances will be fetched for all
-	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
-	// pagination
-	Page *Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
-	// used to group balances by time_frame for the specified time_range
-	TimeFrame TimeFrame `protobuf:"varint,4,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+	// stream updates of specific one or more market's, if no market_id is passed updates of all markets are streamed
+	MarketIds []string `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
 }
 
-func (x *MarketsBalancesRequest) Reset() {
-	*x = MarketsBalancesRequest{}
+func (x *SubscribeMarketsRequest) Reset() {
+	*x = SubscribeMarketsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,13 +265,13 @@ func (x *MarketsBalancesRequest) Reset() {
 	}
 }
 
-func (x *MarketsBalancesRequest) String() string {
+func (x *SubscribeMarketsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsBalancesRequest) ProtoMessage() {}
+func (*SubscribeMarketsRequest) ProtoMessage() {}
 
-func (x *MarketsBalancesRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeMarketsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -185,50 +283,33 @@ func (x *MarketsBalancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketsBalancesRequest.ProtoReflect.Descriptor instead.
-func (*MarketsBalancesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeMarketsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMarketsRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *MarketsBalancesRequest) GetTimeRange() *TimeRange {
-	if x != nil {
-		return x.TimeRange
-	}
-	return nil
-}
-
-func (x *MarketsBalancesRequest) GetMarketIds() []string {
+func (x *SubscribeMarketsRequest) GetMarketIds() []string {
 	if x != nil {
 		return x.MarketIds
 	}
 	return nil
 }
 
-func (x *MarketsBalancesRequest) GetPage() *Page {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-func (x *MarketsBalancesRequest) GetTimeFrame() TimeFrame {
-	if x != nil {
-		return x.TimeFrame
-	}
-	return TimeFrame_TF_NULL
-}
-
-type MarketsBalancesReply struct {
+type SubscribeMarketsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// returns map of market_id and its balances sorted by time ASC
-	MarketsBalances map[string]*MarketBalances `protobuf:"bytes,1,rep,name=markets_balances,json=marketsBalances,proto3" json:"markets_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// market the update refers to
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Types that are assignable to Update:
+	//	*SubscribeMarketsReply_Price
+	//	*SubscribeMarketsReply_Balance
+	Update isSubscribeMarketsReply_Update `protobuf_oneof:"update"`
 }
 
-func (x *MarketsBalancesReply) Reset() {
-	*x = MarketsBalancesReply{}
+func (x *SubscribeMarketsReply) Reset() {
+	*x = SubscribeMarketsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,13 +317,13 @@ func (x *MarketsBalancesReply) Reset() {
 	}
 }
 
-func (x *MarketsBalancesReply) String() string {
+func (x *SubscribeMarketsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsBalancesReply) ProtoMessage() {}
+func (*SubscribeMarketsReply) ProtoMessage() {}
 
-func (x *MarketsBalancesReply) ProtoReflect() protoreflect.Message {
+func (x *SubscribeMarketsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,28 +335,67 @@ func (x *MarketsBalancesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketsBalancesReply.ProtoReflect.Descriptor instead.
-func (*MarketsBalancesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeMarketsReply.ProtoReflect.Descriptor instead.
+func (*SubscribeMarketsReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MarketsBalancesReply) GetMarketsBalances() map[string]*MarketBalances {
+func (x *SubscribeMarketsReply) GetMarketId() string {
 	if x != nil {
-		return x.MarketsBalances
+		return x.MarketId
+	}
+	return ""
+}
+
+func (m *SubscribeMarketsReply) GetUpdate() isSubscribeMarketsReply_Update {
+	if m != nil {
+		return m.Update
 	}
 	return nil
 }
 
-type MarketBalances struct {
+func (x *SubscribeMarketsReply) GetPrice() *MarketPrice {
+	if x, ok := x.GetUpdate().(*SubscribeMarketsReply_Price); ok {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SubscribeMarketsReply) GetBalance() *MarketBalance {
+	if x, ok := x.GetUpdate().(*SubscribeMarketsReply_Balance); ok {
+		return x.Balance
+	}
+	return nil
+}
+
+type isSubscribeMarketsReply_Update interface {
+	isSubscribeMarketsReply_Update()
+}
+
+type SubscribeMarketsReply_Price struct {
+	// recorded price, reference prices are not set
+	Price *MarketPrice `protobuf:"bytes,2,opt,name=price,proto3,oneof"`
+}
+
+type SubscribeMarketsReply_Balance struct {
+	// recorded balance
+	Balance *MarketBalance `protobuf:"bytes,3,opt,name=balance,proto3,oneof"`
+}
+
+func (*SubscribeMarketsReply_Price) isSubscribeMarketsReply_Update() {}
+
+func (*SubscribeMarketsReply_Balance) isSubscribeMarketsReply_Update() {}
+
+type CreateAlertRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketBalance []*MarketBalance `protobuf:"bytes,1,rep,name=market_balance,json=marketBalance,proto3" json:"market_balance,omitempty"`
+	Rule *AlertRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *MarketBalances) Reset() {
-	*x = MarketBalances{}
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -283,13 +403,13 @@ func (x *MarketBalances) Reset() {
 	}
 }
 
-func (x *MarketBalances) String() string {
+func (x *CreateAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketBalances) ProtoMessage() {}
+func (*CreateAlertRuleRequest) ProtoMessage() {}
 
-func (x *MarketBalances) ProtoReflect() protoreflect.Message {
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,33 +421,29 @@ func (x *MarketBalances) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketBalances.ProtoReflect.Descriptor instead.
-func (*MarketBalances) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MarketBalances) GetMarketBalance() []*MarketBalance {
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
 	if x != nil {
-		return x.MarketBalance
+		return x.Rule
 	}
 	return nil
 }
 
-type MarketBalance struct {
+type CreateAlertRuleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// base balance
-	BaseBalance float64 `protobuf:"fixed64,1,opt,name=base_balance,json=baseBalance,proto3" json:"base_balance,omitempty"`
-	// quote balance
-	QuoteBalance float64 `protobuf:"fixed64,2,opt,name=quote_balance,json=quoteBalance,proto3" json:"quote_balance,omitempty"`
-	// point in time when market had this balance
-	Time string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// id of the created rule
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MarketBalance) Reset() {
-	*x = MarketBalance{}
+func (x *CreateAlertRuleReply) Reset() {
+	*x = CreateAlertRuleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +451,13 @@ func (x *MarketBalance) Reset() {
 	}
 }
 
-func (x *MarketBalance) String() string {
+func (x *CreateAlertRuleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketBalance) ProtoMessage() {}
+func (*CreateAlertRuleReply) ProtoMessage() {}
 
-func (x *MarketBalance) ProtoReflect() protoreflect.Message {
+func (x *CreateAlertRuleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,51 +469,29 @@ func (x *MarketBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketBalance.ProtoReflect.Descriptor instead.
-func (*MarketBalance) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAlertRuleReply.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MarketBalance) GetBaseBalance() float64 {
-	if x != nil {
-		return x.BaseBalance
-	}
-	return 0
-}
-
-func (x *MarketBalance) GetQuoteBalance() float64 {
+func (x *CreateAlertRuleReply) GetId() int64 {
 	if x != nil {
-		return x.QuoteBalance
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MarketBalance) GetTime() string {
-	if x != nil {
-		return x.Time
-	}
-	return ""
-}
-
-type MarketsPricesRequest struct {
+type UpdateAlertRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// time_range fetch prices for time range
-	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	// fetch prices for specific one or more market's, if no market_id is passed balances will be fetched for all
-	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
-	// reference fiat currency to which base and quote will be converted
-	ReferenceCurrency string `protobuf:"bytes,3,opt,name=reference_currency,json=referenceCurrency,proto3" json:"reference_currency,omitempty"`
-	// pagination. Leave empty to return all
-	Page *Page `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
-	// used to group balances by time_frame for the specified time_range
-	TimeFrame TimeFrame `protobuf:"varint,5,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+	// rule to be replaced, identified by its id
+	Rule *AlertRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *MarketsPricesRequest) Reset() {
-	*x = MarketsPricesRequest{}
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,13 +499,13 @@ func (x *MarketsPricesRequest) Reset() {
 	}
 }
 
-func (x *MarketsPricesRequest) String() string {
+func (x *UpdateAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsPricesRequest) ProtoMessage() {}
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
 
-func (x *MarketsPricesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,59 +517,26 @@ func (x *MarketsPricesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketsPricesRequest.ProtoReflect.Descriptor instead.
-func (*MarketsPricesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *MarketsPricesRequest) GetTimeRange() *TimeRange {
-	if x != nil {
-		return x.TimeRange
-	}
-	return nil
-}
-
-func (x *MarketsPricesRequest) GetMarketIds() []string {
-	if x != nil {
-		return x.MarketIds
-	}
-	return nil
-}
-
-func (x *MarketsPricesRequest) GetReferenceCurrency() string {
-	if x != nil {
-		return x.ReferenceCurrency
-	}
-	return ""
-}
-
-func (x *MarketsPricesRequest) GetPage() *Page {
+func (x *UpdateAlertRuleRequest) GetRule() *AlertRule {
 	if x != nil {
-		return x.Page
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *MarketsPricesRequest) GetTimeFrame() TimeFrame {
-	if x != nil {
-		return x.TimeFrame
-	}
-	return TimeFrame_TF_NULL
-}
-
-type MarketsPricesReply struct {
+type UpdateAlertRuleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// returns map of market_id and its prices sorted by time ASC
-	MarketsPrices map[string]*MarketPrices `protobuf:"bytes,1,rep,name=markets_prices,json=marketsPrices,proto3" json:"markets_prices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// average price
-	AveragePrices []*AveragePrice `protobuf:"bytes,2,rep,name=average_prices,json=averagePrices,proto3" json:"average_prices,omitempty"`
 }
 
-func (x *MarketsPricesReply) Reset() {
-	*x = MarketsPricesReply{}
+func (x *UpdateAlertRuleReply) Reset() {
+	*x = UpdateAlertRuleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,13 +544,13 @@ func (x *MarketsPricesReply) Reset() {
 	}
 }
 
-func (x *MarketsPricesReply) String() string {
+func (x *UpdateAlertRuleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsPricesReply) ProtoMessage() {}
+func (*UpdateAlertRuleReply) ProtoMessage() {}
 
-func (x *MarketsPricesReply) ProtoReflect() protoreflect.Message {
+func (x *UpdateAlertRuleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,36 +562,21 @@ func (x *MarketsPricesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketsPricesReply.ProtoReflect.Descriptor instead.
-func (*MarketsPricesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAlertRuleReply.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MarketsPricesReply) GetMarketsPrices() map[string]*MarketPrices {
-	if x != nil {
-		return x.MarketsPrices
-	}
-	return nil
-}
-
-func (x *MarketsPricesReply) GetAveragePrices() []*AveragePrice {
-	if x != nil {
-		return x.AveragePrices
-	}
-	return nil
-}
-
-type MarketPrices struct {
+type DeleteAlertRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// returns list of Market's and their prices
-	MarketPrice []*MarketPrice `protobuf:"bytes,1,rep,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MarketPrices) Reset() {
-	*x = MarketPrices{}
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,13 +584,13 @@ func (x *MarketPrices) Reset() {
 	}
 }
 
-func (x *MarketPrices) String() string {
+func (x *DeleteAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketPrices) ProtoMessage() {}
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
 
-func (x *MarketPrices) ProtoReflect() protoreflect.Message {
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,37 +602,26 @@ func (x *MarketPrices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketPrices.ProtoReflect.Descriptor instead.
-func (*MarketPrices) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MarketPrices) GetMarketPrice() []*MarketPrice {
+func (x *DeleteAlertRuleRequest) GetId() int64 {
 	if x != nil {
-		return x.MarketPrice
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type MarketPrice struct {
+type DeleteAlertRuleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// base is the price of one unit of the base asset, priced in quote asset assuming the trade amount was, ie. 100 unit of that asset
-	BasePrice float64 `protobuf:"fixed64,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	// quote amount
-	QuotePrice float64 `protobuf:"fixed64,2,opt,name=quote_price,json=quotePrice,proto3" json:"quote_price,omitempty"`
-	// base price converted to reference one
-	BaseReferencePrice float64 `protobuf:"fixed64,3,opt,name=base_reference_price,json=baseReferencePrice,proto3" json:"base_reference_price,omitempty"`
-	// quote price converted to reference one
-	QuoteReferencePrice float64 `protobuf:"fixed64,4,opt,name=quote_reference_price,json=quoteReferencePrice,proto3" json:"quote_reference_price,omitempty"`
-	// point in time when market had this price
-	Time string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
-}
-
-func (x *MarketPrice) Reset() {
-	*x = MarketPrice{}
+func (x *DeleteAlertRuleReply) Reset() {
+	*x = DeleteAlertRuleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -594,13 +629,13 @@ func (x *MarketPrice) Reset() {
 	}
 }
 
-func (x *MarketPrice) String() string {
+func (x *DeleteAlertRuleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketPrice) ProtoMessage() {}
+func (*DeleteAlertRuleReply) ProtoMessage() {}
 
-func (x *MarketPrice) ProtoReflect() protoreflect.Message {
+func (x *DeleteAlertRuleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,61 +647,22 @@ func (x *MarketPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
-func (*MarketPrice) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAlertRuleReply.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MarketPrice) GetBasePrice() float64 {
-	if x != nil {
-		return x.BasePrice
-	}
-	return 0
-}
-
-func (x *MarketPrice) GetQuotePrice() float64 {
-	if x != nil {
-		return x.QuotePrice
-	}
-	return 0
-}
-
-func (x *MarketPrice) GetBaseReferencePrice() float64 {
-	if x != nil {
-		return x.BaseReferencePrice
-	}
-	return 0
-}
-
-func (x *MarketPrice) GetQuoteReferencePrice() float64 {
-	if x != nil {
-		return x.QuoteReferencePrice
-	}
-	return 0
-}
-
-func (x *MarketPrice) GetTime() string {
-	if x != nil {
-		return x.Time
-	}
-	return ""
-}
-
-type AveragePrice struct {
+type ListAlertRulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// market_ids for which average price is calculated
+	// rules of specific one or more market's, if no market_id is passed rules of all markets are returned
 	MarketIds []string `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
-	// average price for the specified time_frame
-	AveragePrice float64 `protobuf:"fixed64,6,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
-	// average price converted to reference one
-	AverageReferencePrice float64 `protobuf:"fixed64,7,opt,name=average_reference_price,json=averageReferencePrice,proto3" json:"average_reference_price,omitempty"`
 }
 
-func (x *AveragePrice) Reset() {
-	*x = AveragePrice{}
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +670,13 @@ func (x *AveragePrice) Reset() {
 	}
 }
 
-func (x *AveragePrice) String() string {
+func (x *ListAlertRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AveragePrice) ProtoMessage() {}
+func (*ListAlertRulesRequest) ProtoMessage() {}
 
-func (x *AveragePrice) ProtoReflect() protoreflect.Message {
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,47 +688,29 @@ func (x *AveragePrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AveragePrice.ProtoReflect.Descriptor instead.
-func (*AveragePrice) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AveragePrice) GetMarketIds() []string {
+func (x *ListAlertRulesRequest) GetMarketIds() []string {
 	if x != nil {
 		return x.MarketIds
 	}
 	return nil
 }
 
-func (x *AveragePrice) GetAveragePrice() float64 {
-	if x != nil {
-		return x.AveragePrice
-	}
-	return 0
-}
-
-func (x *AveragePrice) GetAverageReferencePrice() float64 {
-	if x != nil {
-		return x.AverageReferencePrice
-	}
-	return 0
-}
-
-// TimeRange is flexible type used to determine time span for which specific
-// api will fetch data, either one of predefined_period or custom_period should be provided.
-type TimeRange struct {
+type ListAlertRulesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// predefined time_period till now
-	PredefinedPeriod PredefinedPeriod `protobuf:"varint,1,opt,name=predefined_period,json=predefinedPeriod,proto3,enum=tdexa.v1.PredefinedPeriod" json:"predefined_period,omitempty"`
-	// granular time range
-	CustomPeriod *CustomPeriod `protobuf:"bytes,2,opt,name=custom_period,json=customPeriod,proto3" json:"custom_period,omitempty"`
+	// rules sorted by id
+	Rules []*AlertRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *TimeRange) Reset() {
-	*x = TimeRange{}
+func (x *ListAlertRulesReply) Reset() {
+	*x = ListAlertRulesReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +718,13 @@ func (x *TimeRange) Reset() {
 	}
 }
 
-func (x *TimeRange) String() string {
+func (x *ListAlertRulesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRange) ProtoMessage() {}
+func (*ListAlertRulesReply) ProtoMessage() {}
 
-func (x *TimeRange) ProtoReflect() protoreflect.Message {
+func (x *ListAlertRulesReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,38 +736,30 @@ func (x *TimeRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
-func (*TimeRange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAlertRulesReply.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TimeRange) GetPredefinedPeriod() PredefinedPeriod {
-	if x != nil {
-		return x.PredefinedPeriod
-	}
-	return PredefinedPeriod_NULL
-}
-
-func (x *TimeRange) GetCustomPeriod() *CustomPeriod {
+func (x *ListAlertRulesReply) GetRules() []*AlertRule {
 	if x != nil {
-		return x.CustomPeriod
+		return x.Rules
 	}
 	return nil
 }
 
-type CustomPeriod struct {
+type ListAlertDeliveriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// start_date in RFC3339 format
-	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	// end_date in RFC3339 format
-	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RuleId int64 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// pagination
+	Page *Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *CustomPeriod) Reset() {
-	*x = CustomPeriod{}
+func (x *ListAlertDeliveriesRequest) Reset() {
+	*x = ListAlertDeliveriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,13 +767,13 @@ func (x *CustomPeriod) Reset() {
 	}
 }
 
-func (x *CustomPeriod) String() string {
+func (x *ListAlertDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomPeriod) ProtoMessage() {}
+func (*ListAlertDeliveriesRequest) ProtoMessage() {}
 
-func (x *CustomPeriod) ProtoReflect() protoreflect.Message {
+func (x *ListAlertDeliveriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,36 +785,36 @@ func (x *CustomPeriod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomPeriod.ProtoReflect.Descriptor instead.
-func (*CustomPeriod) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAlertDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertDeliveriesRequest) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CustomPeriod) GetStartDate() string {
+func (x *ListAlertDeliveriesRequest) GetRuleId() int64 {
 	if x != nil {
-		return x.StartDate
+		return x.RuleId
 	}
-	return ""
+	return 0
 }
 
-func (x *CustomPeriod) GetEndDate() string {
+func (x *ListAlertDeliveriesRequest) GetPage() *Page {
 	if x != nil {
-		return x.EndDate
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type ListMarketsRequest struct {
+type ListAlertDeliveriesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketProviders []*MarketProvider `protobuf:"bytes,1,rep,name=market_providers,json=marketProviders,proto3" json:"market_providers,omitempty"`
-	Page            *Page             `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// deliveries sorted by time DESC
+	Deliveries []*AlertDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 }
 
-func (x *ListMarketsRequest) Reset() {
-	*x = ListMarketsRequest{}
+func (x *ListAlertDeliveriesReply) Reset() {
+	*x = ListAlertDeliveriesReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,13 +822,13 @@ func (x *ListMarketsRequest) Reset() {
 	}
 }
 
-func (x *ListMarketsRequest) String() string {
+func (x *ListAlertDeliveriesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketsRequest) ProtoMessage() {}
+func (*ListAlertDeliveriesReply) ProtoMessage() {}
 
-func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAlertDeliveriesReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -870,35 +840,46 @@ func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
-func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAlertDeliveriesReply.ProtoReflect.Descriptor instead.
+func (*ListAlertDeliveriesReply) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListMarketsRequest) GetMarketProviders() []*MarketProvider {
-	if x != nil {
-		return x.MarketProviders
-	}
-	return nil
-}
-
-func (x *ListMarketsRequest) GetPage() *Page {
+func (x *ListAlertDeliveriesReply) GetDeliveries() []*AlertDelivery {
 	if x != nil {
-		return x.Page
+		return x.Deliveries
 	}
 	return nil
 }
 
-type ListMarketsReply struct {
+type AlertRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Markets []*MarketIDInfo `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
-}
-
-func (x *ListMarketsReply) Reset() {
-	*x = ListMarketsReply{}
+	// set by the server on creation
+	Id       int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketId string        `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Type     AlertRuleType `protobuf:"varint,3,opt,name=type,proto3,enum=tdexa.v1.AlertRuleType" json:"type,omitempty"`
+	// asset whose balance is checked, only for balance alerts
+	Asset AlertAsset `protobuf:"varint,4,opt,name=asset,proto3,enum=tdexa.v1.AlertAsset" json:"asset,omitempty"`
+	// satoshis for balance alerts, quote price change in percent for price alerts
+	Threshold float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// window over which price change is measured, only for price alerts
+	WindowMinutes int64 `protobuf:"varint,6,opt,name=window_minutes,json=windowMinutes,proto3" json:"window_minutes,omitempty"`
+	// url the alert is posted to
+	WebhookUrl string `protobuf:"bytes,7,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// secret used to sign webhooks with HMAC-SHA256 in the X-Tdexa-Signature
+	// header, write-only: it is never returned and, on update, the current one
+	// is kept if empty
+	Secret string `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	// whether the condition was met last time the rule was evaluated
+	Triggered bool   `protobuf:"varint,9,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -906,13 +887,13 @@ func (x *ListMarketsReply) Reset() {
 	}
 }
 
-func (x *ListMarketsReply) String() string {
+func (x *AlertRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketsReply) ProtoMessage() {}
+func (*AlertRule) ProtoMessage() {}
 
-func (x *ListMarketsReply) ProtoReflect() protoreflect.Message {
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,101 +905,118 @@ func (x *ListMarketsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketsReply.ProtoReflect.Descriptor instead.
-func (*ListMarketsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
 	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListMarketsReply) GetMarkets() []*MarketIDInfo {
+func (x *AlertRule) GetId() int64 {
 	if x != nil {
-		return x.Markets
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type MarketIDInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AlertRule) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
 
-	Id             uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MarketProvider *MarketProvider `protobuf:"bytes,2,opt,name=market_provider,json=marketProvider,proto3" json:"market_provider,omitempty"`
+func (x *AlertRule) GetType() AlertRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertRuleType_ALERT_RULE_TYPE_NULL
 }
 
-func (x *MarketIDInfo) Reset() {
-	*x = MarketIDInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tdexa_v1_analytics_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AlertRule) GetAsset() AlertAsset {
+	if x != nil {
+		return x.Asset
 	}
+	return AlertAsset_ALERT_ASSET_NULL
 }
 
-func (x *MarketIDInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
 }
 
-func (*MarketIDInfo) ProtoMessage() {}
+func (x *AlertRule) GetWindowMinutes() int64 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
 
-func (x *MarketIDInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_tdexa_v1_analytics_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AlertRule) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MarketIDInfo.ProtoReflect.Descriptor instead.
-func (*MarketIDInfo) Descriptor() ([]byte, []int) {
-	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{13}
+func (x *AlertRule) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
 }
 
-func (x *MarketIDInfo) GetId() uint64 {
+func (x *AlertRule) GetTriggered() bool {
 	if x != nil {
-		return x.Id
+		return x.Triggered
 	}
-	return 0
+	return false
 }
 
-func (x *MarketIDInfo) GetMarketProvider() *MarketProvider {
+func (x *AlertRule) GetCreatedAt() string {
 	if x != nil {
-		return x.MarketProvider
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type MarketProvider struct {
+type AlertDelivery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	BaseAsset  string `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
-	QuoteAsset string `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
-	Active     bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-}
-
-func (x *MarketProvider) Reset() {
-	*x = MarketProvider{}
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId int64 `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// json body of the webhook
+	Payload string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// number of attempts made to deliver the webhook
+	Attempts int64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// http status of the last attempt
+	StatusCode int64 `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// error of the last attempt, empty if delivered
+	Error     string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Delivered bool   `protobuf:"varint,7,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// point in time when delivery completed or was given up
+	Time string `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *AlertDelivery) Reset() {
+	*x = AlertDelivery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdexa_v1_analytics_proto_msgTypes[14]
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketProvider) String() string {
+func (x *AlertDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketProvider) ProtoMessage() {}
+func (*AlertDelivery) ProtoMessage() {}
 
-func (x *MarketProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_tdexa_v1_analytics_proto_msgTypes[14]
+func (x *AlertDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,50 +1027,137 @@ func (x *MarketProvider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketProvider.ProtoReflect.Descriptor instead.
-func (*MarketProvider) Descriptor() ([]byte, []int) {
-	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AlertDelivery.ProtoReflect.Descriptor instead.
+func (*AlertDelivery) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MarketProvider) GetUrl() string {
+func (x *AlertDelivery) GetId() int64 {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MarketProvider) GetBaseAsset() string {
+func (x *AlertDelivery) GetRuleId() int64 {
 	if x != nil {
-		return x.BaseAsset
+		return x.RuleId
 	}
-	return ""
+	return 0
 }
 
-func (x *MarketProvider) GetQuoteAsset() string {
+func (x *AlertDelivery) GetPayload() string {
 	if x != nil {
-		return x.QuoteAsset
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *MarketProvider) GetActive() bool {
+func (x *AlertDelivery) GetAttempts() int64 {
 	if x != nil {
-		return x.Active
+		return x.Attempts
 	}
-	return false
+	return 0
 }
 
-type Page struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PageNumber int64 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize   int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+func (x *AlertDelivery) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
 }
 
-func (x *Page) Reset() {
-	*x = Page{}
+func (x *AlertDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AlertDelivery) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *AlertDelivery) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsUptimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range to measure uptime in
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// uptime of specific one or more market's, if no market_id is passed uptime of all markets is returned
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+}
+
+func (x *MarketsUptimeRequest) Reset() {
+	*x = MarketsUptimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsUptimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsUptimeRequest) ProtoMessage() {}
+
+func (x *MarketsUptimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsUptimeRequest.ProtoReflect.Descriptor instead.
+func (*MarketsUptimeRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarketsUptimeRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsUptimeRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+type MarketsUptimeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its uptime
+	MarketsUptime map[string]*MarketUptime `protobuf:"bytes,1,rep,name=markets_uptime,json=marketsUptime,proto3" json:"markets_uptime,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// returns map of provider url and its uptime, providers are up while at least one of their markets is
+	ProvidersUptime map[string]*ProviderUptime `protobuf:"bytes,2,rep,name=providers_uptime,json=providersUptime,proto3" json:"providers_uptime,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsUptimeReply) Reset() {
+	*x = MarketsUptimeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tdexa_v1_analytics_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,319 +1165,5731 @@ func (x *Page) Reset() {
 	}
 }
 
-func (x *Page) String() string {
+func (x *MarketsUptimeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Page) ProtoMessage() {}
+func (*MarketsUptimeReply) ProtoMessage() {}
 
-func (x *Page) ProtoReflect() protoreflect.Message {
+func (x *MarketsUptimeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_tdexa_v1_analytics_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Page.ProtoReflect.Descriptor instead.
-func (*Page) Descriptor() ([]byte, []int) {
-	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *Page) GetPageNumber() int64 {
-	if x != nil {
-		return x.PageNumber
-	}
-	return 0
-}
-
-func (x *Page) GetPageSize() int64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-var File_tdexa_v1_analytics_proto protoreflect.FileDescriptor
-
-var file_tdexa_v1_analytics_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x64, 0x65, 0x78,
-	0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73,
-	0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x5e, 0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x1a, 0x5c, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x50, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65, 0x78,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x22, 0x6b, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x71, 0x75,
-	0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf0,
-	0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d,
-	0x65, 0x22, 0x85, 0x02, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x56, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x3d, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a,
-	0x58, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0c, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x12, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x01,
-	0x0a, 0x0c, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
-	0x10, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x48,
-	0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
-	0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
-	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x44,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x61, 0x0a,
-	0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a,
-	0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x22, 0x7a, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x04,
-	0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x2a, 0x87, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x46, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52,
-	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x5f, 0x46, 0x4f, 0x55, 0x52, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a,
-	0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10,
-	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f,
-	0x57, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x05, 0x2a, 0x86, 0x01, 0x0a,
-	0x10, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c,
-	0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41,
-	0x53, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53, 0x54,
-	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x53, 0x54,
-	0x5f, 0x33, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x53, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x59,
-	0x45, 0x41, 0x52, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x59,
-	0x45, 0x41, 0x52, 0x10, 0x07, 0x32, 0xc0, 0x02, 0x0a, 0x09, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x64, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0xae, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
-	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2f,
-	0x76, 0x31, 0x3b, 0x74, 0x64, 0x65, 0x78, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58,
-	0xaa, 0x02, 0x08, 0x54, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x54, 0x64,
-	0x65, 0x78, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x54, 0x64, 0x65, 0x78, 0x61, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09,
-	0x54, 0x64, 0x65, 0x78, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-}
-
-var (
-	file_tdexa_v1_analytics_proto_rawDescOnce sync.Once
-	file_tdexa_v1_analytics_proto_rawDescData = file_tdexa_v1_analytics_proto_rawDesc
-)
-
-func file_tdexa_v1_analytics_proto_rawDescGZIP() []byte {
-	file_tdexa_v1_analytics_proto_rawDescOnce.Do(func() {
-		file_tdexa_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_tdexa_v1_analytics_proto_rawDescData)
-	})
-	return file_tdexa_v1_analytics_proto_rawDescData
-}
-
-var file_tdexa_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_tdexa_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_tdexa_v1_analytics_proto_goTypes = []interface{}{
-	(TimeFrame)(0),                 // 0: tdexa.v1.TimeFrame
-	(PredefinedPeriod)(0),          // 1: tdexa.v1.PredefinedPeriod
-	(*MarketsBalancesRequest)(nil), // 2: tdexa.v1.MarketsBalancesRequest
-	(*MarketsBalancesReply)(nil),   // 3: tdexa.v1.MarketsBalancesReply
-	(*MarketBalances)(nil),         // 4: tdexa.v1.MarketBalances
-	(*MarketBalance)(nil),          // 5: tdexa.v1.MarketBalance
-	(*MarketsPricesRequest)(nil),   // 6: tdexa.v1.MarketsPricesRequest
-	(*MarketsPricesReply)(nil),     // 7: tdexa.v1.MarketsPricesReply
-	(*MarketPrices)(nil),           // 8: tdexa.v1.MarketPrices
-	(*MarketPrice)(nil),            // 9: tdexa.v1.MarketPrice
-	(*AveragePrice)(nil),           // 10: tdexa.v1.AveragePrice
-	(*TimeRange)(nil),              // 11: tdexa.v1.TimeRange
-	(*CustomPeriod)(nil),           // 12: tdexa.v1.CustomPeriod
-	(*ListMarketsRequest)(nil),     // 13: tdexa.v1.ListMarketsRequest
-	(*ListMarketsReply)(nil),       // 14: tdexa.v1.ListMarketsReply
-	(*MarketIDInfo)(nil),           // 15: tdexa.v1.MarketIDInfo
-	(*MarketProvider)(nil),         // 16: tdexa.v1.MarketProvider
-	(*Page)(nil),                   // 17: tdexa.v1.Page
-	nil,                            // 18: tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry
-	nil,                            // 19: tdexa.v1.MarketsPricesReply.MarketsPricesEntry
-}
-var file_tdexa_v1_analytics_proto_depIdxs = []int32{
-	11, // 0: tdexa.v1.MarketsBalancesRequest.time_range:type_name -> tdexa.v1.TimeRange
-	17, // 1: tdexa.v1.MarketsBalancesRequest.page:type_name -> tdexa.v1.Page
-	0,  // 2: tdexa.v1.MarketsBalancesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
-	18, // 3: tdexa.v1.MarketsBalancesReply.markets_balances:type_name -> tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry
-	5,  // 4: tdexa.v1.MarketBalances.market_balance:type_name -> tdexa.v1.MarketBalance
-	11, // 5: tdexa.v1.MarketsPricesRequest.time_range:type_name -> tdexa.v1.TimeRange
-	17, // 6: tdexa.v1.MarketsPricesRequest.page:type_name -> tdexa.v1.Page
-	0,  // 7: tdexa.v1.MarketsPricesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
-	19, // 8: tdexa.v1.MarketsPricesReply.markets_prices:type_name -> tdexa.v1.MarketsPricesReply.MarketsPricesEntry
-	10, // 9: tdexa.v1.MarketsPricesReply.average_prices:type_name -> tdexa.v1.AveragePrice
-	9,  // 10: tdexa.v1.MarketPrices.market_price:type_name -> tdexa.v1.MarketPrice
-	1,  // 11: tdexa.v1.TimeRange.predefined_period:type_name -> tdexa.v1.PredefinedPeriod
-	12, // 12: tdexa.v1.TimeRange.custom_period:type_name -> tdexa.v1.CustomPeriod
-	16, // 13: tdexa.v1.ListMarketsRequest.market_providers:type_name -> tdexa.v1.MarketProvider
-	17, // 14: tdexa.v1.ListMarketsRequest.page:type_name -> tdexa.v1.Page
-	15, // 15: tdexa.v1.ListMarketsReply.markets:type_name -> tdexa.v1.MarketIDInfo
-	16, // 16: tdexa.v1.MarketIDInfo.market_provider:type_name -> tdexa.v1.MarketProvider
-	4,  // 17: tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry.value:type_name -> tdexa.v1.MarketBalances
-	8,  // 18: tdexa.v1.MarketsPricesReply.MarketsPricesEntry.value:type_name -> tdexa.v1.MarketPrices
-	2,  // 19: tdexa.v1.Analytics.MarketsBalances:input_type -> tdexa.v1.MarketsBalancesRequest
-	6,  // 20: tdexa.v1.Analytics.MarketsPrices:input_type -> tdexa.v1.MarketsPricesRequest
-	13, // 21: tdexa.v1.Analytics.ListMarkets:input_type -> tdexa.v1.ListMarketsRequest
-	3,  // 22: tdexa.v1.Analytics.MarketsBalances:output_type -> tdexa.v1.MarketsBalancesReply
-	7,  // 23: tdexa.v1.Analytics.MarketsPrices:output_type -> tdexa.v1.MarketsPricesReply
-	14, // 24: tdexa.v1.Analytics.ListMarkets:output_type -> tdexa.v1.ListMarketsReply
-	22, // [22:25] is the sub-list for method output_type
-	19, // [19:22] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
-}
-
-func init() { file_tdexa_v1_analytics_proto_init() }
-func file_tdexa_v1_analytics_proto_init() {
-	if File_tdexa_v1_analytics_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_tdexa_v1_analytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsBalancesRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsUptimeReply.ProtoReflect.Descriptor instead.
+func (*MarketsUptimeReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MarketsUptimeReply) GetMarketsUptime() map[string]*MarketUptime {
+	if x != nil {
+		return x.MarketsUptime
+	}
+	return nil
+}
+
+func (x *MarketsUptimeReply) GetProvidersUptime() map[string]*ProviderUptime {
+	if x != nil {
+		return x.ProvidersUptime
+	}
+	return nil
+}
+
+type MarketUptime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderName string  `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Url          string  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	BaseAsset    string  `protobuf:"bytes,3,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset   string  `protobuf:"bytes,4,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Uptime       *Uptime `protobuf:"bytes,5,opt,name=uptime,proto3" json:"uptime,omitempty"`
+}
+
+func (x *MarketUptime) Reset() {
+	*x = MarketUptime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketUptime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketUptime) ProtoMessage() {}
+
+func (x *MarketUptime) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketUptime.ProtoReflect.Descriptor instead.
+func (*MarketUptime) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarketUptime) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *MarketUptime) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MarketUptime) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketUptime) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketUptime) GetUptime() *Uptime {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+type ProviderUptime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderName string  `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Uptime       *Uptime `protobuf:"bytes,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+}
+
+func (x *ProviderUptime) Reset() {
+	*x = ProviderUptime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderUptime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUptime) ProtoMessage() {}
+
+func (x *ProviderUptime) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUptime.ProtoReflect.Descriptor instead.
+func (*ProviderUptime) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProviderUptime) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *ProviderUptime) GetUptime() *Uptime {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+type Uptime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// percentage of time active, measured from the later of range start and first_seen
+	UptimePercentage float64 `protobuf:"fixed64,1,opt,name=uptime_percentage,json=uptimePercentage,proto3" json:"uptime_percentage,omitempty"`
+	// windows of inactivity sorted by time ASC
+	Outages []*Outage `protobuf:"bytes,2,rep,name=outages,proto3" json:"outages,omitempty"`
+	// when the market was discovered, empty if it was known before lifecycle events were recorded
+	FirstSeen string `protobuf:"bytes,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	// last time the market was active, end of range if it was active then
+	LastSeen string `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *Uptime) Reset() {
+	*x = Uptime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Uptime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uptime) ProtoMessage() {}
+
+func (x *Uptime) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uptime.ProtoReflect.Descriptor instead.
+func (*Uptime) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Uptime) GetUptimePercentage() float64 {
+	if x != nil {
+		return x.UptimePercentage
+	}
+	return 0
+}
+
+func (x *Uptime) GetOutages() []*Outage {
+	if x != nil {
+		return x.Outages
+	}
+	return nil
+}
+
+func (x *Uptime) GetFirstSeen() string {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return ""
+}
+
+func (x *Uptime) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+type Outage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Outage) Reset() {
+	*x = Outage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Outage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outage) ProtoMessage() {}
+
+func (x *Outage) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outage.ProtoReflect.Descriptor instead.
+func (*Outage) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Outage) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Outage) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type MarketsStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status of specific one or more market's, if no market_id is passed status of all markets is returned
+	MarketIds []string `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+}
+
+func (x *MarketsStatusRequest) Reset() {
+	*x = MarketsStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsStatusRequest) ProtoMessage() {}
+
+func (x *MarketsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsStatusRequest.ProtoReflect.Descriptor instead.
+func (*MarketsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MarketsStatusRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+type MarketsStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its status
+	MarketsStatus map[string]*MarketStatus `protobuf:"bytes,1,rep,name=markets_status,json=marketsStatus,proto3" json:"markets_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// returns map of provider url and its circuit breaker, only for providers that failed since they last answered
+	ProvidersCircuitBreaker map[string]*CircuitBreaker `protobuf:"bytes,2,rep,name=providers_circuit_breaker,json=providersCircuitBreaker,proto3" json:"providers_circuit_breaker,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsStatusReply) Reset() {
+	*x = MarketsStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsStatusReply) ProtoMessage() {}
+
+func (x *MarketsStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsStatusReply.ProtoReflect.Descriptor instead.
+func (*MarketsStatusReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MarketsStatusReply) GetMarketsStatus() map[string]*MarketStatus {
+	if x != nil {
+		return x.MarketsStatus
+	}
+	return nil
+}
+
+func (x *MarketsStatusReply) GetProvidersCircuitBreaker() map[string]*CircuitBreaker {
+	if x != nil {
+		return x.ProvidersCircuitBreaker
+	}
+	return nil
+}
+
+type MarketStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderName string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Url          string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	BaseAsset    string `protobuf:"bytes,3,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset   string `protobuf:"bytes,4,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Active       bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// status of the jobs that ran for the market
+	FetchStatuses []*FetchStatus `protobuf:"bytes,6,rep,name=fetch_statuses,json=fetchStatuses,proto3" json:"fetch_statuses,omitempty"`
+}
+
+func (x *MarketStatus) Reset() {
+	*x = MarketStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStatus) ProtoMessage() {}
+
+func (x *MarketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStatus.ProtoReflect.Descriptor instead.
+func (*MarketStatus) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MarketStatus) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *MarketStatus) GetFetchStatuses() []*FetchStatus {
+	if x != nil {
+		return x.FetchStatuses
+	}
+	return nil
+}
+
+type FetchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// job fetching data of the market, price or balance
+	Job         string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	LastAttempt string `protobuf:"bytes,2,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	// empty if the job never succeeded
+	LastSuccess         string `protobuf:"bytes,3,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError           string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime       string `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	ConsecutiveFailures uint32 `protobuf:"varint,6,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// time of the first of the consecutive failures, empty if the last attempt succeeded
+	FailingSince string `protobuf:"bytes,7,opt,name=failing_since,json=failingSince,proto3" json:"failing_since,omitempty"`
+	// protocol path used by the last successful fetch, one of v2, v2/http1, v1, v1/http1, v1/preview
+	Protocol string `protobuf:"bytes,8,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// tls if the provider certificate failed verification, other otherwise,
+	// empty if the job never failed
+	LastErrorType string `protobuf:"bytes,9,opt,name=last_error_type,json=lastErrorType,proto3" json:"last_error_type,omitempty"`
+}
+
+func (x *FetchStatus) Reset() {
+	*x = FetchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchStatus) ProtoMessage() {}
+
+func (x *FetchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchStatus.ProtoReflect.Descriptor instead.
+func (*FetchStatus) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FetchStatus) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetLastAttempt() string {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetLastSuccess() string {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetLastErrorTime() string {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *FetchStatus) GetFailingSince() string {
+	if x != nil {
+		return x.FailingSince
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *FetchStatus) GetLastErrorType() string {
+	if x != nil {
+		return x.LastErrorType
+	}
+	return ""
+}
+
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one of closed, open, half-open, providers are not called while open and
+	// probed by a single call while half-open
+	State               string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ConsecutiveFailures uint32 `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError           string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// when the provider is probed again, empty if the breaker is not open
+	RetryAt string `protobuf:"bytes,4,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CircuitBreaker) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetRetryAt() string {
+	if x != nil {
+		return x.RetryAt
+	}
+	return ""
+}
+
+type LeaderStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaderStatusRequest) Reset() {
+	*x = LeaderStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderStatusRequest) ProtoMessage() {}
+
+func (x *LeaderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderStatusRequest.ProtoReflect.Descriptor instead.
+func (*LeaderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{25}
+}
+
+type LeaderStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the replica answering
+	NodeId   string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IsLeader bool   `protobuf:"varint,2,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
+	// id of the leader, empty if no replica holds the leadership
+	LeaderNodeId    string `protobuf:"bytes,3,opt,name=leader_node_id,json=leaderNodeId,proto3" json:"leader_node_id,omitempty"`
+	LeaderElectedAt string `protobuf:"bytes,4,opt,name=leader_elected_at,json=leaderElectedAt,proto3" json:"leader_elected_at,omitempty"`
+	// last time the leader confirmed it holds the leadership
+	LeaderRenewedAt string `protobuf:"bytes,5,opt,name=leader_renewed_at,json=leaderRenewedAt,proto3" json:"leader_renewed_at,omitempty"`
+}
+
+func (x *LeaderStatusReply) Reset() {
+	*x = LeaderStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderStatusReply) ProtoMessage() {}
+
+func (x *LeaderStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderStatusReply.ProtoReflect.Descriptor instead.
+func (*LeaderStatusReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LeaderStatusReply) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *LeaderStatusReply) GetIsLeader() bool {
+	if x != nil {
+		return x.IsLeader
+	}
+	return false
+}
+
+func (x *LeaderStatusReply) GetLeaderNodeId() string {
+	if x != nil {
+		return x.LeaderNodeId
+	}
+	return ""
+}
+
+func (x *LeaderStatusReply) GetLeaderElectedAt() string {
+	if x != nil {
+		return x.LeaderElectedAt
+	}
+	return ""
+}
+
+func (x *LeaderStatusReply) GetLeaderRenewedAt() string {
+	if x != nil {
+		return x.LeaderRenewedAt
+	}
+	return ""
+}
+
+type MarketsBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch balances from time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch balances for specific one or more market's, if no market_id is passed balances will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// pagination
+	Page *Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	// used to group balances by time_frame for the specified time_range
+	TimeFrame TimeFrame `protobuf:"varint,4,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsBalancesRequest) Reset() {
+	*x = MarketsBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsBalancesRequest) ProtoMessage() {}
+
+func (x *MarketsBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsBalancesRequest.ProtoReflect.Descriptor instead.
+func (*MarketsBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MarketsBalancesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsBalancesRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsBalancesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *MarketsBalancesRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsBalancesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its balances sorted by time ASC
+	MarketsBalances map[string]*MarketBalances `protobuf:"bytes,1,rep,name=markets_balances,json=marketsBalances,proto3" json:"markets_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsBalancesReply) Reset() {
+	*x = MarketsBalancesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsBalancesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsBalancesReply) ProtoMessage() {}
+
+func (x *MarketsBalancesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsBalancesReply.ProtoReflect.Descriptor instead.
+func (*MarketsBalancesReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MarketsBalancesReply) GetMarketsBalances() map[string]*MarketBalances {
+	if x != nil {
+		return x.MarketsBalances
+	}
+	return nil
+}
+
+type MarketBalances struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketBalance []*MarketBalance `protobuf:"bytes,1,rep,name=market_balance,json=marketBalance,proto3" json:"market_balance,omitempty"`
+}
+
+func (x *MarketBalances) Reset() {
+	*x = MarketBalances{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketBalances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketBalances) ProtoMessage() {}
+
+func (x *MarketBalances) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketBalances.ProtoReflect.Descriptor instead.
+func (*MarketBalances) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MarketBalances) GetMarketBalance() []*MarketBalance {
+	if x != nil {
+		return x.MarketBalance
+	}
+	return nil
+}
+
+type MarketBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base balance
+	BaseBalance float64 `protobuf:"fixed64,1,opt,name=base_balance,json=baseBalance,proto3" json:"base_balance,omitempty"`
+	// quote balance
+	QuoteBalance float64 `protobuf:"fixed64,2,opt,name=quote_balance,json=quoteBalance,proto3" json:"quote_balance,omitempty"`
+	// point in time when market had this balance
+	Time string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketBalance) Reset() {
+	*x = MarketBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketBalance) ProtoMessage() {}
+
+func (x *MarketBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketBalance.ProtoReflect.Descriptor instead.
+func (*MarketBalance) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MarketBalance) GetBaseBalance() float64 {
+	if x != nil {
+		return x.BaseBalance
+	}
+	return 0
+}
+
+func (x *MarketBalance) GetQuoteBalance() float64 {
+	if x != nil {
+		return x.QuoteBalance
+	}
+	return 0
+}
+
+func (x *MarketBalance) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch prices for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch prices for specific one or more market's, if no market_id is passed balances will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// reference fiat currency to which base and quote will be converted
+	ReferenceCurrency string `protobuf:"bytes,3,opt,name=reference_currency,json=referenceCurrency,proto3" json:"reference_currency,omitempty"`
+	// pagination. Leave empty to return all
+	Page *Page `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	// used to group balances by time_frame for the specified time_range
+	TimeFrame TimeFrame `protobuf:"varint,5,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsPricesRequest) Reset() {
+	*x = MarketsPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsPricesRequest) ProtoMessage() {}
+
+func (x *MarketsPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsPricesRequest.ProtoReflect.Descriptor instead.
+func (*MarketsPricesRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MarketsPricesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsPricesRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsPricesRequest) GetReferenceCurrency() string {
+	if x != nil {
+		return x.ReferenceCurrency
+	}
+	return ""
+}
+
+func (x *MarketsPricesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *MarketsPricesRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsPricesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its prices sorted by time ASC
+	MarketsPrices map[string]*MarketPrices `protobuf:"bytes,1,rep,name=markets_prices,json=marketsPrices,proto3" json:"markets_prices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// average price, weighted by market base balance, for volume weighted
+	// prices see vwap of MarketsVolumes
+	AveragePrices []*AveragePrice `protobuf:"bytes,2,rep,name=average_prices,json=averagePrices,proto3" json:"average_prices,omitempty"`
+}
+
+func (x *MarketsPricesReply) Reset() {
+	*x = MarketsPricesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsPricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsPricesReply) ProtoMessage() {}
+
+func (x *MarketsPricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsPricesReply.ProtoReflect.Descriptor instead.
+func (*MarketsPricesReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MarketsPricesReply) GetMarketsPrices() map[string]*MarketPrices {
+	if x != nil {
+		return x.MarketsPrices
+	}
+	return nil
+}
+
+func (x *MarketsPricesReply) GetAveragePrices() []*AveragePrice {
+	if x != nil {
+		return x.AveragePrices
+	}
+	return nil
+}
+
+type MarketPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns list of Market's and their prices
+	MarketPrice []*MarketPrice `protobuf:"bytes,1,rep,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+}
+
+func (x *MarketPrices) Reset() {
+	*x = MarketPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketPrices) ProtoMessage() {}
+
+func (x *MarketPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketPrices.ProtoReflect.Descriptor instead.
+func (*MarketPrices) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MarketPrices) GetMarketPrice() []*MarketPrice {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return nil
+}
+
+type MarketPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base is the price of one unit of the base asset, priced in quote asset assuming the trade amount was, ie. 100 unit of that asset
+	BasePrice float64 `protobuf:"fixed64,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// quote amount
+	QuotePrice float64 `protobuf:"fixed64,2,opt,name=quote_price,json=quotePrice,proto3" json:"quote_price,omitempty"`
+	// base price converted to reference one
+	BaseReferencePrice float64 `protobuf:"fixed64,3,opt,name=base_reference_price,json=baseReferencePrice,proto3" json:"base_reference_price,omitempty"`
+	// quote price converted to reference one
+	QuoteReferencePrice float64 `protobuf:"fixed64,4,opt,name=quote_reference_price,json=quoteReferencePrice,proto3" json:"quote_reference_price,omitempty"`
+	// point in time when market had this price
+	Time string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketPrice) Reset() {
+	*x = MarketPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketPrice) ProtoMessage() {}
+
+func (x *MarketPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
+func (*MarketPrice) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MarketPrice) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetQuotePrice() float64 {
+	if x != nil {
+		return x.QuotePrice
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetBaseReferencePrice() float64 {
+	if x != nil {
+		return x.BaseReferencePrice
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetQuoteReferencePrice() float64 {
+	if x != nil {
+		return x.QuoteReferencePrice
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type AveragePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// market_ids for which average price is calculated
+	MarketIds []string `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// average of quote prices recorded in the time range weighted by the base
+	// balance of the market at the same time, not by trade volume, that
+	// providers don't publish
+	AveragePrice float64 `protobuf:"fixed64,6,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	// average price converted to reference one
+	AverageReferencePrice float64 `protobuf:"fixed64,7,opt,name=average_reference_price,json=averageReferencePrice,proto3" json:"average_reference_price,omitempty"`
+}
+
+func (x *AveragePrice) Reset() {
+	*x = AveragePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AveragePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AveragePrice) ProtoMessage() {}
+
+func (x *AveragePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AveragePrice.ProtoReflect.Descriptor instead.
+func (*AveragePrice) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AveragePrice) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *AveragePrice) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *AveragePrice) GetAverageReferencePrice() float64 {
+	if x != nil {
+		return x.AverageReferencePrice
+	}
+	return 0
+}
+
+type MarketsCandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch candles for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch candles for specific one or more market's, if no market_id is passed candles will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// reference fiat currency to which candle prices will be converted
+	ReferenceCurrency string `protobuf:"bytes,3,opt,name=reference_currency,json=referenceCurrency,proto3" json:"reference_currency,omitempty"`
+	// pagination. Leave empty to return all
+	Page *Page `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	// size of the bucket every candle is built from, it must be provided
+	TimeFrame TimeFrame `protobuf:"varint,5,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsCandlesRequest) Reset() {
+	*x = MarketsCandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsCandlesRequest) ProtoMessage() {}
+
+func (x *MarketsCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsCandlesRequest.ProtoReflect.Descriptor instead.
+func (*MarketsCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MarketsCandlesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsCandlesRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsCandlesRequest) GetReferenceCurrency() string {
+	if x != nil {
+		return x.ReferenceCurrency
+	}
+	return ""
+}
+
+func (x *MarketsCandlesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *MarketsCandlesRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsCandlesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its candles sorted by time ASC
+	MarketsCandles map[string]*MarketCandles `protobuf:"bytes,1,rep,name=markets_candles,json=marketsCandles,proto3" json:"markets_candles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsCandlesReply) Reset() {
+	*x = MarketsCandlesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsCandlesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsCandlesReply) ProtoMessage() {}
+
+func (x *MarketsCandlesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsCandlesReply.ProtoReflect.Descriptor instead.
+func (*MarketsCandlesReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarketsCandlesReply) GetMarketsCandles() map[string]*MarketCandles {
+	if x != nil {
+		return x.MarketsCandles
+	}
+	return nil
+}
+
+type MarketCandles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns list of Market's candles
+	MarketCandle []*MarketCandle `protobuf:"bytes,1,rep,name=market_candle,json=marketCandle,proto3" json:"market_candle,omitempty"`
+}
+
+func (x *MarketCandles) Reset() {
+	*x = MarketCandles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketCandles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketCandles) ProtoMessage() {}
+
+func (x *MarketCandles) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketCandles.ProtoReflect.Descriptor instead.
+func (*MarketCandles) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MarketCandles) GetMarketCandle() []*MarketCandle {
+	if x != nil {
+		return x.MarketCandle
+	}
+	return nil
+}
+
+type MarketCandle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// first quote price of the time_frame bucket
+	Open float64 `protobuf:"fixed64,1,opt,name=open,proto3" json:"open,omitempty"`
+	// highest quote price of the time_frame bucket
+	High float64 `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	// lowest quote price of the time_frame bucket
+	Low float64 `protobuf:"fixed64,3,opt,name=low,proto3" json:"low,omitempty"`
+	// last quote price of the time_frame bucket
+	Close float64 `protobuf:"fixed64,4,opt,name=close,proto3" json:"close,omitempty"`
+	// open price converted to reference one
+	ReferenceOpen float64 `protobuf:"fixed64,5,opt,name=reference_open,json=referenceOpen,proto3" json:"reference_open,omitempty"`
+	// high price converted to reference one
+	ReferenceHigh float64 `protobuf:"fixed64,6,opt,name=reference_high,json=referenceHigh,proto3" json:"reference_high,omitempty"`
+	// low price converted to reference one
+	ReferenceLow float64 `protobuf:"fixed64,7,opt,name=reference_low,json=referenceLow,proto3" json:"reference_low,omitempty"`
+	// close price converted to reference one
+	ReferenceClose float64 `protobuf:"fixed64,8,opt,name=reference_close,json=referenceClose,proto3" json:"reference_close,omitempty"`
+	// start of the time_frame bucket
+	Time string `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketCandle) Reset() {
+	*x = MarketCandle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketCandle) ProtoMessage() {}
+
+func (x *MarketCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketCandle.ProtoReflect.Descriptor instead.
+func (*MarketCandle) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MarketCandle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetReferenceOpen() float64 {
+	if x != nil {
+		return x.ReferenceOpen
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetReferenceHigh() float64 {
+	if x != nil {
+		return x.ReferenceHigh
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetReferenceLow() float64 {
+	if x != nil {
+		return x.ReferenceLow
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetReferenceClose() float64 {
+	if x != nil {
+		return x.ReferenceClose
+	}
+	return 0
+}
+
+func (x *MarketCandle) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type GetTotalValueLockedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch value locked for time range, if not provided only the latest value is returned
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// used to group value locked by time_frame for the specified time_range
+	TimeFrame TimeFrame `protobuf:"varint,2,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+	// reference fiat currency in which value locked is expressed
+	ReferenceCurrency string `protobuf:"bytes,3,opt,name=reference_currency,json=referenceCurrency,proto3" json:"reference_currency,omitempty"`
+	// fetch value locked for specific one or more market's, if no market_id is passed value will be calculated for all active markets
+	MarketIds []string `protobuf:"bytes,4,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+}
+
+func (x *GetTotalValueLockedRequest) Reset() {
+	*x = GetTotalValueLockedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalValueLockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalValueLockedRequest) ProtoMessage() {}
+
+func (x *GetTotalValueLockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalValueLockedRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalValueLockedRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTotalValueLockedRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetTotalValueLockedRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+func (x *GetTotalValueLockedRequest) GetReferenceCurrency() string {
+	if x != nil {
+		return x.ReferenceCurrency
+	}
+	return ""
+}
+
+func (x *GetTotalValueLockedRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+type GetTotalValueLockedReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns value locked snapshots sorted by time ASC
+	TotalValueLocked []*TotalValueLocked `protobuf:"bytes,1,rep,name=total_value_locked,json=totalValueLocked,proto3" json:"total_value_locked,omitempty"`
+}
+
+func (x *GetTotalValueLockedReply) Reset() {
+	*x = GetTotalValueLockedReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalValueLockedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalValueLockedReply) ProtoMessage() {}
+
+func (x *GetTotalValueLockedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalValueLockedReply.ProtoReflect.Descriptor instead.
+func (*GetTotalValueLockedReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTotalValueLockedReply) GetTotalValueLocked() []*TotalValueLocked {
+	if x != nil {
+		return x.TotalValueLocked
+	}
+	return nil
+}
+
+type TotalValueLocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reference currency in which values are expressed
+	ReferenceCurrency string `protobuf:"bytes,1,opt,name=reference_currency,json=referenceCurrency,proto3" json:"reference_currency,omitempty"`
+	// network-wide value locked
+	Total float64 `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	// value locked per market
+	Markets []*MarketValueLocked `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
+	// value locked per provider
+	Providers []*ProviderValueLocked `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
+	// value locked per asset
+	Assets []*AssetValueLocked `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+	// point in time of the snapshot
+	Time string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *TotalValueLocked) Reset() {
+	*x = TotalValueLocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotalValueLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalValueLocked) ProtoMessage() {}
+
+func (x *TotalValueLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalValueLocked.ProtoReflect.Descriptor instead.
+func (*TotalValueLocked) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TotalValueLocked) GetReferenceCurrency() string {
+	if x != nil {
+		return x.ReferenceCurrency
+	}
+	return ""
+}
+
+func (x *TotalValueLocked) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TotalValueLocked) GetMarkets() []*MarketValueLocked {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *TotalValueLocked) GetProviders() []*ProviderValueLocked {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *TotalValueLocked) GetAssets() []*AssetValueLocked {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *TotalValueLocked) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketValueLocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId     string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ProviderName string `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	BaseAsset    string `protobuf:"bytes,3,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	// base amount in asset units
+	BaseAmount float64 `protobuf:"fixed64,4,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	// base amount converted to reference currency
+	BaseValue  float64 `protobuf:"fixed64,5,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	QuoteAsset string  `protobuf:"bytes,6,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// quote amount in asset units
+	QuoteAmount float64 `protobuf:"fixed64,7,opt,name=quote_amount,json=quoteAmount,proto3" json:"quote_amount,omitempty"`
+	// quote amount converted to reference currency
+	QuoteValue float64 `protobuf:"fixed64,8,opt,name=quote_value,json=quoteValue,proto3" json:"quote_value,omitempty"`
+	// base and quote value
+	Total float64 `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *MarketValueLocked) Reset() {
+	*x = MarketValueLocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketValueLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketValueLocked) ProtoMessage() {}
+
+func (x *MarketValueLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketValueLocked.ProtoReflect.Descriptor instead.
+func (*MarketValueLocked) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MarketValueLocked) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketValueLocked) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *MarketValueLocked) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketValueLocked) GetBaseAmount() float64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *MarketValueLocked) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+func (x *MarketValueLocked) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketValueLocked) GetQuoteAmount() float64 {
+	if x != nil {
+		return x.QuoteAmount
+	}
+	return 0
+}
+
+func (x *MarketValueLocked) GetQuoteValue() float64 {
+	if x != nil {
+		return x.QuoteValue
+	}
+	return 0
+}
+
+func (x *MarketValueLocked) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ProviderValueLocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderName string  `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Total        float64 `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ProviderValueLocked) Reset() {
+	*x = ProviderValueLocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderValueLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderValueLocked) ProtoMessage() {}
+
+func (x *ProviderValueLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderValueLocked.ProtoReflect.Descriptor instead.
+func (*ProviderValueLocked) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProviderValueLocked) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *ProviderValueLocked) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AssetValueLocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// amount in asset units locked across all markets
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// amount converted to reference currency
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AssetValueLocked) Reset() {
+	*x = AssetValueLocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetValueLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetValueLocked) ProtoMessage() {}
+
+func (x *AssetValueLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetValueLocked.ProtoReflect.Descriptor instead.
+func (*AssetValueLocked) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AssetValueLocked) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AssetValueLocked) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AssetValueLocked) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type MarketsVolumesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch volumes for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch volumes for specific one or more market's, if no market_id is passed volumes will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// size of the bucket volumes are summed into, it must be provided
+	TimeFrame TimeFrame `protobuf:"varint,3,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsVolumesRequest) Reset() {
+	*x = MarketsVolumesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsVolumesRequest) ProtoMessage() {}
+
+func (x *MarketsVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsVolumesRequest.ProtoReflect.Descriptor instead.
+func (*MarketsVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MarketsVolumesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsVolumesRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsVolumesRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsVolumesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its volumes sorted by time ASC
+	MarketsVolumes map[string]*MarketVolumes `protobuf:"bytes,1,rep,name=markets_volumes,json=marketsVolumes,proto3" json:"markets_volumes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsVolumesReply) Reset() {
+	*x = MarketsVolumesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsVolumesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsVolumesReply) ProtoMessage() {}
+
+func (x *MarketsVolumesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsVolumesReply.ProtoReflect.Descriptor instead.
+func (*MarketsVolumesReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MarketsVolumesReply) GetMarketsVolumes() map[string]*MarketVolumes {
+	if x != nil {
+		return x.MarketsVolumes
+	}
+	return nil
+}
+
+type MarketVolumes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns list of Market's volumes, buckets without trades are omitted
+	MarketVolume []*MarketVolume `protobuf:"bytes,1,rep,name=market_volume,json=marketVolume,proto3" json:"market_volume,omitempty"`
+}
+
+func (x *MarketVolumes) Reset() {
+	*x = MarketVolumes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketVolumes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketVolumes) ProtoMessage() {}
+
+func (x *MarketVolumes) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketVolumes.ProtoReflect.Descriptor instead.
+func (*MarketVolumes) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MarketVolumes) GetMarketVolume() []*MarketVolume {
+	if x != nil {
+		return x.MarketVolume
+	}
+	return nil
+}
+
+type MarketVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base asset amount traded
+	BaseVolume float64 `protobuf:"fixed64,1,opt,name=base_volume,json=baseVolume,proto3" json:"base_volume,omitempty"`
+	// quote asset amount traded
+	QuoteVolume float64 `protobuf:"fixed64,2,opt,name=quote_volume,json=quoteVolume,proto3" json:"quote_volume,omitempty"`
+	// number of inferred trades
+	TradeCount uint64 `protobuf:"varint,3,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	// quote volume divided by base volume, that is price weighted by
+	// inferred trade volume
+	Vwap float64 `protobuf:"fixed64,4,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	// start of the time_frame bucket
+	Time string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketVolume) Reset() {
+	*x = MarketVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketVolume) ProtoMessage() {}
+
+func (x *MarketVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketVolume.ProtoReflect.Descriptor instead.
+func (*MarketVolume) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MarketVolume) GetBaseVolume() float64 {
+	if x != nil {
+		return x.BaseVolume
+	}
+	return 0
+}
+
+func (x *MarketVolume) GetQuoteVolume() float64 {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return 0
+}
+
+func (x *MarketVolume) GetTradeCount() uint64 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *MarketVolume) GetVwap() float64 {
+	if x != nil {
+		return x.Vwap
+	}
+	return 0
+}
+
+func (x *MarketVolume) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsSpreadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch spreads for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch spreads for specific one or more market's, if no market_id is passed spreads will be calculated for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// used to group prices by time_frame for the specified time_range, it must be provided
+	TimeFrame TimeFrame `protobuf:"varint,3,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+	// fee in basis points applied to market price to derive bid and ask, 0 included, if not provided the fee recorded for every market, or the default one, is used
+	FeeBps *uint32 `protobuf:"varint,4,opt,name=fee_bps,json=feeBps,proto3,oneof" json:"fee_bps,omitempty"`
+}
+
+func (x *MarketsSpreadsRequest) Reset() {
+	*x = MarketsSpreadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsSpreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsSpreadsRequest) ProtoMessage() {}
+
+func (x *MarketsSpreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsSpreadsRequest.ProtoReflect.Descriptor instead.
+func (*MarketsSpreadsRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MarketsSpreadsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsSpreadsRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsSpreadsRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+func (x *MarketsSpreadsRequest) GetFeeBps() uint32 {
+	if x != nil && x.FeeBps != nil {
+		return *x.FeeBps
+	}
+	return 0
+}
+
+type MarketsSpreadsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns spreads of every asset pair traded by more than one market
+	PairSpreads []*PairSpreads `protobuf:"bytes,1,rep,name=pair_spreads,json=pairSpreads,proto3" json:"pair_spreads,omitempty"`
+}
+
+func (x *MarketsSpreadsReply) Reset() {
+	*x = MarketsSpreadsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsSpreadsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsSpreadsReply) ProtoMessage() {}
+
+func (x *MarketsSpreadsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsSpreadsReply.ProtoReflect.Descriptor instead.
+func (*MarketsSpreadsReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MarketsSpreadsReply) GetPairSpreads() []*PairSpreads {
+	if x != nil {
+		return x.PairSpreads
+	}
+	return nil
+}
+
+type PairSpreads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAsset  string `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// market_ids trading the asset pair
+	MarketIds []string `protobuf:"bytes,3,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// spreads sorted by time ASC
+	Spreads []*MarketSpread `protobuf:"bytes,4,rep,name=spreads,proto3" json:"spreads,omitempty"`
+}
+
+func (x *PairSpreads) Reset() {
+	*x = PairSpreads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairSpreads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairSpreads) ProtoMessage() {}
+
+func (x *PairSpreads) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairSpreads.ProtoReflect.Descriptor instead.
+func (*PairSpreads) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PairSpreads) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *PairSpreads) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *PairSpreads) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *PairSpreads) GetSpreads() []*MarketSpread {
+	if x != nil {
+		return x.Spreads
+	}
+	return nil
+}
+
+type MarketSpread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// highest bid across markets
+	BestBid float64 `protobuf:"fixed64,1,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	// market_id with the highest bid
+	BestBidMarketId string `protobuf:"bytes,2,opt,name=best_bid_market_id,json=bestBidMarketId,proto3" json:"best_bid_market_id,omitempty"`
+	// lowest ask across markets
+	BestAsk float64 `protobuf:"fixed64,3,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	// market_id with the lowest ask
+	BestAskMarketId string `protobuf:"bytes,4,opt,name=best_ask_market_id,json=bestAskMarketId,proto3" json:"best_ask_market_id,omitempty"`
+	// best_ask minus best_bid
+	Spread float64 `protobuf:"fixed64,5,opt,name=spread,proto3" json:"spread,omitempty"`
+	// spread in basis points of the mid price
+	SpreadBps float64 `protobuf:"fixed64,6,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	// true if best_bid is higher than best_ask, meaning price difference is larger than providers fees
+	Arbitrage bool `protobuf:"varint,7,opt,name=arbitrage,proto3" json:"arbitrage,omitempty"`
+	// point in time of the spread
+	Time string `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketSpread) Reset() {
+	*x = MarketSpread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketSpread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSpread) ProtoMessage() {}
+
+func (x *MarketSpread) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSpread.ProtoReflect.Descriptor instead.
+func (*MarketSpread) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MarketSpread) GetBestBid() float64 {
+	if x != nil {
+		return x.BestBid
+	}
+	return 0
+}
+
+func (x *MarketSpread) GetBestBidMarketId() string {
+	if x != nil {
+		return x.BestBidMarketId
+	}
+	return ""
+}
+
+func (x *MarketSpread) GetBestAsk() float64 {
+	if x != nil {
+		return x.BestAsk
+	}
+	return 0
+}
+
+func (x *MarketSpread) GetBestAskMarketId() string {
+	if x != nil {
+		return x.BestAskMarketId
+	}
+	return ""
+}
+
+func (x *MarketSpread) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *MarketSpread) GetSpreadBps() float64 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+func (x *MarketSpread) GetArbitrage() bool {
+	if x != nil {
+		return x.Arbitrage
+	}
+	return false
+}
+
+func (x *MarketSpread) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsDeviationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch deviations for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch deviations for specific one or more market's, if no market_id is passed deviations will be calculated for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// used to group prices by time_frame for the specified time_range
+	TimeFrame TimeFrame `protobuf:"varint,3,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+	// max deviation in basis points before a price is considered off-market, if not provided the default one is used
+	ToleranceBps uint32 `protobuf:"varint,4,opt,name=tolerance_bps,json=toleranceBps,proto3" json:"tolerance_bps,omitempty"`
+}
+
+func (x *MarketsDeviationsRequest) Reset() {
+	*x = MarketsDeviationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsDeviationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsDeviationsRequest) ProtoMessage() {}
+
+func (x *MarketsDeviationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsDeviationsRequest.ProtoReflect.Descriptor instead.
+func (*MarketsDeviationsRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MarketsDeviationsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsDeviationsRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsDeviationsRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+func (x *MarketsDeviationsRequest) GetToleranceBps() uint32 {
+	if x != nil {
+		return x.ToleranceBps
+	}
+	return 0
+}
+
+type MarketsDeviationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its deviations, markets without a known external rate are omitted
+	MarketsDeviations map[string]*MarketDeviations `protobuf:"bytes,1,rep,name=markets_deviations,json=marketsDeviations,proto3" json:"markets_deviations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsDeviationsReply) Reset() {
+	*x = MarketsDeviationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsDeviationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsDeviationsReply) ProtoMessage() {}
+
+func (x *MarketsDeviationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsDeviationsReply.ProtoReflect.Descriptor instead.
+func (*MarketsDeviationsReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MarketsDeviationsReply) GetMarketsDeviations() map[string]*MarketDeviations {
+	if x != nil {
+		return x.MarketsDeviations
+	}
+	return nil
+}
+
+type MarketDeviations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAsset  string `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// deviations sorted by time ASC
+	Deviations []*MarketDeviation `protobuf:"bytes,3,rep,name=deviations,proto3" json:"deviations,omitempty"`
+	// mean of absolute deviations in basis points
+	MeanBps float64 `protobuf:"fixed64,4,opt,name=mean_bps,json=meanBps,proto3" json:"mean_bps,omitempty"`
+	// max absolute deviation in basis points
+	MaxBps float64 `protobuf:"fixed64,5,opt,name=max_bps,json=maxBps,proto3" json:"max_bps,omitempty"`
+	// percentage of points with deviation larger than tolerance
+	OutsideTolerancePercentage float64 `protobuf:"fixed64,6,opt,name=outside_tolerance_percentage,json=outsideTolerancePercentage,proto3" json:"outside_tolerance_percentage,omitempty"`
+}
+
+func (x *MarketDeviations) Reset() {
+	*x = MarketDeviations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDeviations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDeviations) ProtoMessage() {}
+
+func (x *MarketDeviations) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDeviations.ProtoReflect.Descriptor instead.
+func (*MarketDeviations) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MarketDeviations) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketDeviations) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketDeviations) GetDeviations() []*MarketDeviation {
+	if x != nil {
+		return x.Deviations
+	}
+	return nil
+}
+
+func (x *MarketDeviations) GetMeanBps() float64 {
+	if x != nil {
+		return x.MeanBps
+	}
+	return 0
+}
+
+func (x *MarketDeviations) GetMaxBps() float64 {
+	if x != nil {
+		return x.MaxBps
+	}
+	return 0
+}
+
+func (x *MarketDeviations) GetOutsideTolerancePercentage() float64 {
+	if x != nil {
+		return x.OutsideTolerancePercentage
+	}
+	return 0
+}
+
+type MarketDeviation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// market quote price
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	// external rate of the same asset pair
+	ReferencePrice float64 `protobuf:"fixed64,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// distance of price from reference_price in basis points, positive if price is higher
+	DeviationBps float64 `protobuf:"fixed64,3,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+	// point in time of the price
+	Time string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketDeviation) Reset() {
+	*x = MarketDeviation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDeviation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDeviation) ProtoMessage() {}
+
+func (x *MarketDeviation) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDeviation.ProtoReflect.Descriptor instead.
+func (*MarketDeviation) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MarketDeviation) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketDeviation) GetReferencePrice() float64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *MarketDeviation) GetDeviationBps() float64 {
+	if x != nil {
+		return x.DeviationBps
+	}
+	return 0
+}
+
+func (x *MarketDeviation) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsFeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch fees for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch fees for specific one or more market's, if no market_id is passed fees will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// pagination
+	Page *Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	// used to group fees by time_frame for the specified time_range, the last fee of every group is returned
+	TimeFrame TimeFrame `protobuf:"varint,4,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsFeesRequest) Reset() {
+	*x = MarketsFeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsFeesRequest) ProtoMessage() {}
+
+func (x *MarketsFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsFeesRequest.ProtoReflect.Descriptor instead.
+func (*MarketsFeesRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MarketsFeesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsFeesRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsFeesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *MarketsFeesRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsFeesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its fees sorted by time ASC
+	MarketsFees map[string]*MarketFees `protobuf:"bytes,1,rep,name=markets_fees,json=marketsFees,proto3" json:"markets_fees,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsFeesReply) Reset() {
+	*x = MarketsFeesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsFeesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsFeesReply) ProtoMessage() {}
+
+func (x *MarketsFeesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsFeesReply.ProtoReflect.Descriptor instead.
+func (*MarketsFeesReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MarketsFeesReply) GetMarketsFees() map[string]*MarketFees {
+	if x != nil {
+		return x.MarketsFees
+	}
+	return nil
+}
+
+type MarketFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAsset  string       `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string       `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	MarketFee  []*MarketFee `protobuf:"bytes,3,rep,name=market_fee,json=marketFee,proto3" json:"market_fee,omitempty"`
+}
+
+func (x *MarketFees) Reset() {
+	*x = MarketFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFees) ProtoMessage() {}
+
+func (x *MarketFees) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketFees.ProtoReflect.Descriptor instead.
+func (*MarketFees) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MarketFees) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketFees) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketFees) GetMarketFee() []*MarketFee {
+	if x != nil {
+		return x.MarketFee
+	}
+	return nil
+}
+
+type MarketFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// percentage fee, in basis points, charged on base asset
+	BasePercentageFee int64 `protobuf:"varint,1,opt,name=base_percentage_fee,json=basePercentageFee,proto3" json:"base_percentage_fee,omitempty"`
+	// percentage fee, in basis points, charged on quote asset
+	QuotePercentageFee int64 `protobuf:"varint,2,opt,name=quote_percentage_fee,json=quotePercentageFee,proto3" json:"quote_percentage_fee,omitempty"`
+	// fixed fee, in satoshis, charged on base asset
+	BaseFixedFee int64 `protobuf:"varint,3,opt,name=base_fixed_fee,json=baseFixedFee,proto3" json:"base_fixed_fee,omitempty"`
+	// fixed fee, in satoshis, charged on quote asset
+	QuoteFixedFee int64 `protobuf:"varint,4,opt,name=quote_fixed_fee,json=quoteFixedFee,proto3" json:"quote_fixed_fee,omitempty"`
+	// fee charged by the provider when previewing a trade
+	PreviewFeeAmount uint64 `protobuf:"varint,5,opt,name=preview_fee_amount,json=previewFeeAmount,proto3" json:"preview_fee_amount,omitempty"`
+	// asset of the previewed fee
+	PreviewFeeAsset string `protobuf:"bytes,6,opt,name=preview_fee_asset,json=previewFeeAsset,proto3" json:"preview_fee_asset,omitempty"`
+	// point in time when market had this fee
+	Time string `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MarketFee) Reset() {
+	*x = MarketFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFee) ProtoMessage() {}
+
+func (x *MarketFee) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketFee.ProtoReflect.Descriptor instead.
+func (*MarketFee) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MarketFee) GetBasePercentageFee() int64 {
+	if x != nil {
+		return x.BasePercentageFee
+	}
+	return 0
+}
+
+func (x *MarketFee) GetQuotePercentageFee() int64 {
+	if x != nil {
+		return x.QuotePercentageFee
+	}
+	return 0
+}
+
+func (x *MarketFee) GetBaseFixedFee() int64 {
+	if x != nil {
+		return x.BaseFixedFee
+	}
+	return 0
+}
+
+func (x *MarketFee) GetQuoteFixedFee() int64 {
+	if x != nil {
+		return x.QuoteFixedFee
+	}
+	return 0
+}
+
+func (x *MarketFee) GetPreviewFeeAmount() uint64 {
+	if x != nil {
+		return x.PreviewFeeAmount
+	}
+	return 0
+}
+
+func (x *MarketFee) GetPreviewFeeAsset() string {
+	if x != nil {
+		return x.PreviewFeeAsset
+	}
+	return ""
+}
+
+func (x *MarketFee) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type MarketsDepthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_range fetch depth for time range
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// fetch depth for specific one or more market's, if no market_id is passed depth will be fetched for all
+	MarketIds []string `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// used to average depth samples by time_frame for the specified time_range
+	TimeFrame TimeFrame `protobuf:"varint,3,opt,name=time_frame,json=timeFrame,proto3,enum=tdexa.v1.TimeFrame" json:"time_frame,omitempty"`
+}
+
+func (x *MarketsDepthRequest) Reset() {
+	*x = MarketsDepthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsDepthRequest) ProtoMessage() {}
+
+func (x *MarketsDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsDepthRequest.ProtoReflect.Descriptor instead.
+func (*MarketsDepthRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MarketsDepthRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MarketsDepthRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+func (x *MarketsDepthRequest) GetTimeFrame() TimeFrame {
+	if x != nil {
+		return x.TimeFrame
+	}
+	return TimeFrame_TF_NULL
+}
+
+type MarketsDepthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// returns map of market_id and its depth curves
+	MarketsDepth map[string]*MarketDepth `protobuf:"bytes,1,rep,name=markets_depth,json=marketsDepth,proto3" json:"markets_depth,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MarketsDepthReply) Reset() {
+	*x = MarketsDepthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsDepthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsDepthReply) ProtoMessage() {}
+
+func (x *MarketsDepthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsDepthReply.ProtoReflect.Descriptor instead.
+func (*MarketsDepthReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MarketsDepthReply) GetMarketsDepth() map[string]*MarketDepth {
+	if x != nil {
+		return x.MarketsDepth
+	}
+	return nil
+}
+
+type MarketDepth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAsset  string `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// depth curves sorted by time ASC
+	Curves []*DepthCurve `protobuf:"bytes,3,rep,name=curves,proto3" json:"curves,omitempty"`
+}
+
+func (x *MarketDepth) Reset() {
+	*x = MarketDepth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepth) ProtoMessage() {}
+
+func (x *MarketDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
+func (*MarketDepth) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MarketDepth) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketDepth) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketDepth) GetCurves() []*DepthCurve {
+	if x != nil {
+		return x.Curves
+	}
+	return nil
+}
+
+type DepthCurve struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mean of buy and sell prices of the smallest amount, slippage is relative to it
+	MidPrice float64 `protobuf:"fixed64,1,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	// levels sorted by amount ASC
+	Levels []*DepthLevel `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+	// point in time when depth was sampled
+	Time string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *DepthCurve) Reset() {
+	*x = DepthCurve{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepthCurve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthCurve) ProtoMessage() {}
+
+func (x *DepthCurve) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthCurve.ProtoReflect.Descriptor instead.
+func (*DepthCurve) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DepthCurve) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
+}
+
+func (x *DepthCurve) GetLevels() []*DepthLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *DepthCurve) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type DepthLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount of base asset bought or sold
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// quote asset paid per unit of base asset when buying amount, fees included
+	BuyPrice float64 `protobuf:"fixed64,2,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	// distance of buy_price from mid_price in basis points
+	BuySlippageBps float64 `protobuf:"fixed64,3,opt,name=buy_slippage_bps,json=buySlippageBps,proto3" json:"buy_slippage_bps,omitempty"`
+	// quote asset received per unit of base asset when selling amount, fees included
+	SellPrice float64 `protobuf:"fixed64,4,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	// distance of sell_price from mid_price in basis points
+	SellSlippageBps float64 `protobuf:"fixed64,5,opt,name=sell_slippage_bps,json=sellSlippageBps,proto3" json:"sell_slippage_bps,omitempty"`
+}
+
+func (x *DepthLevel) Reset() {
+	*x = DepthLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepthLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthLevel) ProtoMessage() {}
+
+func (x *DepthLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthLevel.ProtoReflect.Descriptor instead.
+func (*DepthLevel) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DepthLevel) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetBuyPrice() float64 {
+	if x != nil {
+		return x.BuyPrice
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetBuySlippageBps() float64 {
+	if x != nil {
+		return x.BuySlippageBps
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetSellPrice() float64 {
+	if x != nil {
+		return x.SellPrice
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetSellSlippageBps() float64 {
+	if x != nil {
+		return x.SellSlippageBps
+	}
+	return 0
+}
+
+// TimeRange is flexible type used to determine time span for which specific
+// api will fetch data, either one of predefined_period or custom_period should be provided.
+type TimeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// predefined time_period till now
+	PredefinedPeriod PredefinedPeriod `protobuf:"varint,1,opt,name=predefined_period,json=predefinedPeriod,proto3,enum=tdexa.v1.PredefinedPeriod" json:"predefined_period,omitempty"`
+	// granular time range
+	CustomPeriod *CustomPeriod `protobuf:"bytes,2,opt,name=custom_period,json=customPeriod,proto3" json:"custom_period,omitempty"`
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TimeRange) GetPredefinedPeriod() PredefinedPeriod {
+	if x != nil {
+		return x.PredefinedPeriod
+	}
+	return PredefinedPeriod_NULL
+}
+
+func (x *TimeRange) GetCustomPeriod() *CustomPeriod {
+	if x != nil {
+		return x.CustomPeriod
+	}
+	return nil
+}
+
+type CustomPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_date in RFC3339 format
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date in RFC3339 format
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *CustomPeriod) Reset() {
+	*x = CustomPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomPeriod) ProtoMessage() {}
+
+func (x *CustomPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomPeriod.ProtoReflect.Descriptor instead.
+func (*CustomPeriod) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CustomPeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CustomPeriod) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type ListMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketProviders []*MarketProvider `protobuf:"bytes,1,rep,name=market_providers,json=marketProviders,proto3" json:"market_providers,omitempty"`
+	Page            *Page             `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListMarketsRequest) Reset() {
+	*x = ListMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsRequest) ProtoMessage() {}
+
+func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListMarketsRequest) GetMarketProviders() []*MarketProvider {
+	if x != nil {
+		return x.MarketProviders
+	}
+	return nil
+}
+
+func (x *ListMarketsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListMarketsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Markets []*MarketIDInfo `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (x *ListMarketsReply) Reset() {
+	*x = ListMarketsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsReply) ProtoMessage() {}
+
+func (x *ListMarketsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsReply.ProtoReflect.Descriptor instead.
+func (*ListMarketsReply) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListMarketsReply) GetMarkets() []*MarketIDInfo {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+type MarketIDInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketProvider *MarketProvider `protobuf:"bytes,2,opt,name=market_provider,json=marketProvider,proto3" json:"market_provider,omitempty"`
+}
+
+func (x *MarketIDInfo) Reset() {
+	*x = MarketIDInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketIDInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketIDInfo) ProtoMessage() {}
+
+func (x *MarketIDInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketIDInfo.ProtoReflect.Descriptor instead.
+func (*MarketIDInfo) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MarketIDInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarketIDInfo) GetMarketProvider() *MarketProvider {
+	if x != nil {
+		return x.MarketProvider
+	}
+	return nil
+}
+
+type MarketProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	BaseAsset  string `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Active     bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *MarketProvider) Reset() {
+	*x = MarketProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketProvider) ProtoMessage() {}
+
+func (x *MarketProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketProvider.ProtoReflect.Descriptor instead.
+func (*MarketProvider) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MarketProvider) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MarketProvider) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketProvider) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketProvider) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Page struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNumber int64 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdexa_v1_analytics_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_tdexa_v1_analytics_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_tdexa_v1_analytics_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Page) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *Page) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+var File_tdexa_v1_analytics_proto protoreflect.FileDescriptor
+
+var file_tdexa_v1_analytics_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x38, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a,
+	0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x41, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x73, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x53,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x09, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x14,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x56,
+	0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x55, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x1a, 0x58, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55,
+	0x70, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a,
+	0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5f,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x9d, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22,
+	0x30, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x35, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x12, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x56, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x75, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x1a, 0x58,
+	0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x64, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb,
+	0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3c,
+	0x0a, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xc8, 0x02, 0x0a,
+	0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc3,
+	0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a,
+	0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x5c, 0x0a,
+	0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x0e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x6b, 0x0a,
+	0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x14, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x85, 0x02,
+	0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x56, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0e,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x58, 0x0a, 0x12, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0xc7, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x13, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x36,
+	0x0a, 0x17, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x13, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x5a, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x63, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x1a, 0x5a,
+	0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x69,
+	0x67, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x48, 0x69, 0x67, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x64,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x22, 0x93, 0x02, 0x0a, 0x10, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35,
+	0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x07, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x06,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x11, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x50, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x56,
+	0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x5a, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x13, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x77, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x77, 0x61, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53,
+	0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x66, 0x65, 0x65, 0x42, 0x70, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x22, 0x4f,
+	0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x73, 0x70,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x53, 0x70, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22,
+	0x9e, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x69, 0x72, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x07, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x22, 0x87, 0x02, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x70, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x12,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x42, 0x69,
+	0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x65, 0x73,
+	0x74, 0x41, 0x73, 0x6b, 0x12, 0x2b, 0x0a, 0x12, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x6b,
+	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73,
+	0x70, 0x72, 0x65, 0x61, 0x64, 0x42, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x62, 0x69,
+	0x74, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x72, 0x62,
+	0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x18, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x70, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x66,
+	0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x60, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x61, 0x6e,
+	0x42, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x42, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x1a, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x89,
+	0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x12, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a,
+	0x10, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x4e, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65,
+	0x73, 0x1a, 0x54, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52,
+	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x09, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x62, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x65, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x65, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x1a,
+	0x56, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7b, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x76, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x74, 0x68, 0x43, 0x75, 0x72,
+	0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x74, 0x68,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x79, 0x5f, 0x73, 0x6c, 0x69,
+	0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x62, 0x75, 0x79, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x6c, 0x53,
+	0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x10, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x48,
+	0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x44,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x61, 0x0a,
+	0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a,
+	0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x22, 0x7a, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x2a, 0x87, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x46, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52,
+	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x5f, 0x46, 0x4f, 0x55, 0x52, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f,
+	0x57, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x05, 0x2a, 0x86, 0x01, 0x0a,
+	0x10, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c,
+	0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41,
+	0x53, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53, 0x54,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x53, 0x54,
+	0x5f, 0x33, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x53, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x59,
+	0x45, 0x41, 0x52, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x59,
+	0x45, 0x41, 0x52, 0x10, 0x07, 0x2a, 0x93, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x4c, 0x45, 0x52, 0x54,
+	0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x42, 0x45, 0x4c,
+	0x4f, 0x57, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x55,
+	0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
+	0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x0a, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x45,
+	0x52, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x42,
+	0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x41,
+	0x53, 0x53, 0x45, 0x54, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x32, 0xa2, 0x10, 0x0a,
+	0x09, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a,
+	0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x68,
+	0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x12, 0x1f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x76, 0x6c, 0x12, 0x68, 0x0a,
+	0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12,
+	0x1f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x70, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x74, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31,
+	0x2f, 0x66, 0x65, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x7a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x67, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
+	0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0d, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x6c, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x61, 0x0a,
+	0x0c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x42, 0xae, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2e,
+	0x76, 0x31, 0x42, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64,
+	0x65, 0x78, 0x2d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x65, 0x78,
+	0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x54, 0x64, 0x65, 0x78,
+	0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x54, 0x64, 0x65, 0x78, 0x61, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x14, 0x54, 0x64, 0x65, 0x78, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x54, 0x64, 0x65, 0x78, 0x61, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tdexa_v1_analytics_proto_rawDescOnce sync.Once
+	file_tdexa_v1_analytics_proto_rawDescData = file_tdexa_v1_analytics_proto_rawDesc
+)
+
+func file_tdexa_v1_analytics_proto_rawDescGZIP() []byte {
+	file_tdexa_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_tdexa_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_tdexa_v1_analytics_proto_rawDescData)
+	})
+	return file_tdexa_v1_analytics_proto_rawDescData
+}
+
+var file_tdexa_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_tdexa_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_tdexa_v1_analytics_proto_goTypes = []interface{}{
+	(TimeFrame)(0),                     // 0: tdexa.v1.TimeFrame
+	(PredefinedPeriod)(0),              // 1: tdexa.v1.PredefinedPeriod
+	(AlertRuleType)(0),                 // 2: tdexa.v1.AlertRuleType
+	(AlertAsset)(0),                    // 3: tdexa.v1.AlertAsset
+	(*SubscribeMarketsRequest)(nil),    // 4: tdexa.v1.SubscribeMarketsRequest
+	(*SubscribeMarketsReply)(nil),      // 5: tdexa.v1.SubscribeMarketsReply
+	(*CreateAlertRuleRequest)(nil),     // 6: tdexa.v1.CreateAlertRuleRequest
+	(*CreateAlertRuleReply)(nil),       // 7: tdexa.v1.CreateAlertRuleReply
+	(*UpdateAlertRuleRequest)(nil),     // 8: tdexa.v1.UpdateAlertRuleRequest
+	(*UpdateAlertRuleReply)(nil),       // 9: tdexa.v1.UpdateAlertRuleReply
+	(*DeleteAlertRuleRequest)(nil),     // 10: tdexa.v1.DeleteAlertRuleRequest
+	(*DeleteAlertRuleReply)(nil),       // 11: tdexa.v1.DeleteAlertRuleReply
+	(*ListAlertRulesRequest)(nil),      // 12: tdexa.v1.ListAlertRulesRequest
+	(*ListAlertRulesReply)(nil),        // 13: tdexa.v1.ListAlertRulesReply
+	(*ListAlertDeliveriesRequest)(nil), // 14: tdexa.v1.ListAlertDeliveriesRequest
+	(*ListAlertDeliveriesReply)(nil),   // 15: tdexa.v1.ListAlertDeliveriesReply
+	(*AlertRule)(nil),                  // 16: tdexa.v1.AlertRule
+	(*AlertDelivery)(nil),              // 17: tdexa.v1.AlertDelivery
+	(*MarketsUptimeRequest)(nil),       // 18: tdexa.v1.MarketsUptimeRequest
+	(*MarketsUptimeReply)(nil),         // 19: tdexa.v1.MarketsUptimeReply
+	(*MarketUptime)(nil),               // 20: tdexa.v1.MarketUptime
+	(*ProviderUptime)(nil),             // 21: tdexa.v1.ProviderUptime
+	(*Uptime)(nil),                     // 22: tdexa.v1.Uptime
+	(*Outage)(nil),                     // 23: tdexa.v1.Outage
+	(*MarketsStatusRequest)(nil),       // 24: tdexa.v1.MarketsStatusRequest
+	(*MarketsStatusReply)(nil),         // 25: tdexa.v1.MarketsStatusReply
+	(*MarketStatus)(nil),               // 26: tdexa.v1.MarketStatus
+	(*FetchStatus)(nil),                // 27: tdexa.v1.FetchStatus
+	(*CircuitBreaker)(nil),             // 28: tdexa.v1.CircuitBreaker
+	(*LeaderStatusRequest)(nil),        // 29: tdexa.v1.LeaderStatusRequest
+	(*LeaderStatusReply)(nil),          // 30: tdexa.v1.LeaderStatusReply
+	(*MarketsBalancesRequest)(nil),     // 31: tdexa.v1.MarketsBalancesRequest
+	(*MarketsBalancesReply)(nil),       // 32: tdexa.v1.MarketsBalancesReply
+	(*MarketBalances)(nil),             // 33: tdexa.v1.MarketBalances
+	(*MarketBalance)(nil),              // 34: tdexa.v1.MarketBalance
+	(*MarketsPricesRequest)(nil),       // 35: tdexa.v1.MarketsPricesRequest
+	(*MarketsPricesReply)(nil),         // 36: tdexa.v1.MarketsPricesReply
+	(*MarketPrices)(nil),               // 37: tdexa.v1.MarketPrices
+	(*MarketPrice)(nil),                // 38: tdexa.v1.MarketPrice
+	(*AveragePrice)(nil),               // 39: tdexa.v1.AveragePrice
+	(*MarketsCandlesRequest)(nil),      // 40: tdexa.v1.MarketsCandlesRequest
+	(*MarketsCandlesReply)(nil),        // 41: tdexa.v1.MarketsCandlesReply
+	(*MarketCandles)(nil),              // 42: tdexa.v1.MarketCandles
+	(*MarketCandle)(nil),               // 43: tdexa.v1.MarketCandle
+	(*GetTotalValueLockedRequest)(nil), // 44: tdexa.v1.GetTotalValueLockedRequest
+	(*GetTotalValueLockedReply)(nil),   // 45: tdexa.v1.GetTotalValueLockedReply
+	(*TotalValueLocked)(nil),           // 46: tdexa.v1.TotalValueLocked
+	(*MarketValueLocked)(nil),          // 47: tdexa.v1.MarketValueLocked
+	(*ProviderValueLocked)(nil),        // 48: tdexa.v1.ProviderValueLocked
+	(*AssetValueLocked)(nil),           // 49: tdexa.v1.AssetValueLocked
+	(*MarketsVolumesRequest)(nil),      // 50: tdexa.v1.MarketsVolumesRequest
+	(*MarketsVolumesReply)(nil),        // 51: tdexa.v1.MarketsVolumesReply
+	(*MarketVolumes)(nil),              // 52: tdexa.v1.MarketVolumes
+	(*MarketVolume)(nil),               // 53: tdexa.v1.MarketVolume
+	(*MarketsSpreadsRequest)(nil),      // 54: tdexa.v1.MarketsSpreadsRequest
+	(*MarketsSpreadsReply)(nil),        // 55: tdexa.v1.MarketsSpreadsReply
+	(*PairSpreads)(nil),                // 56: tdexa.v1.PairSpreads
+	(*MarketSpread)(nil),               // 57: tdexa.v1.MarketSpread
+	(*MarketsDeviationsRequest)(nil),   // 58: tdexa.v1.MarketsDeviationsRequest
+	(*MarketsDeviationsReply)(nil),     // 59: tdexa.v1.MarketsDeviationsReply
+	(*MarketDeviations)(nil),           // 60: tdexa.v1.MarketDeviations
+	(*MarketDeviation)(nil),            // 61: tdexa.v1.MarketDeviation
+	(*MarketsFeesRequest)(nil),         // 62: tdexa.v1.MarketsFeesRequest
+	(*MarketsFeesReply)(nil),           // 63: tdexa.v1.MarketsFeesReply
+	(*MarketFees)(nil),                 // 64: tdexa.v1.MarketFees
+	(*MarketFee)(nil),                  // 65: tdexa.v1.MarketFee
+	(*MarketsDepthRequest)(nil),        // 66: tdexa.v1.MarketsDepthRequest
+	(*MarketsDepthReply)(nil),          // 67: tdexa.v1.MarketsDepthReply
+	(*MarketDepth)(nil),                // 68: tdexa.v1.MarketDepth
+	(*DepthCurve)(nil),                 // 69: tdexa.v1.DepthCurve
+	(*DepthLevel)(nil),                 // 70: tdexa.v1.DepthLevel
+	(*TimeRange)(nil),                  // 71: tdexa.v1.TimeRange
+	(*CustomPeriod)(nil),               // 72: tdexa.v1.CustomPeriod
+	(*ListMarketsRequest)(nil),         // 73: tdexa.v1.ListMarketsRequest
+	(*ListMarketsReply)(nil),           // 74: tdexa.v1.ListMarketsReply
+	(*MarketIDInfo)(nil),               // 75: tdexa.v1.MarketIDInfo
+	(*MarketProvider)(nil),             // 76: tdexa.v1.MarketProvider
+	(*Page)(nil),                       // 77: tdexa.v1.Page
+	nil,                                // 78: tdexa.v1.MarketsUptimeReply.MarketsUptimeEntry
+	nil,                                // 79: tdexa.v1.MarketsUptimeReply.ProvidersUptimeEntry
+	nil,                                // 80: tdexa.v1.MarketsStatusReply.MarketsStatusEntry
+	nil,                                // 81: tdexa.v1.MarketsStatusReply.ProvidersCircuitBreakerEntry
+	nil,                                // 82: tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry
+	nil,                                // 83: tdexa.v1.MarketsPricesReply.MarketsPricesEntry
+	nil,                                // 84: tdexa.v1.MarketsCandlesReply.MarketsCandlesEntry
+	nil,                                // 85: tdexa.v1.MarketsVolumesReply.MarketsVolumesEntry
+	nil,                                // 86: tdexa.v1.MarketsDeviationsReply.MarketsDeviationsEntry
+	nil,                                // 87: tdexa.v1.MarketsFeesReply.MarketsFeesEntry
+	nil,                                // 88: tdexa.v1.MarketsDepthReply.MarketsDepthEntry
+}
+var file_tdexa_v1_analytics_proto_depIdxs = []int32{
+	38, // 0: tdexa.v1.SubscribeMarketsReply.price:type_name -> tdexa.v1.MarketPrice
+	34, // 1: tdexa.v1.SubscribeMarketsReply.balance:type_name -> tdexa.v1.MarketBalance
+	16, // 2: tdexa.v1.CreateAlertRuleRequest.rule:type_name -> tdexa.v1.AlertRule
+	16, // 3: tdexa.v1.UpdateAlertRuleRequest.rule:type_name -> tdexa.v1.AlertRule
+	16, // 4: tdexa.v1.ListAlertRulesReply.rules:type_name -> tdexa.v1.AlertRule
+	77, // 5: tdexa.v1.ListAlertDeliveriesRequest.page:type_name -> tdexa.v1.Page
+	17, // 6: tdexa.v1.ListAlertDeliveriesReply.deliveries:type_name -> tdexa.v1.AlertDelivery
+	2,  // 7: tdexa.v1.AlertRule.type:type_name -> tdexa.v1.AlertRuleType
+	3,  // 8: tdexa.v1.AlertRule.asset:type_name -> tdexa.v1.AlertAsset
+	71, // 9: tdexa.v1.MarketsUptimeRequest.time_range:type_name -> tdexa.v1.TimeRange
+	78, // 10: tdexa.v1.MarketsUptimeReply.markets_uptime:type_name -> tdexa.v1.MarketsUptimeReply.MarketsUptimeEntry
+	79, // 11: tdexa.v1.MarketsUptimeReply.providers_uptime:type_name -> tdexa.v1.MarketsUptimeReply.ProvidersUptimeEntry
+	22, // 12: tdexa.v1.MarketUptime.uptime:type_name -> tdexa.v1.Uptime
+	22, // 13: tdexa.v1.ProviderUptime.uptime:type_name -> tdexa.v1.Uptime
+	23, // 14: tdexa.v1.Uptime.outages:type_name -> tdexa.v1.Outage
+	80, // 15: tdexa.v1.MarketsStatusReply.markets_status:type_name -> tdexa.v1.MarketsStatusReply.MarketsStatusEntry
+	81, // 16: tdexa.v1.MarketsStatusReply.providers_circuit_breaker:type_name -> tdexa.v1.MarketsStatusReply.ProvidersCircuitBreakerEntry
+	27, // 17: tdexa.v1.MarketStatus.fetch_statuses:type_name -> tdexa.v1.FetchStatus
+	71, // 18: tdexa.v1.MarketsBalancesRequest.time_range:type_name -> tdexa.v1.TimeRange
+	77, // 19: tdexa.v1.MarketsBalancesRequest.page:type_name -> tdexa.v1.Page
+	0,  // 20: tdexa.v1.MarketsBalancesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	82, // 21: tdexa.v1.MarketsBalancesReply.markets_balances:type_name -> tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry
+	34, // 22: tdexa.v1.MarketBalances.market_balance:type_name -> tdexa.v1.MarketBalance
+	71, // 23: tdexa.v1.MarketsPricesRequest.time_range:type_name -> tdexa.v1.TimeRange
+	77, // 24: tdexa.v1.MarketsPricesRequest.page:type_name -> tdexa.v1.Page
+	0,  // 25: tdexa.v1.MarketsPricesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	83, // 26: tdexa.v1.MarketsPricesReply.markets_prices:type_name -> tdexa.v1.MarketsPricesReply.MarketsPricesEntry
+	39, // 27: tdexa.v1.MarketsPricesReply.average_prices:type_name -> tdexa.v1.AveragePrice
+	38, // 28: tdexa.v1.MarketPrices.market_price:type_name -> tdexa.v1.MarketPrice
+	71, // 29: tdexa.v1.MarketsCandlesRequest.time_range:type_name -> tdexa.v1.TimeRange
+	77, // 30: tdexa.v1.MarketsCandlesRequest.page:type_name -> tdexa.v1.Page
+	0,  // 31: tdexa.v1.MarketsCandlesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	84, // 32: tdexa.v1.MarketsCandlesReply.markets_candles:type_name -> tdexa.v1.MarketsCandlesReply.MarketsCandlesEntry
+	43, // 33: tdexa.v1.MarketCandles.market_candle:type_name -> tdexa.v1.MarketCandle
+	71, // 34: tdexa.v1.GetTotalValueLockedRequest.time_range:type_name -> tdexa.v1.TimeRange
+	0,  // 35: tdexa.v1.GetTotalValueLockedRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	46, // 36: tdexa.v1.GetTotalValueLockedReply.total_value_locked:type_name -> tdexa.v1.TotalValueLocked
+	47, // 37: tdexa.v1.TotalValueLocked.markets:type_name -> tdexa.v1.MarketValueLocked
+	48, // 38: tdexa.v1.TotalValueLocked.providers:type_name -> tdexa.v1.ProviderValueLocked
+	49, // 39: tdexa.v1.TotalValueLocked.assets:type_name -> tdexa.v1.AssetValueLocked
+	71, // 40: tdexa.v1.MarketsVolumesRequest.time_range:type_name -> tdexa.v1.TimeRange
+	0,  // 41: tdexa.v1.MarketsVolumesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	85, // 42: tdexa.v1.MarketsVolumesReply.markets_volumes:type_name -> tdexa.v1.MarketsVolumesReply.MarketsVolumesEntry
+	53, // 43: tdexa.v1.MarketVolumes.market_volume:type_name -> tdexa.v1.MarketVolume
+	71, // 44: tdexa.v1.MarketsSpreadsRequest.time_range:type_name -> tdexa.v1.TimeRange
+	0,  // 45: tdexa.v1.MarketsSpreadsRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	56, // 46: tdexa.v1.MarketsSpreadsReply.pair_spreads:type_name -> tdexa.v1.PairSpreads
+	57, // 47: tdexa.v1.PairSpreads.spreads:type_name -> tdexa.v1.MarketSpread
+	71, // 48: tdexa.v1.MarketsDeviationsRequest.time_range:type_name -> tdexa.v1.TimeRange
+	0,  // 49: tdexa.v1.MarketsDeviationsRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	86, // 50: tdexa.v1.MarketsDeviationsReply.markets_deviations:type_name -> tdexa.v1.MarketsDeviationsReply.MarketsDeviationsEntry
+	61, // 51: tdexa.v1.MarketDeviations.deviations:type_name -> tdexa.v1.MarketDeviation
+	71, // 52: tdexa.v1.MarketsFeesRequest.time_range:type_name -> tdexa.v1.TimeRange
+	77, // 53: tdexa.v1.MarketsFeesRequest.page:type_name -> tdexa.v1.Page
+	0,  // 54: tdexa.v1.MarketsFeesRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	87, // 55: tdexa.v1.MarketsFeesReply.markets_fees:type_name -> tdexa.v1.MarketsFeesReply.MarketsFeesEntry
+	65, // 56: tdexa.v1.MarketFees.market_fee:type_name -> tdexa.v1.MarketFee
+	71, // 57: tdexa.v1.MarketsDepthRequest.time_range:type_name -> tdexa.v1.TimeRange
+	0,  // 58: tdexa.v1.MarketsDepthRequest.time_frame:type_name -> tdexa.v1.TimeFrame
+	88, // 59: tdexa.v1.MarketsDepthReply.markets_depth:type_name -> tdexa.v1.MarketsDepthReply.MarketsDepthEntry
+	69, // 60: tdexa.v1.MarketDepth.curves:type_name -> tdexa.v1.DepthCurve
+	70, // 61: tdexa.v1.DepthCurve.levels:type_name -> tdexa.v1.DepthLevel
+	1,  // 62: tdexa.v1.TimeRange.predefined_period:type_name -> tdexa.v1.PredefinedPeriod
+	72, // 63: tdexa.v1.TimeRange.custom_period:type_name -> tdexa.v1.CustomPeriod
+	76, // 64: tdexa.v1.ListMarketsRequest.market_providers:type_name -> tdexa.v1.MarketProvider
+	77, // 65: tdexa.v1.ListMarketsRequest.page:type_name -> tdexa.v1.Page
+	75, // 66: tdexa.v1.ListMarketsReply.markets:type_name -> tdexa.v1.MarketIDInfo
+	76, // 67: tdexa.v1.MarketIDInfo.market_provider:type_name -> tdexa.v1.MarketProvider
+	20, // 68: tdexa.v1.MarketsUptimeReply.MarketsUptimeEntry.value:type_name -> tdexa.v1.MarketUptime
+	21, // 69: tdexa.v1.MarketsUptimeReply.ProvidersUptimeEntry.value:type_name -> tdexa.v1.ProviderUptime
+	26, // 70: tdexa.v1.MarketsStatusReply.MarketsStatusEntry.value:type_name -> tdexa.v1.MarketStatus
+	28, // 71: tdexa.v1.MarketsStatusReply.ProvidersCircuitBreakerEntry.value:type_name -> tdexa.v1.CircuitBreaker
+	33, // 72: tdexa.v1.MarketsBalancesReply.MarketsBalancesEntry.value:type_name -> tdexa.v1.MarketBalances
+	37, // 73: tdexa.v1.MarketsPricesReply.MarketsPricesEntry.value:type_name -> tdexa.v1.MarketPrices
+	42, // 74: tdexa.v1.MarketsCandlesReply.MarketsCandlesEntry.value:type_name -> tdexa.v1.MarketCandles
+	52, // 75: tdexa.v1.MarketsVolumesReply.MarketsVolumesEntry.value:type_name -> tdexa.v1.MarketVolumes
+	60, // 76: tdexa.v1.MarketsDeviationsReply.MarketsDeviationsEntry.value:type_name -> tdexa.v1.MarketDeviations
+	64, // 77: tdexa.v1.MarketsFeesReply.MarketsFeesEntry.value:type_name -> tdexa.v1.MarketFees
+	68, // 78: tdexa.v1.MarketsDepthReply.MarketsDepthEntry.value:type_name -> tdexa.v1.MarketDepth
+	31, // 79: tdexa.v1.Analytics.MarketsBalances:input_type -> tdexa.v1.MarketsBalancesRequest
+	35, // 80: tdexa.v1.Analytics.MarketsPrices:input_type -> tdexa.v1.MarketsPricesRequest
+	40, // 81: tdexa.v1.Analytics.MarketsCandles:input_type -> tdexa.v1.MarketsCandlesRequest
+	44, // 82: tdexa.v1.Analytics.GetTotalValueLocked:input_type -> tdexa.v1.GetTotalValueLockedRequest
+	50, // 83: tdexa.v1.Analytics.MarketsVolumes:input_type -> tdexa.v1.MarketsVolumesRequest
+	54, // 84: tdexa.v1.Analytics.MarketsSpreads:input_type -> tdexa.v1.MarketsSpreadsRequest
+	58, // 85: tdexa.v1.Analytics.MarketsDeviations:input_type -> tdexa.v1.MarketsDeviationsRequest
+	62, // 86: tdexa.v1.Analytics.MarketsFees:input_type -> tdexa.v1.MarketsFeesRequest
+	66, // 87: tdexa.v1.Analytics.MarketsDepth:input_type -> tdexa.v1.MarketsDepthRequest
+	4,  // 88: tdexa.v1.Analytics.SubscribeMarkets:input_type -> tdexa.v1.SubscribeMarketsRequest
+	6,  // 89: tdexa.v1.Analytics.CreateAlertRule:input_type -> tdexa.v1.CreateAlertRuleRequest
+	8,  // 90: tdexa.v1.Analytics.UpdateAlertRule:input_type -> tdexa.v1.UpdateAlertRuleRequest
+	10, // 91: tdexa.v1.Analytics.DeleteAlertRule:input_type -> tdexa.v1.DeleteAlertRuleRequest
+	12, // 92: tdexa.v1.Analytics.ListAlertRules:input_type -> tdexa.v1.ListAlertRulesRequest
+	14, // 93: tdexa.v1.Analytics.ListAlertDeliveries:input_type -> tdexa.v1.ListAlertDeliveriesRequest
+	18, // 94: tdexa.v1.Analytics.MarketsUptime:input_type -> tdexa.v1.MarketsUptimeRequest
+	24, // 95: tdexa.v1.Analytics.MarketsStatus:input_type -> tdexa.v1.MarketsStatusRequest
+	29, // 96: tdexa.v1.Analytics.LeaderStatus:input_type -> tdexa.v1.LeaderStatusRequest
+	73, // 97: tdexa.v1.Analytics.ListMarkets:input_type -> tdexa.v1.ListMarketsRequest
+	32, // 98: tdexa.v1.Analytics.MarketsBalances:output_type -> tdexa.v1.MarketsBalancesReply
+	36, // 99: tdexa.v1.Analytics.MarketsPrices:output_type -> tdexa.v1.MarketsPricesReply
+	41, // 100: tdexa.v1.Analytics.MarketsCandles:output_type -> tdexa.v1.MarketsCandlesReply
+	45, // 101: tdexa.v1.Analytics.GetTotalValueLocked:output_type -> tdexa.v1.GetTotalValueLockedReply
+	51, // 102: tdexa.v1.Analytics.MarketsVolumes:output_type -> tdexa.v1.MarketsVolumesReply
+	55, // 103: tdexa.v1.Analytics.MarketsSpreads:output_type -> tdexa.v1.MarketsSpreadsReply
+	59, // 104: tdexa.v1.Analytics.MarketsDeviations:output_type -> tdexa.v1.MarketsDeviationsReply
+	63, // 105: tdexa.v1.Analytics.MarketsFees:output_type -> tdexa.v1.MarketsFeesReply
+	67, // 106: tdexa.v1.Analytics.MarketsDepth:output_type -> tdexa.v1.MarketsDepthReply
+	5,  // 107: tdexa.v1.Analytics.SubscribeMarkets:output_type -> tdexa.v1.SubscribeMarketsReply
+	7,  // 108: tdexa.v1.Analytics.CreateAlertRule:output_type -> tdexa.v1.CreateAlertRuleReply
+	9,  // 109: tdexa.v1.Analytics.UpdateAlertRule:output_type -> tdexa.v1.UpdateAlertRuleReply
+	11, // 110: tdexa.v1.Analytics.DeleteAlertRule:output_type -> tdexa.v1.DeleteAlertRuleReply
+	13, // 111: tdexa.v1.Analytics.ListAlertRules:output_type -> tdexa.v1.ListAlertRulesReply
+	15, // 112: tdexa.v1.Analytics.ListAlertDeliveries:output_type -> tdexa.v1.ListAlertDeliveriesReply
+	19, // 113: tdexa.v1.Analytics.MarketsUptime:output_type -> tdexa.v1.MarketsUptimeReply
+	25, // 114: tdexa.v1.Analytics.MarketsStatus:output_type -> tdexa.v1.MarketsStatusReply
+	30, // 115: tdexa.v1.Analytics.LeaderStatus:output_type -> tdexa.v1.LeaderStatusReply
+	74, // 116: tdexa.v1.Analytics.ListMarkets:output_type -> tdexa.v1.ListMarketsReply
+	98, // [98:117] is the sub-list for method output_type
+	79, // [79:98] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
+}
+
+func init() { file_tdexa_v1_analytics_proto_init() }
+func file_tdexa_v1_analytics_proto_init() {
+	if File_tdexa_v1_analytics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tdexa_v1_analytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMarketsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAlertRuleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAlertRuleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAlertRuleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAlertRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAlertRulesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAlertDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAlertDeliveriesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsUptimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsUptimeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketUptime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderUptime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Uptime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsBalancesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketBalances); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsPricesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AveragePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsCandlesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketCandles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketCandle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTotalValueLockedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTotalValueLockedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalValueLocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketValueLocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderValueLocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetValueLocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsVolumesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsVolumesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketVolumes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketVolume); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1403,8 +6900,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsBalancesReply); i {
+		file_tdexa_v1_analytics_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsSpreadsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1415,8 +6912,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketBalances); i {
+		file_tdexa_v1_analytics_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsSpreadsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1427,8 +6924,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketBalance); i {
+		file_tdexa_v1_analytics_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairSpreads); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1439,8 +6936,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsPricesRequest); i {
+		file_tdexa_v1_analytics_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketSpread); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1451,8 +6948,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsPricesReply); i {
+		file_tdexa_v1_analytics_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsDeviationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1463,8 +6960,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketPrices); i {
+		file_tdexa_v1_analytics_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsDeviationsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1475,8 +6972,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketPrice); i {
+		file_tdexa_v1_analytics_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDeviations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1487,8 +6984,8 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AveragePrice); i {
+		file_tdexa_v1_analytics_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDeviation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,7 +6996,115 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsFeesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsDepthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsDepthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDepth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepthCurve); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepthLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdexa_v1_analytics_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeRange); i {
 			case 0:
 				return &v.state
@@ -1511,7 +7116,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CustomPeriod); i {
 			case 0:
 				return &v.state
@@ -1523,7 +7128,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListMarketsRequest); i {
 			case 0:
 				return &v.state
@@ -1535,7 +7140,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListMarketsReply); i {
 			case 0:
 				return &v.state
@@ -1547,7 +7152,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketIDInfo); i {
 			case 0:
 				return &v.state
@@ -1559,7 +7164,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketProvider); i {
 			case 0:
 				return &v.state
@@ -1571,7 +7176,7 @@ func file_tdexa_v1_analytics_proto_init() {
 				return nil
 			}
 		}
-		file_tdexa_v1_analytics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_tdexa_v1_analytics_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Page); i {
 			case 0:
 				return &v.state
@@ -1584,13 +7189,18 @@ func file_tdexa_v1_analytics_proto_init() {
 			}
 		}
 	}
+	file_tdexa_v1_analytics_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*SubscribeMarketsReply_Price)(nil),
+		(*SubscribeMarketsReply_Balance)(nil),
+	}
+	file_tdexa_v1_analytics_proto_msgTypes[50].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tdexa_v1_analytics_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   18,
+			NumEnums:      4,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,53 @@ type AnalyticsClient interface {
 	MarketsBalances(ctx context.Context, in *MarketsBalancesRequest, opts ...grpc.CallOption) (*MarketsBalancesReply, error)
 	// returns all markets and its prices in time series
 	MarketsPrices(ctx context.Context, in *MarketsPricesRequest, opts ...grpc.CallOption) (*MarketsPricesReply, error)
+	// returns all markets and their open, high, low and close prices grouped
+	// by time_frame in time series
+	MarketsCandles(ctx context.Context, in *MarketsCandlesRequest, opts ...grpc.CallOption) (*MarketsCandlesReply, error)
+	// returns value locked in markets converted to reference currency, per
+	// market, provider, asset and network-wide
+	GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedRequest, opts ...grpc.CallOption) (*GetTotalValueLockedReply, error)
+	// returns trade volume and trade count, inferred from consecutive market
+	// balances, grouped by time_frame in time series
+	MarketsVolumes(ctx context.Context, in *MarketsVolumesRequest, opts ...grpc.CallOption) (*MarketsVolumesReply, error)
+	// returns best bid/ask spread across providers of markets with the same
+	// asset pair, grouped by time_frame in time series
+	MarketsSpreads(ctx context.Context, in *MarketsSpreadsRequest, opts ...grpc.CallOption) (*MarketsSpreadsReply, error)
+	// returns deviation of markets prices from external reference rates in
+	// time series, with summary stats per market
+	MarketsDeviations(ctx context.Context, in *MarketsDeviationsRequest, opts ...grpc.CallOption) (*MarketsDeviationsReply, error)
+	// returns fees charged by markets in time series, sampled from providers
+	// supporting v2 protocol
+	MarketsFees(ctx context.Context, in *MarketsFeesRequest, opts ...grpc.CallOption) (*MarketsFeesReply, error)
+	// returns depth curves of markets, that is the effective price of buying
+	// and selling several amounts of base asset and its slippage
+	MarketsDepth(ctx context.Context, in *MarketsDepthRequest, opts ...grpc.CallOption) (*MarketsDepthReply, error)
+	// streams prices and balances of markets as soon as they are recorded
+	SubscribeMarkets(ctx context.Context, in *SubscribeMarketsRequest, opts ...grpc.CallOption) (Analytics_SubscribeMarketsClient, error)
+	// creates a rule notified with a webhook every time it starts being met
+	// requires the admin token in the authorization metadata
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleReply, error)
+	// replaces a rule, its triggered state is reset
+	// requires the admin token in the authorization metadata
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleReply, error)
+	// deletes a rule together with its delivery log
+	// requires the admin token in the authorization metadata
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleReply, error)
+	// returns alert rules, without their secrets
+	// requires the admin token in the authorization metadata
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesReply, error)
+	// returns the log of webhooks delivered for a rule
+	// requires the admin token in the authorization metadata
+	ListAlertDeliveries(ctx context.Context, in *ListAlertDeliveriesRequest, opts ...grpc.CallOption) (*ListAlertDeliveriesReply, error)
+	// returns uptime percentage, outages and first/last seen dates of markets
+	// and of their providers in a time range
+	MarketsUptime(ctx context.Context, in *MarketsUptimeRequest, opts ...grpc.CallOption) (*MarketsUptimeReply, error)
+	// returns, for every job fetching data of markets, the last success, the
+	// last error, the consecutive failures and the protocol path that worked
+	MarketsStatus(ctx context.Context, in *MarketsStatusRequest, opts ...grpc.CallOption) (*MarketsStatusReply, error)
+	// returns the replica running the jobs ingesting markets data, any replica
+	// answers, only the leader runs the jobs
+	LeaderStatus(ctx context.Context, in *LeaderStatusRequest, opts ...grpc.CallOption) (*LeaderStatusReply, error)
 	// return market id's to be used, if needed, as filter for MarketsBalances/MarketsPrices rpcs
 	ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsReply, error)
 }
@@ -56,6 +103,173 @@ func (c *analyticsClient) MarketsPrices(ctx context.Context, in *MarketsPricesRe
 	return out, nil
 }
 
+func (c *analyticsClient) MarketsCandles(ctx context.Context, in *MarketsCandlesRequest, opts ...grpc.CallOption) (*MarketsCandlesReply, error) {
+	out := new(MarketsCandlesReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedRequest, opts ...grpc.CallOption) (*GetTotalValueLockedReply, error) {
+	out := new(GetTotalValueLockedReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/GetTotalValueLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsVolumes(ctx context.Context, in *MarketsVolumesRequest, opts ...grpc.CallOption) (*MarketsVolumesReply, error) {
+	out := new(MarketsVolumesReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsSpreads(ctx context.Context, in *MarketsSpreadsRequest, opts ...grpc.CallOption) (*MarketsSpreadsReply, error) {
+	out := new(MarketsSpreadsReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsSpreads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsDeviations(ctx context.Context, in *MarketsDeviationsRequest, opts ...grpc.CallOption) (*MarketsDeviationsReply, error) {
+	out := new(MarketsDeviationsReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsDeviations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsFees(ctx context.Context, in *MarketsFeesRequest, opts ...grpc.CallOption) (*MarketsFeesReply, error) {
+	out := new(MarketsFeesReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsDepth(ctx context.Context, in *MarketsDepthRequest, opts ...grpc.CallOption) (*MarketsDepthReply, error) {
+	out := new(MarketsDepthReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) SubscribeMarkets(ctx context.Context, in *SubscribeMarketsRequest, opts ...grpc.CallOption) (Analytics_SubscribeMarketsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Analytics_ServiceDesc.Streams[0], "/tdexa.v1.Analytics/SubscribeMarkets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &analyticsSubscribeMarketsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Analytics_SubscribeMarketsClient interface {
+	Recv() (*SubscribeMarketsReply, error)
+	grpc.ClientStream
+}
+
+type analyticsSubscribeMarketsClient struct {
+	grpc.ClientStream
+}
+
+func (x *analyticsSubscribeMarketsClient) Recv() (*SubscribeMarketsReply, error) {
+	m := new(SubscribeMarketsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *analyticsClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleReply, error) {
+	out := new(CreateAlertRuleReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/CreateAlertRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleReply, error) {
+	out := new(UpdateAlertRuleReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/UpdateAlertRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleReply, error) {
+	out := new(DeleteAlertRuleReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/DeleteAlertRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesReply, error) {
+	out := new(ListAlertRulesReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/ListAlertRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) ListAlertDeliveries(ctx context.Context, in *ListAlertDeliveriesRequest, opts ...grpc.CallOption) (*ListAlertDeliveriesReply, error) {
+	out := new(ListAlertDeliveriesReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/ListAlertDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsUptime(ctx context.Context, in *MarketsUptimeRequest, opts ...grpc.CallOption) (*MarketsUptimeReply, error) {
+	out := new(MarketsUptimeReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) MarketsStatus(ctx context.Context, in *MarketsStatusRequest, opts ...grpc.CallOption) (*MarketsStatusReply, error) {
+	out := new(MarketsStatusReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/MarketsStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) LeaderStatus(ctx context.Context, in *LeaderStatusRequest, opts ...grpc.CallOption) (*LeaderStatusReply, error) {
+	out := new(LeaderStatusReply)
+	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/LeaderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyticsClient) ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsReply, error) {
 	out := new(ListMarketsReply)
 	err := c.cc.Invoke(ctx, "/tdexa.v1.Analytics/ListMarkets", in, out, opts...)
@@ -73,6 +287,53 @@ type AnalyticsServer interface {
 	MarketsBalances(context.Context, *MarketsBalancesRequest) (*MarketsBalancesReply, error)
 	// returns all markets and its prices in time series
 	MarketsPrices(context.Context, *MarketsPricesRequest) (*MarketsPricesReply, error)
+	// returns all markets and their open, high, low and close prices grouped
+	// by time_frame in time series
+	MarketsCandles(context.Context, *MarketsCandlesRequest) (*MarketsCandlesReply, error)
+	// returns value locked in markets converted to reference currency, per
+	// market, provider, asset and network-wide
+	GetTotalValueLocked(context.Context, *GetTotalValueLockedRequest) (*GetTotalValueLockedReply, error)
+	// returns trade volume and trade count, inferred from consecutive market
+	// balances, grouped by time_frame in time series
+	MarketsVolumes(context.Context, *MarketsVolumesRequest) (*MarketsVolumesReply, error)
+	// returns best bid/ask spread across providers of markets with the same
+	// asset pair, grouped by time_frame in time series
+	MarketsSpreads(context.Context, *MarketsSpreadsRequest) (*MarketsSpreadsReply, error)
+	// returns deviation of markets prices from external reference rates in
+	// time series, with summary stats per market
+	MarketsDeviations(context.Context, *MarketsDeviationsRequest) (*MarketsDeviationsReply, error)
+	// returns fees charged by markets in time series, sampled from providers
+	// supporting v2 protocol
+	MarketsFees(context.Context, *MarketsFeesRequest) (*MarketsFeesReply, error)
+	// returns depth curves of markets, that is the effective price of buying
+	// and selling several amounts of base asset and its slippage
+	MarketsDepth(context.Context, *MarketsDepthRequest) (*MarketsDepthReply, error)
+	// streams prices and balances of markets as soon as they are recorded
+	SubscribeMarkets(*SubscribeMarketsRequest, Analytics_SubscribeMarketsServer) error
+	// creates a rule notified with a webhook every time it starts being met
+	// requires the admin token in the authorization metadata
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleReply, error)
+	// replaces a rule, its triggered state is reset
+	// requires the admin token in the authorization metadata
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleReply, error)
+	// deletes a rule together with its delivery log
+	// requires the admin token in the authorization metadata
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleReply, error)
+	// returns alert rules, without their secrets
+	// requires the admin token in the authorization metadata
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesReply, error)
+	// returns the log of webhooks delivered for a rule
+	// requires the admin token in the authorization metadata
+	ListAlertDeliveries(context.Context, *ListAlertDeliveriesRequest) (*ListAlertDeliveriesReply, error)
+	// returns uptime percentage, outages and first/last seen dates of markets
+	// and of their providers in a time range
+	MarketsUptime(context.Context, *MarketsUptimeRequest) (*MarketsUptimeReply, error)
+	// returns, for every job fetching data of markets, the last success, the
+	// last error, the consecutive failures and the protocol path that worked
+	MarketsStatus(context.Context, *MarketsStatusRequest) (*MarketsStatusReply, error)
+	// returns the replica running the jobs ingesting markets data, any replica
+	// answers, only the leader runs the jobs
+	LeaderStatus(context.Context, *LeaderStatusRequest) (*LeaderStatusReply, error)
 	// return market id's to be used, if needed, as filter for MarketsBalances/MarketsPrices rpcs
 	ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsReply, error)
 }
@@ -87,6 +348,54 @@ func (UnimplementedAnalyticsServer) MarketsBalances(context.Context, *MarketsBal
 func (UnimplementedAnalyticsServer) MarketsPrices(context.Context, *MarketsPricesRequest) (*MarketsPricesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketsPrices not implemented")
 }
+func (UnimplementedAnalyticsServer) MarketsCandles(context.Context, *MarketsCandlesRequest) (*MarketsCandlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsCandles not implemented")
+}
+func (UnimplementedAnalyticsServer) GetTotalValueLocked(context.Context, *GetTotalValueLockedRequest) (*GetTotalValueLockedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalValueLocked not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsVolumes(context.Context, *MarketsVolumesRequest) (*MarketsVolumesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsVolumes not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsSpreads(context.Context, *MarketsSpreadsRequest) (*MarketsSpreadsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsSpreads not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsDeviations(context.Context, *MarketsDeviationsRequest) (*MarketsDeviationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsDeviations not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsFees(context.Context, *MarketsFeesRequest) (*MarketsFeesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsFees not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsDepth(context.Context, *MarketsDepthRequest) (*MarketsDepthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsDepth not implemented")
+}
+func (UnimplementedAnalyticsServer) SubscribeMarkets(*SubscribeMarketsRequest, Analytics_SubscribeMarketsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMarkets not implemented")
+}
+func (UnimplementedAnalyticsServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServer) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedAnalyticsServer) ListAlertDeliveries(context.Context, *ListAlertDeliveriesRequest) (*ListAlertDeliveriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertDeliveries not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsUptime(context.Context, *MarketsUptimeRequest) (*MarketsUptimeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsUptime not implemented")
+}
+func (UnimplementedAnalyticsServer) MarketsStatus(context.Context, *MarketsStatusRequest) (*MarketsStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsStatus not implemented")
+}
+func (UnimplementedAnalyticsServer) LeaderStatus(context.Context, *LeaderStatusRequest) (*LeaderStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaderStatus not implemented")
+}
 func (UnimplementedAnalyticsServer) ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMarkets not implemented")
 }
@@ -138,6 +447,297 @@ func _Analytics_MarketsPrices_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Analytics_MarketsCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsCandles(ctx, req.(*MarketsCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_GetTotalValueLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalValueLockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetTotalValueLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/GetTotalValueLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetTotalValueLocked(ctx, req.(*GetTotalValueLockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsVolumes(ctx, req.(*MarketsVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsSpreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsSpreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsSpreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsSpreads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsSpreads(ctx, req.(*MarketsSpreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsDeviationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsDeviations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsDeviations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsDeviations(ctx, req.(*MarketsDeviationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsFees(ctx, req.(*MarketsFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsDepth(ctx, req.(*MarketsDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_SubscribeMarkets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMarketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnalyticsServer).SubscribeMarkets(m, &analyticsSubscribeMarketsServer{stream})
+}
+
+type Analytics_SubscribeMarketsServer interface {
+	Send(*SubscribeMarketsReply) error
+	grpc.ServerStream
+}
+
+type analyticsSubscribeMarketsServer struct {
+	grpc.ServerStream
+}
+
+func (x *analyticsSubscribeMarketsServer) Send(m *SubscribeMarketsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Analytics_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/CreateAlertRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/UpdateAlertRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/DeleteAlertRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/ListAlertRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_ListAlertDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).ListAlertDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/ListAlertDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).ListAlertDeliveries(ctx, req.(*ListAlertDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsUptime(ctx, req.(*MarketsUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_MarketsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).MarketsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/MarketsStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).MarketsStatus(ctx, req.(*MarketsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_LeaderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).LeaderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdexa.v1.Analytics/LeaderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).LeaderStatus(ctx, req.(*LeaderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Analytics_ListMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMarketsRequest)
 	if err := dec(in); err != nil {
@@ -171,11 +771,77 @@ var Analytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarketsPrices",
 			Handler:    _Analytics_MarketsPrices_Handler,
 		},
+		{
+			MethodName: "MarketsCandles",
+			Handler:    _Analytics_MarketsCandles_Handler,
+		},
+		{
+			MethodName: "GetTotalValueLocked",
+			Handler:    _Analytics_GetTotalValueLocked_Handler,
+		},
+		{
+			MethodName: "MarketsVolumes",
+			Handler:    _Analytics_MarketsVolumes_Handler,
+		},
+		{
+			MethodName: "MarketsSpreads",
+			Handler:    _Analytics_MarketsSpreads_Handler,
+		},
+		{
+			MethodName: "MarketsDeviations",
+			Handler:    _Analytics_MarketsDeviations_Handler,
+		},
+		{
+			MethodName: "MarketsFees",
+			Handler:    _Analytics_MarketsFees_Handler,
+		},
+		{
+			MethodName: "MarketsDepth",
+			Handler:    _Analytics_MarketsDepth_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Analytics_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _Analytics_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Analytics_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Analytics_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "ListAlertDeliveries",
+			Handler:    _Analytics_ListAlertDeliveries_Handler,
+		},
+		{
+			MethodName: "MarketsUptime",
+			Handler:    _Analytics_MarketsUptime_Handler,
+		},
+		{
+			MethodName: "MarketsStatus",
+			Handler:    _Analytics_MarketsStatus_Handler,
+		},
+		{
+			MethodName: "LeaderStatus",
+			Handler:    _Analytics_LeaderStatus_Handler,
+		},
 		{
 			MethodName: "ListMarkets",
 			Handler:    _Analytics_ListMarkets_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeMarkets",
+			Handler:       _Analytics_SubscribeMarkets_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "tdexa/v1/analytics.proto",
 }
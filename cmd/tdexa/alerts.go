@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	alertRuleTypes = map[string]tdexav1.AlertRuleType{
+		"balance_below":   tdexav1.AlertRuleType_ALERT_RULE_TYPE_BALANCE_BELOW,
+		"price_change":    tdexav1.AlertRuleType_ALERT_RULE_TYPE_PRICE_CHANGE,
+		"market_inactive": tdexav1.AlertRuleType_ALERT_RULE_TYPE_MARKET_INACTIVE,
+	}
+	alertAssets = map[string]tdexav1.AlertAsset{
+		"":      tdexav1.AlertAsset_ALERT_ASSET_NULL,
+		"base":  tdexav1.AlertAsset_ALERT_ASSET_BASE,
+		"quote": tdexav1.AlertAsset_ALERT_ASSET_QUOTE,
+	}
+
+	alertRuleFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     "market_id",
+			Usage:    "market the rule is evaluated for",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "type",
+			Usage:    "balance_below, price_change or market_inactive",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "base or quote, asset whose balance is checked by balance_below rules",
+		},
+		&cli.Float64Flag{
+			Name:  "threshold",
+			Usage: "satoshis for balance_below rules, percent of quote price change for price_change rules",
+		},
+		&cli.Int64Flag{
+			Name:  "window_minutes",
+			Usage: "window over which price_change rules measure the change",
+		},
+		&cli.StringFlag{
+			Name:     "webhook_url",
+			Usage:    "url alerts are posted to",
+			Required: true,
+		},
+	}
+
+	alertsCmd = &cli.Command{
+		Name: "alerts",
+		Usage: "manage price and balance alerts notified with webhooks, " +
+			"requires the admin token set with `config set admin_token`",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "create",
+				Usage:  "create an alert rule",
+				Action: createAlertRuleAction,
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "secret",
+						Usage:    "secret used to sign alerts in the X-Tdexa-Signature header",
+						Required: true,
+					},
+				}, alertRuleFlags...),
+			},
+			{
+				Name:   "update",
+				Usage:  "replace an alert rule",
+				Action: updateAlertRuleAction,
+				Flags: append([]cli.Flag{
+					&cli.Int64Flag{
+						Name:     "id",
+						Usage:    "id of the rule to update",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "secret",
+						Usage: "secret used to sign alerts, the current one is kept if not set",
+					},
+				}, alertRuleFlags...),
+			},
+			{
+				Name:   "delete",
+				Usage:  "delete an alert rule",
+				Action: deleteAlertRuleAction,
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "id",
+						Usage:    "id of the rule to delete",
+						Required: true,
+					},
+				},
+			},
+			{
+				Name:   "list",
+				Usage:  "list alert rules",
+				Action: listAlertRulesAction,
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:  "market_id",
+						Usage: "market_id to list rules for",
+					},
+				},
+			},
+			{
+				Name:   "deliveries",
+				Usage:  "list webhooks delivered for an alert rule, latest first",
+				Action: listAlertDeliveriesAction,
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "id",
+						Usage:    "id of the rule",
+						Required: true,
+					},
+					&cli.Uint64Flag{
+						Name:  "page_num",
+						Usage: "the number of the page to be listed",
+						Value: 1,
+					},
+					&cli.Uint64Flag{
+						Name:  "page_size",
+						Usage: "the size of the page",
+						Value: 10,
+					},
+				},
+			},
+		},
+	}
+)
+
+func createAlertRuleAction(ctx *cli.Context) error {
+	rule, err := parseAlertRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adminCtx, err := getAdminContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CreateAlertRule(
+		adminCtx,
+		&tdexav1.CreateAlertRuleRequest{
+			Rule: rule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func updateAlertRuleAction(ctx *cli.Context) error {
+	rule, err := parseAlertRule(ctx)
+	if err != nil {
+		return err
+	}
+	rule.Id = ctx.Int64("id")
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adminCtx, err := getAdminContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.UpdateAlertRule(
+		adminCtx,
+		&tdexav1.UpdateAlertRuleRequest{
+			Rule: rule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func deleteAlertRuleAction(ctx *cli.Context) error {
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adminCtx, err := getAdminContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteAlertRule(
+		adminCtx,
+		&tdexav1.DeleteAlertRuleRequest{
+			Id: ctx.Int64("id"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func listAlertRulesAction(ctx *cli.Context) error {
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adminCtx, err := getAdminContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListAlertRules(
+		adminCtx,
+		&tdexav1.ListAlertRulesRequest{
+			MarketIds: ctx.StringSlice("market_id"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func listAlertDeliveriesAction(ctx *cli.Context) error {
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adminCtx, err := getAdminContext()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListAlertDeliveries(
+		adminCtx,
+		&tdexav1.ListAlertDeliveriesRequest{
+			RuleId: ctx.Int64("id"),
+			Page: &tdexav1.Page{
+				PageNumber: ctx.Int64("page_num"),
+				PageSize:   ctx.Int64("page_size"),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func parseAlertRule(ctx *cli.Context) (*tdexav1.AlertRule, error) {
+	ruleType, ok := alertRuleTypes[ctx.String("type")]
+	if !ok {
+		return nil, fmt.Errorf("unknown alert rule type %s", ctx.String("type"))
+	}
+
+	asset, ok := alertAssets[ctx.String("asset")]
+	if !ok {
+		return nil, fmt.Errorf("unknown alert asset %s", ctx.String("asset"))
+	}
+
+	return &tdexav1.AlertRule{
+		MarketId:      ctx.String("market_id"),
+		Type:          ruleType,
+		Asset:         asset,
+		Threshold:     ctx.Float64("threshold"),
+		WindowMinutes: ctx.Int64("window_minutes"),
+		WebhookUrl:    ctx.String("webhook_url"),
+		Secret:        ctx.String("secret"),
+	}, nil
+}
+
+// getAdminContext returns the context carrying the admin token required by
+// the RPCs managing alert rules
+func getAdminContext() (context.Context, error) {
+	state, err := getState()
+	if err != nil {
+		return nil, err
+	}
+	token, ok := state["admin_token"]
+	if !ok {
+		return nil, errors.New("set admin_token with `config set admin_token`")
+	}
+
+	return metadata.AppendToOutgoingContext(
+		context.Background(), "authorization", "Bearer "+token,
+	), nil
+}
@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	tdexav2 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v2"
 	"github.com/urfave/cli/v2"
 )
 
@@ -50,31 +50,31 @@ var listBalancesCmd = &cli.Command{
 func listBalancesAction(ctx *cli.Context) error {
 	marketIDs := ctx.StringSlice("market_id")
 
-	var customPeriod *tdexav1.CustomPeriod
+	var customPeriod *tdexav2.CustomPeriod
 	start := ctx.String("start")
 	end := ctx.String("end")
 	if start != "" && end != "" {
-		customPeriod = &tdexav1.CustomPeriod{
+		customPeriod = &tdexav2.CustomPeriod{
 			StartDate: start,
 			EndDate:   end,
 		}
 	}
 
-	var predefinedPeriod tdexav1.PredefinedPeriod
+	var predefinedPeriod tdexav2.PredefinedPeriod
 	pp := ctx.Int("predefined_period")
 	if pp > 0 {
-		predefinedPeriod = tdexav1.PredefinedPeriod(pp)
+		predefinedPeriod = tdexav2.PredefinedPeriod(pp)
 	}
 
 	pageNum := ctx.Int64("page_num")
 	pageSize := ctx.Int64("page_size")
-	page := &tdexav1.Page{
+	page := &tdexav2.Page{
 		PageNumber: pageNum,
 		PageSize:   pageSize,
 	}
 
-	req := &tdexav1.MarketsBalancesRequest{
-		TimeRange: &tdexav1.TimeRange{
+	req := &tdexav2.MarketsBalancesRequest{
+		TimeRange: &tdexav2.TimeRange{
 			PredefinedPeriod: predefinedPeriod,
 			CustomPeriod:     customPeriod,
 		},
@@ -82,7 +82,7 @@ func listBalancesAction(ctx *cli.Context) error {
 		Page:      page,
 	}
 
-	client, cleanup, err := getAnalyticsClient()
+	client, cleanup, err := getAnalyticsV2Client()
 	if err != nil {
 		return err
 	}
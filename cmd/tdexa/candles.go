@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var listCandlesCmd = &cli.Command{
+	Name:   "candles",
+	Usage:  "list open, high, low and close prices",
+	Action: listCandlesAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "fetch candles from specific time in the past, please provide end flag also",
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "fetch candles from specific time in the past til end date, use with start flag",
+		},
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to fetch candles for",
+		},
+		&cli.IntFlag{
+			Name: "predefined_period",
+			Usage: "time predefined periods:\n" +
+				"       1 -> last hour\n" +
+				"       2 -> last day\n" +
+				"       3 -> last month\n" +
+				"       4 -> last 3 months\n" +
+				"       5 -> year to date\n" +
+				"       6 -> all",
+			Value: 3,
+		},
+		&cli.IntFlag{
+			Name: "time_frame",
+			Usage: "size of the bucket every candle is built from:\n" +
+				"       1 -> hour\n" +
+				"       2 -> four hours\n" +
+				"       3 -> day\n" +
+				"       4 -> week\n" +
+				"       5 -> month",
+			Value: 3,
+		},
+		&cli.StringFlag{
+			Name:  "reference_currency",
+			Usage: "fiat currency to which candle prices will be converted",
+		},
+		&cli.Uint64Flag{
+			Name:  "page_num",
+			Usage: "the number of the page to be listed. If omitted, the entire list is returned",
+			Value: 1,
+		},
+		&cli.Uint64Flag{
+			Name:  "page_size",
+			Usage: "the size of the page",
+			Value: 10,
+		},
+	},
+}
+
+func listCandlesAction(ctx *cli.Context) error {
+	marketIDs := ctx.StringSlice("market_id")
+
+	var customPeriod *tdexav1.CustomPeriod
+	start := ctx.String("start")
+	end := ctx.String("end")
+	if start != "" && end != "" {
+		customPeriod = &tdexav1.CustomPeriod{
+			StartDate: start,
+			EndDate:   end,
+		}
+	}
+
+	var predefinedPeriod tdexav1.PredefinedPeriod
+	pp := ctx.Int("predefined_period")
+	if pp > 0 && customPeriod == nil {
+		predefinedPeriod = tdexav1.PredefinedPeriod(pp)
+	}
+
+	pageNum := ctx.Int64("page_num")
+	pageSize := ctx.Int64("page_size")
+	page := &tdexav1.Page{
+		PageNumber: pageNum,
+		PageSize:   pageSize,
+	}
+
+	req := &tdexav1.MarketsCandlesRequest{
+		TimeRange: &tdexav1.TimeRange{
+			PredefinedPeriod: predefinedPeriod,
+			CustomPeriod:     customPeriod,
+		},
+		MarketIds:         marketIDs,
+		ReferenceCurrency: ctx.String("reference_currency"),
+		Page:              page,
+		TimeFrame:         tdexav1.TimeFrame(ctx.Int("time_frame")),
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.MarketsCandles(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var listDepthCmd = &cli.Command{
+	Name:   "depth",
+	Usage:  "list depth curves with effective price and slippage of several trade sizes",
+	Action: listDepthAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "fetch depth from specific time in the past, please provide end flag also",
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "fetch depth from specific time in the past til end date, use with start flag",
+		},
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to fetch depth for",
+		},
+		&cli.IntFlag{
+			Name: "predefined_period",
+			Usage: "time predefined periods:\n" +
+				"       1 -> last hour\n" +
+				"       2 -> last day\n" +
+				"       3 -> last month\n" +
+				"       4 -> last 3 months\n" +
+				"       5 -> year to date\n" +
+				"       6 -> all",
+			Value: 2,
+		},
+		&cli.IntFlag{
+			Name: "time_frame",
+			Usage: "used to average depth samples:\n" +
+				"       1 -> hour\n" +
+				"       2 -> four hours\n" +
+				"       3 -> day\n" +
+				"       4 -> week\n" +
+				"       5 -> month",
+			Value: 1,
+		},
+	},
+}
+
+func listDepthAction(ctx *cli.Context) error {
+	marketIDs := ctx.StringSlice("market_id")
+
+	var customPeriod *tdexav1.CustomPeriod
+	start := ctx.String("start")
+	end := ctx.String("end")
+	if start != "" && end != "" {
+		customPeriod = &tdexav1.CustomPeriod{
+			StartDate: start,
+			EndDate:   end,
+		}
+	}
+
+	var predefinedPeriod tdexav1.PredefinedPeriod
+	pp := ctx.Int("predefined_period")
+	if pp > 0 && customPeriod == nil {
+		predefinedPeriod = tdexav1.PredefinedPeriod(pp)
+	}
+
+	req := &tdexav1.MarketsDepthRequest{
+		TimeRange: &tdexav1.TimeRange{
+			PredefinedPeriod: predefinedPeriod,
+			CustomPeriod:     customPeriod,
+		},
+		MarketIds: marketIDs,
+		TimeFrame: tdexav1.TimeFrame(ctx.Int("time_frame")),
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.MarketsDepth(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
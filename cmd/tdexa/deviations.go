@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var listDeviationsCmd = &cli.Command{
+	Name:   "deviations",
+	Usage:  "list deviation of markets prices from external reference rates",
+	Action: listDeviationsAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "fetch deviations from specific time in the past, please provide end flag also",
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "fetch deviations from specific time in the past til end date, use with start flag",
+		},
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to calculate deviations for",
+		},
+		&cli.IntFlag{
+			Name: "predefined_period",
+			Usage: "time predefined periods:\n" +
+				"       1 -> last hour\n" +
+				"       2 -> last day\n" +
+				"       3 -> last month\n" +
+				"       4 -> last 3 months\n" +
+				"       5 -> year to date\n" +
+				"       6 -> all",
+			Value: 2,
+		},
+		&cli.IntFlag{
+			Name: "time_frame",
+			Usage: "used to group prices before comparing them with reference rates:\n" +
+				"       1 -> hour\n" +
+				"       2 -> four hours\n" +
+				"       3 -> day\n" +
+				"       4 -> week\n" +
+				"       5 -> month",
+			Value: 1,
+		},
+		&cli.UintFlag{
+			Name:  "tolerance_bps",
+			Usage: "max deviation in basis points before a price is considered off-market, if omitted the daemon default is used",
+		},
+	},
+}
+
+func listDeviationsAction(ctx *cli.Context) error {
+	marketIDs := ctx.StringSlice("market_id")
+
+	var customPeriod *tdexav1.CustomPeriod
+	start := ctx.String("start")
+	end := ctx.String("end")
+	if start != "" && end != "" {
+		customPeriod = &tdexav1.CustomPeriod{
+			StartDate: start,
+			EndDate:   end,
+		}
+	}
+
+	var predefinedPeriod tdexav1.PredefinedPeriod
+	pp := ctx.Int("predefined_period")
+	if pp > 0 && customPeriod == nil {
+		predefinedPeriod = tdexav1.PredefinedPeriod(pp)
+	}
+
+	req := &tdexav1.MarketsDeviationsRequest{
+		TimeRange: &tdexav1.TimeRange{
+			PredefinedPeriod: predefinedPeriod,
+			CustomPeriod:     customPeriod,
+		},
+		MarketIds:    marketIDs,
+		TimeFrame:    tdexav1.TimeFrame(ctx.Int("time_frame")),
+		ToleranceBps: uint32(ctx.Uint("tolerance_bps")),
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.MarketsDeviations(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
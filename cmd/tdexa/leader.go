@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var leaderCmd = &cli.Command{
+	Name:   "leader",
+	Usage:  "show which replica is the leader running the jobs ingesting markets data",
+	Action: leaderAction,
+}
+
+func leaderAction(ctx *cli.Context) error {
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.LeaderStatus(
+		context.Background(), &tdexav1.LeaderStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	tdexav2 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v2"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -37,6 +38,18 @@ func main() {
 		configCmd,
 		listBalancesCmd,
 		listPricesCmd,
+		listCandlesCmd,
+		totalValueLockedCmd,
+		listVolumesCmd,
+		listSpreadsCmd,
+		listDeviationsCmd,
+		listFeesCmd,
+		listDepthCmd,
+		subscribeCmd,
+		alertsCmd,
+		uptimeCmd,
+		statusCmd,
+		leaderCmd,
 		marketsCmd,
 		healthCheckCmd,
 	)
@@ -224,6 +237,21 @@ func getAnalyticsClient() (tdexav1.AnalyticsClient, func(), error) {
 	return tdexav1.NewAnalyticsClient(conn), cleanup, nil
 }
 
+func getAnalyticsV2Client() (tdexav2.AnalyticsClient, func(), error) {
+	creds, err := getCreds()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := getClientConn(creds)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { _ = conn.Close() }
+
+	return tdexav2.NewAnalyticsClient(conn), cleanup, nil
+}
+
 func getHealthClient() (grpchealth.HealthClient, func(), error) {
 	creds, err := getCreds()
 	if err != nil {
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var statusCmd = &cli.Command{
+	Name:   "status",
+	Usage:  "show last success, last error and consecutive failures of the jobs fetching markets data",
+	Action: statusAction,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to show status for",
+		},
+	},
+}
+
+func statusAction(ctx *cli.Context) error {
+	req := &tdexav1.MarketsStatusRequest{
+		MarketIds: ctx.StringSlice("market_id"),
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.MarketsStatus(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var subscribeCmd = &cli.Command{
+	Name:   "subscribe",
+	Usage:  "stream prices and balances of markets as soon as they are recorded",
+	Action: subscribeAction,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to stream updates for",
+		},
+	},
+}
+
+func subscribeAction(ctx *cli.Context) error {
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeMarkets(
+		context.Background(),
+		&tdexav1.SubscribeMarketsRequest{
+			MarketIds: ctx.StringSlice("market_id"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	"github.com/urfave/cli/v2"
+)
+
+var totalValueLockedCmd = &cli.Command{
+	Name:   "tvl",
+	Usage:  "show total value locked in markets",
+	Action: totalValueLockedAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "reference_currency",
+			Usage:    "fiat currency in which value locked is expressed",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "fetch value locked from specific time in the past, please provide end flag also",
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "fetch value locked from specific time in the past til end date, use with start flag",
+		},
+		&cli.StringSliceFlag{
+			Name:  "market_id",
+			Usage: "market_id to calculate value locked for",
+		},
+		&cli.IntFlag{
+			Name: "predefined_period",
+			Usage: "time predefined periods, if omitted only latest value locked is returned:\n" +
+				"       1 -> last hour\n" +
+				"       2 -> last day\n" +
+				"       3 -> last month\n" +
+				"       4 -> last 3 months\n" +
+				"       5 -> year to date\n" +
+				"       6 -> all",
+		},
+		&cli.IntFlag{
+			Name: "time_frame",
+			Usage: "used to group value locked when time range is provided:\n" +
+				"       1 -> hour\n" +
+				"       2 -> four hours\n" +
+				"       3 -> day\n" +
+				"       4 -> week\n" +
+				"       5 -> month",
+			Value: 3,
+		},
+	},
+}
+
+func totalValueLockedAction(ctx *cli.Context) error {
+	var timeRange *tdexav1.TimeRange
+	start := ctx.String("start")
+	end := ctx.String("end")
+	if start != "" && end != "" {
+		timeRange = &tdexav1.TimeRange{
+			CustomPeriod: &tdexav1.CustomPeriod{
+				StartDate: start,
+				EndDate:   end,
+			},
+		}
+	} else if pp := ctx.Int("predefined_period"); pp > 0 {
+		timeRange = &tdexav1.TimeRange{
+			PredefinedPeriod: tdexav1.PredefinedPeriod(pp),
+		}
+	}
+
+	req := &tdexav1.GetTotalValueLockedRequest{
+		TimeRange:         timeRange,
+		TimeFrame:         tdexav1.TimeFrame(ctx.Int("time_frame")),
+		ReferenceCurrency: ctx.String("reference_currency"),
+		MarketIds:         ctx.StringSlice("market_id"),
+	}
+
+	client, cleanup, err := getAnalyticsClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetTotalValueLocked(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -5,59 +5,182 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sort"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/tdex-network/tdex-analytics/internal/config"
 	"github.com/tdex-network/tdex-analytics/internal/core/application"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+	dbbolt "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/bolt"
 	dbinflux "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/influx"
 	dbpg "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg"
 	tdexagrpc "github.com/tdex-network/tdex-analytics/internal/interface/grpc"
 	"github.com/tdex-network/tdex-analytics/pkg/rater"
 	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+	"github.com/tdex-network/tdex-analytics/pkg/webhook"
 )
 
+// timeSeriesRepository is implemented by the dbs able to store time series
+type timeSeriesRepository interface {
+	domain.MarketBalanceRepository
+	domain.MarketPriceRepository
+	domain.RateRepository
+	domain.MarketFeeRepository
+	domain.MarketDepthRepository
+	domain.MarketSnapshotRepository
+}
+
 func main() {
-	influxDbSvc, err := dbinflux.New(dbinflux.Config{
-		Org:             config.GetString(config.InfluxDbOrg),
-		AuthToken:       config.GetString(config.InfluxDbAuthToken),
-		DbUrl:           config.GetString(config.InfluxDbUrl),
-		AnalyticsBucket: config.GetString(config.InfluxDbAnalyticsBucket),
-	})
-	if err != nil {
-		log.Fatalln(err.Error())
+	var marketRepository domain.MarketRepository
+	var timeSeriesDbSvc timeSeriesRepository
+	var alertRepository domain.AlertRepository
+	var marketEventRepository domain.MarketEventRepository
+	var marketFetchStatusRepository domain.MarketFetchStatusRepository
+	var leaderRepository domain.LeaderRepository
+	var workerRepository domain.WorkerRepository
+	var marketUpdatesBroker port.MarketUpdatesBroker
+	switch config.GetString(config.DbTypeKey) {
+	case config.BoltDbType:
+		boltDbSvc, err := dbbolt.New(dbbolt.Config{
+			Path: config.GetString(config.BoltDbPathKey),
+		})
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		marketRepository, timeSeriesDbSvc = boltDbSvc, boltDbSvc
+		alertRepository, marketEventRepository = boltDbSvc, boltDbSvc
+		marketFetchStatusRepository, leaderRepository = boltDbSvc, boltDbSvc
+		workerRepository, marketUpdatesBroker = boltDbSvc, boltDbSvc
+	default:
+		pgDbSvc, err := dbpg.New(dbpg.DbConfig{
+			DbUser:             config.GetString(config.DbUserKey),
+			DbPassword:         config.GetString(config.DbPassKey),
+			DbHost:             config.GetString(config.DbHostKey),
+			DbPort:             config.GetInt(config.DbPortKey),
+			DbName:             config.GetString(config.DbNameKey),
+			MigrationSourceURL: config.GetString(config.DbMigrationPath),
+			DbInsecure:         config.GetBool(config.DbInsecure),
+			AwsRegion:          config.GetString(config.AwsRegion),
+		})
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		// time series are stored in postgres together with markets unless
+		//influxdb is selected
+		marketRepository, timeSeriesDbSvc = pgDbSvc, pgDbSvc
+		alertRepository, marketEventRepository = pgDbSvc, pgDbSvc
+		marketFetchStatusRepository, leaderRepository = pgDbSvc, pgDbSvc
+		workerRepository, marketUpdatesBroker = pgDbSvc, pgDbSvc
+		if config.GetString(config.TimeSeriesDbKey) == config.InfluxDbTimeSeries {
+			influxDbSvc, err := dbinflux.New(dbinflux.Config{
+				Org:             config.GetString(config.InfluxDbOrg),
+				AuthToken:       config.GetString(config.InfluxDbAuthToken),
+				DbUrl:           config.GetString(config.InfluxDbUrl),
+				AnalyticsBucket: config.GetString(config.InfluxDbAnalyticsBucket),
+			})
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+
+			if config.GetBool(config.InfluxDbMigrateBalances) {
+				if err := influxDbSvc.MigrateBalances(context.Background()); err != nil {
+					log.Fatalln(err.Error())
+				}
+			}
+
+			timeSeriesDbSvc = influxDbSvc
+		}
 	}
 
-	marketRepository, err := dbpg.New(dbpg.DbConfig{
-		DbUser:             config.GetString(config.DbUserKey),
-		DbPassword:         config.GetString(config.DbPassKey),
-		DbHost:             config.GetString(config.DbHostKey),
-		DbPort:             config.GetInt(config.DbPortKey),
-		DbName:             config.GetString(config.DbNameKey),
-		MigrationSourceURL: config.GetString(config.DbMigrationPath),
-		DbInsecure:         config.GetBool(config.DbInsecure),
-		AwsRegion:          config.GetString(config.AwsRegion),
-	})
-	if err != nil {
-		log.Fatalln(err.Error())
+	var providerPins map[string][]string
+	if path := config.GetString(config.ProviderPinsPath); path != "" {
+		pins, err := tdexmarketloader.LoadPins(path)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		providerPins = pins
 	}
 
-	tdexMarketLoaderSvc := tdexmarketloader.NewService(
-		config.GetString(config.TorProxyUrl),
-		config.GetString(config.RegistryUrl),
-		config.GetInt(config.PriceAmount),
+	// configured providers are listed first, so they take precedence over
+	//the registries ones
+	registries := make([]tdexmarketloader.RegistrySource, 0)
+	if providers := config.GetRegistryProviders(); len(providers) > 0 {
+		liquidityProviders := make([]tdexmarketloader.LiquidityProvider, 0, len(providers))
+		for endpoint, name := range providers {
+			liquidityProviders = append(liquidityProviders, tdexmarketloader.LiquidityProvider{
+				Name:     name,
+				Endpoint: endpoint,
+			})
+		}
+		sort.Slice(liquidityProviders, func(i, j int) bool {
+			return liquidityProviders[i].Endpoint < liquidityProviders[j].Endpoint
+		})
+		registries = append(registries, tdexmarketloader.NewStaticRegistrySource(liquidityProviders))
+	}
+	registryPubKey := config.GetRegistryPubKey()
+	for _, v := range config.GetRegistrySources() {
+		registries = append(registries, tdexmarketloader.NewRegistrySource(v, registryPubKey))
+	}
+
+	tdexMarketLoaderSvc := tdexmarketloader.NewService(tdexmarketloader.Config{
+		TorProxyUrl:      config.GetString(config.TorProxyUrl),
+		Registries:       registries,
+		PriceAmount:      config.GetInt(config.PriceAmount),
+		ConnIdleTimeout:  time.Duration(config.GetInt(config.ProviderConnIdleTimeoutInSeconds)) * time.Second,
+		RequestTimeout:   time.Duration(config.GetInt(config.ProviderRequestTimeoutInSeconds)) * time.Second,
+		BreakerThreshold: config.GetInt(config.ProviderBreakerThreshold),
+		BreakerCooldown:  time.Duration(config.GetInt(config.ProviderBreakerCooldownInSeconds)) * time.Second,
+		PinsOverride:     providerPins,
+	})
+
+	alertSvc := application.NewAlertService(
+		alertRepository,
+		marketRepository,
+		timeSeriesDbSvc,
+		webhook.NewService(
+			time.Duration(config.GetInt(config.AlertWebhookTimeoutInSeconds))*time.Second,
+		),
 	)
 
 	marketLoaderSvc := application.NewMarketsLoaderService(
 		marketRepository,
+		marketEventRepository,
+		tdexMarketLoaderSvc,
+		alertSvc,
+	)
+
+	// updates are published to the subscribers of every replica, each one
+	//fetching only the markets assigned to it
+	marketUpdatesSvc := application.NewMarketUpdatesService(marketUpdatesBroker)
+
+	// markets are partitioned among the replicas heartbeating as workers
+	marketsShardSvc := application.NewMarketsShardService(
+		workerRepository,
 		tdexMarketLoaderSvc,
+		config.GetString(config.NodeID),
+		time.Duration(config.GetInt(config.WorkerHeartbeatIntervalInSeconds))*time.Second,
+	)
+
+	marketStatusSvc := application.NewMarketStatusService(
+		marketRepository,
+		marketFetchStatusRepository,
+		marketsShardSvc,
+	)
+
+	// prices and balances of markets are fetched by a pool of workers
+	marketsJobRunner := application.NewMarketsJobRunner(
+		config.GetInt(config.FetchJobWorkers),
+		marketsShardSvc,
 	)
 
 	marketBalanceSvc := application.NewMarketBalanceService(
-		influxDbSvc,
+		timeSeriesDbSvc,
 		marketRepository,
-		tdexMarketLoaderSvc,
-		config.GetString(config.JobPeriodInMinutes),
 	)
 
 	raterSvc, err := rater.NewExchangeRateClient(
@@ -71,11 +194,29 @@ func main() {
 	}
 
 	marketPriceSvc := application.NewMarketPriceService(
-		influxDbSvc,
+		timeSeriesDbSvc,
+		marketRepository,
+		raterSvc,
+		timeSeriesDbSvc,
+	)
+
+	marketSnapshotSvc := application.NewMarketSnapshotService(
+		timeSeriesDbSvc,
 		marketRepository,
 		tdexMarketLoaderSvc,
 		config.GetString(config.JobPeriodInMinutes),
+		marketUpdatesSvc,
+		alertSvc,
+		marketStatusSvc,
+		marketsJobRunner,
+	)
+
+	rateHistorySvc := application.NewRateHistoryService(
+		timeSeriesDbSvc,
 		raterSvc,
+		config.GetAssetCurrencies(),
+		config.GetRateReferenceCurrencies(),
+		config.GetString(config.RateJobPeriodInMinutes),
 	)
 
 	opts := tdexagrpc.WithInsecureGrpcGateway()
@@ -87,13 +228,87 @@ func main() {
 
 	marketSvc := application.NewMarketService(marketRepository)
 
+	tvlSvc := application.NewTotalValueLockedService(
+		timeSeriesDbSvc,
+		timeSeriesDbSvc,
+		marketRepository,
+		raterSvc,
+		timeSeriesDbSvc,
+		config.GetAssetPrecisions(),
+	)
+
+	marketVolumeSvc := application.NewMarketVolumeService(
+		timeSeriesDbSvc,
+		timeSeriesDbSvc,
+		marketRepository,
+		config.GetInt(config.VolumePriceToleranceBps),
+	)
+
+	marketFeeSvc := application.NewMarketFeeService(
+		timeSeriesDbSvc,
+		marketRepository,
+		tdexMarketLoaderSvc,
+		config.GetString(config.JobPeriodInMinutes),
+		marketsShardSvc,
+	)
+
+	marketDepthSvc := application.NewMarketDepthService(
+		timeSeriesDbSvc,
+		marketRepository,
+		tdexMarketLoaderSvc,
+		config.GetDepthAmounts(),
+		config.GetString(config.DepthJobPeriodInMinutes),
+		marketsShardSvc,
+	)
+
+	marketSpreadSvc := application.NewMarketSpreadService(
+		timeSeriesDbSvc,
+		timeSeriesDbSvc,
+		marketRepository,
+		config.GetInt(config.DefaultMarketFeeBps),
+	)
+
+	marketDeviationSvc := application.NewMarketDeviationService(
+		timeSeriesDbSvc,
+		timeSeriesDbSvc,
+		marketRepository,
+		raterSvc,
+		config.GetInt(config.DeviationToleranceBps),
+	)
+
+	marketUptimeSvc := application.NewMarketUptimeService(
+		marketRepository,
+		marketEventRepository,
+	)
+
+	leaderSvc := application.NewLeaderService(
+		leaderRepository,
+		config.GetString(config.NodeID),
+		time.Duration(config.GetInt(config.LeaderElectionIntervalInSeconds))*time.Second,
+	)
+
 	tdexad, err := tdexagrpc.NewServer(
 		strconv.Itoa(config.GetInt(config.GrpcServerPortKey)),
 		marketBalanceSvc,
 		marketPriceSvc,
 		marketLoaderSvc,
+		rateHistorySvc,
 		marketSvc,
+		tvlSvc,
+		marketVolumeSvc,
+		marketSpreadSvc,
+		marketDeviationSvc,
+		marketFeeSvc,
+		marketDepthSvc,
+		marketUpdatesSvc,
+		alertSvc,
+		marketUptimeSvc,
+		marketStatusSvc,
+		marketSnapshotSvc,
+		leaderSvc,
+		marketsShardSvc,
 		opts,
+		tdexagrpc.WithAdminToken(config.GetString(config.AdminToken)),
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -1,9 +1,16 @@
 package config
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcutil"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -19,6 +26,17 @@ const (
 	InfluxDbUrl = "INFLUXDB_URL"
 	// InfluxDbAnalyticsBucket is bucket that tdexd using to store data
 	InfluxDbAnalyticsBucket = "INFLUXDB_INIT_BUCKET"
+	// InfluxDbMigrateBalances enables, at startup, the migration of balances
+	//stored as floats by previous versions to integer fields
+	InfluxDbMigrateBalances = "INFLUXDB_MIGRATE_BALANCES"
+	// TimeSeriesDbKey is the backend storing prices, balances and the other
+	//time series, either influxdb or postgres
+	TimeSeriesDbKey = "TIME_SERIES_DB"
+	// DbTypeKey is the db storing markets, either postgres or bolt, bolt is an
+	//embedded file db storing time series too, so that no external db is needed
+	DbTypeKey = "DB_TYPE"
+	// BoltDbPathKey is the path of bolt db file
+	BoltDbPathKey = "BOLT_DB_PATH"
 	// DbUserKey is postgres db user used by tdexd
 	DbUserKey = "DB_USER"
 	// DbPassKey is postgres db pass used by tdexd
@@ -37,8 +55,21 @@ const (
 	AwsRegion = "AWSREGION"
 	// TorProxyUrl is tor client proxy url used to connect to liquidity providers using onion
 	TorProxyUrl = "TOR_PROXY_URL"
-	// RegistryUrl is url with info about available liquidity providers
+	// RegistryUrl is url with info about available liquidity providers, used
+	//if no RegistrySources are configured
 	RegistryUrl = "REGISTRY_URL"
+	// RegistrySources are registries, delimited by comma, either http(s) urls
+	//or paths of local files, providers listed by more than one are taken
+	//from the first of them
+	RegistrySources = "REGISTRY_SOURCES"
+	// RegistryProviders are liquidity providers tracked in addition to the
+	//registries ones and taking precedence over them,
+	//format: name=endpoint, delimited by comma
+	RegistryProviders = "REGISTRY_PROVIDERS"
+	// RegistryPubKey is optional hex encoded ed25519 public key, if set
+	//registries must have a detached signature, at their url or path with
+	//.sig suffix, made with the matching private key
+	RegistryPubKey = "REGISTRY_PUBKEY"
 	// LogLevelKey is log level used by tdexa
 	LogLevelKey = "LOG_LEVEL"
 	// PriceAmount is amount used when invoking tdex-daemon MarketPrice RPC
@@ -52,9 +83,73 @@ const (
 	// ExplorerUrl is explorer url used by tdexa
 	ExplorerUrl = "EXPLORER_URL"
 	//AssetCurrencyPair is the asset currency pair used by tdexa,
-	//format: asset_hash:currency, asset_hash/currency pairs should be delimited by comma
-	//example: 0x0000000000000000000000000000000000000000:LBTC,0x0000000000000000000000000000000000000000:USDT
+	//format: asset_hash:currency[:precision], asset_hash/currency pairs should be delimited by comma,
+	//precision is the number of decimals of the asset, value locked in markets of assets without it is not reported
+	//example: 0x0000000000000000000000000000000000000000:LBTC:8,0x0000000000000000000000000000000000000000:USDT:8
 	AssetCurrencyPair = "ASSET_CURRENCY_PAIRS"
+	// VolumePriceToleranceBps is max distance, in basis points, between the
+	//price implied by two consecutive balances and market price for the
+	//balance change to be counted as trade volume
+	VolumePriceToleranceBps = "VOLUME_PRICE_TOLERANCE_BPS"
+	// DefaultMarketFeeBps is fee, in basis points, assumed for every market
+	//when calculating bid/ask spreads between providers
+	DefaultMarketFeeBps = "DEFAULT_MARKET_FEE_BPS"
+	// DeviationToleranceBps is max distance, in basis points, between market
+	//price and external reference rate before the price is reported as off-market
+	DeviationToleranceBps = "DEVIATION_TOLERANCE_BPS"
+	// RateReferenceCurrencies are currencies, delimited by comma, assets
+	//currencies rates are periodically recorded for
+	RateReferenceCurrencies = "RATE_REFERENCE_CURRENCIES"
+	// RateJobPeriodInMinutes is recurring interval for running fetch rates job
+	RateJobPeriodInMinutes = "RATE_JOB_PERIOD_IN_MINUTES"
+	// DepthAmounts are amounts of market base asset, in satoshis and delimited
+	//by comma, previewed on both buy and sell side to sample market depth
+	DepthAmounts = "DEPTH_AMOUNTS"
+	// DepthJobPeriodInMinutes is recurring interval for running fetch depth job
+	DepthJobPeriodInMinutes = "DEPTH_JOB_PERIOD_IN_MINUTES"
+	// AlertWebhookTimeoutInSeconds is timeout of every attempt to deliver an
+	//alert webhook
+	AlertWebhookTimeoutInSeconds = "ALERT_WEBHOOK_TIMEOUT_IN_SECONDS"
+	// ProviderConnIdleTimeoutInSeconds is time after which an unused gRPC
+	//connection to a liquidity provider is closed
+	ProviderConnIdleTimeoutInSeconds = "PROVIDER_CONN_IDLE_TIMEOUT_IN_SECONDS"
+	// ProviderRequestTimeoutInSeconds is timeout of every request to a
+	//liquidity provider, fallbacks to other protocols included
+	ProviderRequestTimeoutInSeconds = "PROVIDER_REQUEST_TIMEOUT_IN_SECONDS"
+	// FetchJobWorkers is max number of markets fetched at the same time by
+	//prices and balances jobs
+	FetchJobWorkers = "FETCH_JOB_WORKERS"
+	// ProviderBreakerThreshold is number of consecutive failed requests after
+	//which a liquidity provider is not called until a cooldown is over
+	ProviderBreakerThreshold = "PROVIDER_BREAKER_THRESHOLD"
+	// ProviderBreakerCooldownInSeconds is time a failing liquidity provider is
+	//not called for, doubled every time it fails again after it
+	ProviderBreakerCooldownInSeconds = "PROVIDER_BREAKER_COOLDOWN_IN_SECONDS"
+	// ProviderPinsPath is optional path to a json file mapping liquidity
+	//provider endpoints to the pins of their certificates, overriding the
+	//ones published in the registry
+	ProviderPinsPath = "PROVIDER_PINS_PATH"
+	// NodeID identifies this replica among the ones electing the leader
+	//running the jobs ingesting markets data, defaults to the hostname
+	NodeID = "NODE_ID"
+	// LeaderElectionIntervalInSeconds is how often replicas campaign for the
+	//leadership, and the leader confirms it still holds it
+	LeaderElectionIntervalInSeconds = "LEADER_ELECTION_INTERVAL_IN_SECONDS"
+	// WorkerHeartbeatIntervalInSeconds is how often replicas heartbeat as
+	//workers among which markets are partitioned, a worker missing 3
+	//heartbeats is considered dead and its markets assigned to the others
+	WorkerHeartbeatIntervalInSeconds = "WORKER_HEARTBEAT_INTERVAL_IN_SECONDS"
+	// AdminToken is token required, as bearer in the authorization metadata,
+	//by the RPCs managing alert rules, these are disabled if not set
+	AdminToken = "ADMIN_TOKEN"
+)
+
+const (
+	InfluxDbTimeSeries = "influxdb"
+	PostgresTimeSeries = "postgres"
+
+	PostgresDbType = "postgres"
+	BoltDbType     = "bolt"
 )
 
 var (
@@ -65,6 +160,11 @@ var (
 		"ce091c998b83c78bb71a632313ba3760f1763d9cfcffae02258ffa9865a37bd2": "usd",
 		"0e99c1a6da379d1f4151fb9df90449d40d0608f6cb33a5bcbfc8c265f42bab0a": "cad",
 	}
+	assetPrecisions = map[string]int{
+		"6f0279e9ed041c3d710a9f57d0c02928416460c4b722ae3457a11eec381c526d": 8,
+		"ce091c998b83c78bb71a632313ba3760f1763d9cfcffae02258ffa9865a37bd2": 8,
+		"0e99c1a6da379d1f4151fb9df90449d40d0608f6cb33a5bcbfc8c265f42bab0a": 8,
+	}
 )
 
 func init() {
@@ -77,6 +177,12 @@ func init() {
 	vip.SetDefault(InfluxDbOrg, "tdex-network")
 	vip.SetDefault(InfluxDbUrl, "http://localhost:8086")
 	vip.SetDefault(InfluxDbAnalyticsBucket, "analytics")
+	vip.SetDefault(InfluxDbMigrateBalances, false)
+	vip.SetDefault(TimeSeriesDbKey, InfluxDbTimeSeries)
+	vip.SetDefault(DbTypeKey, PostgresDbType)
+	vip.SetDefault(
+		BoltDbPathKey, filepath.Join(btcutil.AppDataDir("tdexa", false), "tdexa.db"),
+	)
 	vip.SetDefault(DbUserKey, "root")
 	vip.SetDefault(DbPassKey, "secret")
 	vip.SetDefault(DbHostKey, "127.0.0.1")
@@ -91,15 +197,57 @@ func init() {
 	vip.SetDefault(PriceAmount, 1000)
 	vip.SetDefault(JobPeriodInMinutes, "1")
 	vip.SetDefault(ExplorerUrl, "https://blockstream.info/liquid/api/")
+	vip.SetDefault(VolumePriceToleranceBps, 500)
+	vip.SetDefault(DefaultMarketFeeBps, 25)
+	vip.SetDefault(DeviationToleranceBps, 100)
+	vip.SetDefault(RateReferenceCurrencies, "usd,eur")
+	vip.SetDefault(RateJobPeriodInMinutes, "5")
+	vip.SetDefault(DepthAmounts, "100000,1000000,10000000,100000000")
+	vip.SetDefault(DepthJobPeriodInMinutes, "15")
+	vip.SetDefault(AlertWebhookTimeoutInSeconds, 10)
+	vip.SetDefault(ProviderConnIdleTimeoutInSeconds, 300)
+	vip.SetDefault(ProviderRequestTimeoutInSeconds, 30)
+	vip.SetDefault(FetchJobWorkers, 10)
+	vip.SetDefault(ProviderBreakerThreshold, 5)
+	vip.SetDefault(ProviderBreakerCooldownInSeconds, 60)
+	vip.SetDefault(LeaderElectionIntervalInSeconds, 10)
+	vip.SetDefault(WorkerHeartbeatIntervalInSeconds, 10)
+	if hostname, err := os.Hostname(); err == nil {
+		vip.SetDefault(NodeID, hostname)
+	}
 
-	if vip.GetString(InfluxDbAuthToken) == "" {
-		log.Fatalln("influx_db auth token not provided")
+	switch vip.GetString(DbTypeKey) {
+	case PostgresDbType:
+		switch vip.GetString(TimeSeriesDbKey) {
+		case InfluxDbTimeSeries:
+			if vip.GetString(InfluxDbAuthToken) == "" {
+				log.Fatalln("influx_db auth token not provided")
+			}
+		case PostgresTimeSeries:
+		default:
+			log.Fatalf(
+				"unknown time series db %s, must be one of %s, %s",
+				vip.GetString(TimeSeriesDbKey), InfluxDbTimeSeries, PostgresTimeSeries,
+			)
+		}
+	// time series are stored in bolt too
+	case BoltDbType:
+	default:
+		log.Fatalf(
+			"unknown db type %s, must be one of %s, %s",
+			vip.GetString(DbTypeKey), PostgresDbType, BoltDbType,
+		)
 	}
 
 	if err := validateTlsKeys(); err != nil {
 		log.WithError(err).Panic("invalid tls keys")
 	}
 
+	// jobs would block forever waiting for a worker
+	if workers := vip.GetInt(FetchJobWorkers); workers <= 0 {
+		log.Fatalf("fetch job workers must be greater than 0, got %d", workers)
+	}
+
 	log.SetLevel(log.Level(GetInt(LogLevelKey)))
 }
 
@@ -125,16 +273,126 @@ func validateTlsKeys() error {
 }
 
 func GetAssetCurrencyPair() map[string]string {
-	response := assetCurrencyPair
-	if vip.GetString(AssetCurrencyPair) != "" {
-		response = make(map[string]string)
-		for _, pair := range strings.Split(vip.GetString(AssetCurrencyPair), ",") {
-			parts := strings.Split(pair, ":")
-			if len(parts) != 2 {
-				log.Fatalf("invalid asset currency pair: %s", pair)
+	if vip.GetString(AssetCurrencyPair) == "" {
+		return assetCurrencyPair
+	}
+	response, _ := parseAssetCurrencyPairs()
+	return response
+}
+
+// GetAssetPrecisions returns the number of decimals of the configured assets,
+// the ones configured without precision are not included
+func GetAssetPrecisions() map[string]int {
+	if vip.GetString(AssetCurrencyPair) == "" {
+		return assetPrecisions
+	}
+	_, response := parseAssetCurrencyPairs()
+	return response
+}
+
+func parseAssetCurrencyPairs() (map[string]string, map[string]int) {
+	currencies := make(map[string]string)
+	precisions := make(map[string]int)
+	for _, pair := range strings.Split(vip.GetString(AssetCurrencyPair), ",") {
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 && len(parts) != 3 {
+			log.Fatalf("invalid asset currency pair: %s", pair)
+		}
+		currencies[parts[0]] = parts[1]
+
+		if len(parts) == 3 {
+			precision, err := strconv.Atoi(parts[2])
+			if err != nil || precision < 0 {
+				log.Fatalf("invalid asset precision: %s", pair)
 			}
-			response[parts[0]] = parts[1]
+			precisions[parts[0]] = precision
 		}
 	}
-	return response
+	return currencies, precisions
+}
+
+// GetAssetCurrencies returns the distinct currencies of the configured assets
+func GetAssetCurrencies() []string {
+	currencies := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, currency := range GetAssetCurrencyPair() {
+		currency = strings.ToLower(currency)
+		if !seen[currency] {
+			seen[currency] = true
+			currencies = append(currencies, currency)
+		}
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+func GetRateReferenceCurrencies() []string {
+	currencies := make([]string, 0)
+	for _, currency := range strings.Split(vip.GetString(RateReferenceCurrencies), ",") {
+		currency = strings.ToLower(strings.TrimSpace(currency))
+		if currency != "" {
+			currencies = append(currencies, currency)
+		}
+	}
+	return currencies
+}
+
+func GetRegistrySources() []string {
+	sources := make([]string, 0)
+	for _, v := range strings.Split(vip.GetString(RegistrySources), ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			sources = append(sources, v)
+		}
+	}
+	if len(sources) == 0 {
+		sources = append(sources, vip.GetString(RegistryUrl))
+	}
+	return sources
+}
+
+// GetRegistryProviders returns the name of the configured providers by
+// endpoint
+func GetRegistryProviders() map[string]string {
+	providers := make(map[string]string)
+	for _, v := range strings.Split(vip.GetString(RegistryProviders), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			log.Fatalf("invalid registry provider: %s", v)
+		}
+		providers[parts[1]] = parts[0]
+	}
+	return providers
+}
+
+// GetRegistryPubKey returns nil if registries are not signed
+func GetRegistryPubKey() ed25519.PublicKey {
+	if vip.GetString(RegistryPubKey) == "" {
+		return nil
+	}
+	pubKey, err := hex.DecodeString(vip.GetString(RegistryPubKey))
+	if err != nil || len(pubKey) != ed25519.PublicKeySize {
+		log.Fatalf("invalid registry pubkey: %s", vip.GetString(RegistryPubKey))
+	}
+	return pubKey
+}
+
+func GetDepthAmounts() []uint64 {
+	amounts := make([]uint64, 0)
+	for _, v := range strings.Split(vip.GetString(DepthAmounts), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		amount, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || amount == 0 {
+			log.Fatalf("invalid depth amount: %s", v)
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts
 }
@@ -34,3 +34,55 @@ func TestGetAssetCurrencyPair(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAssetPrecisions(t *testing.T) {
+	t.Setenv(
+		"TDEXA_ASSET_CURRENCY_PAIRS",
+		"0x0000000000000000000000000000000000000000:LBTC:8,1x0000000000000000000000000000000000000000:USD:2,2x0000000000000000000000000000000000000000:CAD",
+	)
+	wantCurrencies := map[string]string{
+		"0x0000000000000000000000000000000000000000": "LBTC",
+		"1x0000000000000000000000000000000000000000": "USD",
+		"2x0000000000000000000000000000000000000000": "CAD",
+	}
+	if got := GetAssetCurrencyPair(); !reflect.DeepEqual(got, wantCurrencies) {
+		t.Errorf("GetAssetCurrencyPair() = %v, want %v", got, wantCurrencies)
+	}
+
+	// assets configured without precision are not included
+	want := map[string]int{
+		"0x0000000000000000000000000000000000000000": 8,
+		"1x0000000000000000000000000000000000000000": 2,
+	}
+	if got := GetAssetPrecisions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAssetPrecisions() = %v, want %v", got, want)
+	}
+}
+
+func TestGetRegistrySources(t *testing.T) {
+	t.Setenv("TDEXA_REGISTRY_URL", "https://registry.com/registry.json")
+	want := []string{"https://registry.com/registry.json"}
+	if got := GetRegistrySources(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRegistrySources() = %v, want %v", got, want)
+	}
+
+	t.Setenv("TDEXA_REGISTRY_SOURCES", "https://registry.com/registry.json, ./registry.json")
+	want = []string{"https://registry.com/registry.json", "./registry.json"}
+	if got := GetRegistrySources(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRegistrySources() = %v, want %v", got, want)
+	}
+}
+
+func TestGetRegistryProviders(t *testing.T) {
+	t.Setenv(
+		"TDEXA_REGISTRY_PROVIDERS",
+		"provider=https://provider.com:9945,onion=http://provider.onion",
+	)
+	want := map[string]string{
+		"https://provider.com:9945": "provider",
+		"http://provider.onion":     "onion",
+	}
+	if got := GetRegistryProviders(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRegistryProviders() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,393 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+	"github.com/tdex-network/tdex-analytics/pkg/hexerr"
+)
+
+const (
+	// alertDeliveryMaxAttempts is the number of times a webhook is sent before
+	//giving up on the delivery
+	alertDeliveryMaxAttempts = 5
+	// alertDeliveryRetryInterval is the wait before the first retry, it is
+	//doubled after every failed attempt
+	alertDeliveryRetryInterval = time.Second
+)
+
+type AlertService interface {
+	// CreateAlertRule stores a rule for an existing market and returns its id
+	CreateAlertRule(ctx context.Context, rule AlertRule) (int, error)
+	// UpdateAlertRule replaces the rule with the same id, its triggered state
+	//is reset so that the new condition is notified as soon as it is met, the
+	//current secret is kept if none is passed
+	UpdateAlertRule(ctx context.Context, rule AlertRule) error
+	DeleteAlertRule(ctx context.Context, id int) error
+	// ListAlertRules returns rules of the markets with the passed ids, or of all
+	//markets if marketIDs are not passed
+	ListAlertRules(ctx context.Context, marketIDs ...string) ([]AlertRule, error)
+	// ListAlertDeliveries returns the delivery log of a rule, latest first
+	ListAlertDeliveries(
+		ctx context.Context,
+		ruleID int,
+		page Page,
+	) ([]AlertDelivery, error)
+	// EvaluatePrice evaluates price change rules of the market against a price
+	//just recorded by the fetch job
+	EvaluatePrice(ctx context.Context, price MarketPrice)
+	// EvaluateBalance evaluates balance rules of the market against a balance
+	//just recorded by the fetch job
+	EvaluateBalance(ctx context.Context, balance MarketBalance)
+	// EvaluateMarketStatus evaluates inactivity rules of the market after the
+	//markets loader changed its active status
+	EvaluateMarketStatus(ctx context.Context, marketID string, active bool)
+}
+
+type alertService struct {
+	alertRepository       domain.AlertRepository
+	marketRepository      domain.MarketRepository
+	marketPriceRepository domain.MarketPriceRepository
+	webhookSvc            port.WebhookService
+	retryInterval         time.Duration
+}
+
+func NewAlertService(
+	alertRepository domain.AlertRepository,
+	marketRepository domain.MarketRepository,
+	marketPriceRepository domain.MarketPriceRepository,
+	webhookSvc port.WebhookService,
+) AlertService {
+	return &alertService{
+		alertRepository:       alertRepository,
+		marketRepository:      marketRepository,
+		marketPriceRepository: marketPriceRepository,
+		webhookSvc:            webhookSvc,
+		retryInterval:         alertDeliveryRetryInterval,
+	}
+}
+
+// alertPayload is the json body of alert webhooks
+type alertPayload struct {
+	RuleID    int    `json:"rule_id"`
+	MarketID  string `json:"market_id"`
+	Type      string `json:"type"`
+	Asset     string `json:"asset,omitempty"`
+	Threshold string `json:"threshold"`
+	Value     string `json:"value"`
+	Time      string `json:"time"`
+}
+
+func (a *alertService) CreateAlertRule(
+	ctx context.Context,
+	rule AlertRule,
+) (int, error) {
+	if err := rule.validate(); err != nil {
+		return 0, err
+	}
+
+	if err := a.validateMarketExists(ctx, rule.MarketID); err != nil {
+		return 0, err
+	}
+
+	rule.Triggered = false
+	rule.CreatedAt = time.Now()
+
+	return a.alertRepository.InsertAlertRule(ctx, rule.toDomain())
+}
+
+func (a *alertService) UpdateAlertRule(
+	ctx context.Context,
+	rule AlertRule,
+) error {
+	current, err := a.alertRepository.GetAlertRule(ctx, rule.ID)
+	if err != nil {
+		return toAlertRuleError(err)
+	}
+
+	// secrets are never returned, so clients can't send the current one back
+	if rule.Secret == "" {
+		rule.Secret = current.Secret
+	}
+
+	if err := rule.validate(); err != nil {
+		return err
+	}
+
+	if err := a.validateMarketExists(ctx, rule.MarketID); err != nil {
+		return err
+	}
+
+	rule.Triggered = false
+	rule.CreatedAt = current.CreatedAt
+
+	return toAlertRuleError(
+		a.alertRepository.UpdateAlertRule(ctx, rule.toDomain()),
+	)
+}
+
+func (a *alertService) DeleteAlertRule(ctx context.Context, id int) error {
+	return toAlertRuleError(a.alertRepository.DeleteAlertRule(ctx, id))
+}
+
+func (a *alertService) ListAlertRules(
+	ctx context.Context,
+	marketIDs ...string,
+) ([]AlertRule, error) {
+	rules, err := a.alertRepository.GetAlertRules(ctx, marketIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]AlertRule, 0, len(rules))
+	for _, v := range rules {
+		result = append(result, alertRuleFromDomain(v))
+	}
+
+	return result, nil
+}
+
+func (a *alertService) ListAlertDeliveries(
+	ctx context.Context,
+	ruleID int,
+	page Page,
+) ([]AlertDelivery, error) {
+	if _, err := a.alertRepository.GetAlertRule(ctx, ruleID); err != nil {
+		return nil, toAlertRuleError(err)
+	}
+
+	deliveries, err := a.alertRepository.GetAlertDeliveries(
+		ctx, ruleID, page.ToDomain(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]AlertDelivery, 0, len(deliveries))
+	for _, v := range deliveries {
+		result = append(result, AlertDelivery(v))
+	}
+
+	return result, nil
+}
+
+func (a *alertService) EvaluatePrice(ctx context.Context, price MarketPrice) {
+	a.evaluate(
+		ctx,
+		price.MarketID,
+		domain.AlertRulePriceChange,
+		price.Time,
+		func(rule domain.AlertRule) (decimal.Decimal, bool, error) {
+			start := price.Time.Add(
+				-time.Duration(rule.WindowMinutes) * time.Minute,
+			)
+			prices, err := a.marketPriceRepository.GetPricesForMarkets(
+				ctx, start, price.Time, domain.NewPage(1, math.MaxInt32), "",
+				rule.MarketID,
+			)
+			if err != nil {
+				return decimal.Zero, false, err
+			}
+
+			// not enough history yet to evaluate the change
+			if len(prices[rule.MarketID]) == 0 {
+				return decimal.Zero, false, nil
+			}
+
+			// the change is measured from the oldest price in the window
+			reference := prices[rule.MarketID][0]
+			for _, v := range prices[rule.MarketID] {
+				if v.Time.Before(reference.Time) {
+					reference = v
+				}
+			}
+
+			change := priceChangePercent(reference.QuotePrice, price.QuotePrice)
+			return change, change.Abs().GreaterThanOrEqual(rule.Threshold), nil
+		},
+	)
+}
+
+func (a *alertService) EvaluateBalance(
+	ctx context.Context,
+	balance MarketBalance,
+) {
+	a.evaluate(
+		ctx,
+		balance.MarketID,
+		domain.AlertRuleBalanceBelow,
+		balance.Time,
+		func(rule domain.AlertRule) (decimal.Decimal, bool, error) {
+			value := balance.BaseBalance
+			if rule.Asset == domain.AlertAssetQuote {
+				value = balance.QuoteBalance
+			}
+
+			return value, value.LessThan(rule.Threshold), nil
+		},
+	)
+}
+
+func (a *alertService) EvaluateMarketStatus(
+	ctx context.Context,
+	marketID string,
+	active bool,
+) {
+	a.evaluate(
+		ctx,
+		marketID,
+		domain.AlertRuleMarketInactive,
+		time.Now(),
+		func(rule domain.AlertRule) (decimal.Decimal, bool, error) {
+			if active {
+				return decimal.NewFromInt(1), false, nil
+			}
+			return decimal.Zero, true, nil
+		},
+	)
+}
+
+// evaluate checks the condition of every rule of the given type of the
+// market, a webhook is delivered only when a rule starts being met
+func (a *alertService) evaluate(
+	ctx context.Context,
+	marketID string,
+	ruleType string,
+	tm time.Time,
+	condition func(rule domain.AlertRule) (decimal.Decimal, bool, error),
+) {
+	rules, err := a.alertRepository.GetAlertRules(ctx, marketID)
+	if err != nil {
+		log.Errorf("evaluate alerts of market %s -> GetAlertRules: %v", marketID, err)
+		return
+	}
+
+	for _, v := range rules {
+		if v.Type != ruleType {
+			continue
+		}
+
+		value, met, err := condition(v)
+		if err != nil {
+			log.Errorf("evaluate alert rule %v: %v", v.ID, err)
+			continue
+		}
+
+		if met == v.Triggered {
+			continue
+		}
+
+		if err := a.alertRepository.UpdateAlertRuleTriggered(
+			ctx, v.ID, met,
+		); err != nil {
+			log.Errorf("evaluate alert rule %v -> UpdateAlertRuleTriggered: %v", v.ID, err)
+			continue
+		}
+
+		if met {
+			go a.deliver(v, alertPayload{
+				RuleID:    v.ID,
+				MarketID:  v.MarketID,
+				Type:      v.Type,
+				Asset:     v.Asset,
+				Threshold: v.Threshold.String(),
+				Value:     value.String(),
+				Time:      tm.UTC().Format(time.RFC3339),
+			})
+		}
+	}
+}
+
+// deliver sends the alert webhook retrying with exponential backoff and
+// records the outcome in the delivery log of the rule
+func (a *alertService) deliver(rule domain.AlertRule, payload alertPayload) {
+	ctx := context.Background()
+
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		log.Errorf("deliver alert rule %v -> Marshal: %v", rule.ID, err)
+		return
+	}
+
+	delivery := domain.AlertDelivery{
+		RuleID:  rule.ID,
+		Payload: string(buf),
+	}
+
+	interval := a.retryInterval
+	for delivery.Attempts < alertDeliveryMaxAttempts {
+		if delivery.Attempts > 0 {
+			time.Sleep(interval)
+			interval *= 2
+		}
+		delivery.Attempts++
+
+		delivery.StatusCode, err = a.webhookSvc.Send(
+			ctx, rule.WebhookUrl, rule.Secret, buf,
+		)
+		if err == nil {
+			delivery.Delivered = true
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+	}
+
+	if !delivery.Delivered {
+		log.Warnf(
+			"alert rule %v not delivered after %v attempts: %v",
+			rule.ID, delivery.Attempts, delivery.Error,
+		)
+	}
+
+	delivery.Time = time.Now()
+	if err := a.alertRepository.InsertAlertDelivery(ctx, delivery); err != nil {
+		log.Errorf("deliver alert rule %v -> InsertAlertDelivery: %v", rule.ID, err)
+	}
+}
+
+func (a *alertService) validateMarketExists(
+	ctx context.Context,
+	marketID string,
+) error {
+	markets, err := a.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range markets {
+		if strconv.Itoa(v.ID) == marketID {
+			return nil
+		}
+	}
+
+	return hexerr.NewApplicationLayerError(
+		hexerr.EntityNotFound,
+		ErrMarketNotFound.Error(),
+	)
+}
+
+// priceChangePercent returns the change from reference to price in percent,
+// zero if reference is zero
+func priceChangePercent(reference, price decimal.Decimal) decimal.Decimal {
+	if reference.IsZero() {
+		return decimal.Zero
+	}
+
+	return price.Sub(reference).Div(reference).Mul(decimal.NewFromInt(100))
+}
+
+func toAlertRuleError(err error) error {
+	if err == domain.ErrAlertRuleNotFound {
+		return hexerr.NewApplicationLayerError(hexerr.EntityNotFound, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,227 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+type alertRepositoryStub struct {
+	domain.AlertRepository
+	rules      []domain.AlertRule
+	deliveries chan domain.AlertDelivery
+}
+
+func (a *alertRepositoryStub) GetAlertRules(
+	ctx context.Context,
+	marketIDs ...string,
+) ([]domain.AlertRule, error) {
+	return a.rules, nil
+}
+
+func (a *alertRepositoryStub) UpdateAlertRuleTriggered(
+	ctx context.Context,
+	id int,
+	triggered bool,
+) error {
+	for i := range a.rules {
+		if a.rules[i].ID == id {
+			a.rules[i].Triggered = triggered
+		}
+	}
+	return nil
+}
+
+func (a *alertRepositoryStub) InsertAlertDelivery(
+	ctx context.Context,
+	delivery domain.AlertDelivery,
+) error {
+	a.deliveries <- delivery
+	return nil
+}
+
+func (a *alertRepositoryStub) GetAlertRule(
+	ctx context.Context,
+	id int,
+) (*domain.AlertRule, error) {
+	for _, v := range a.rules {
+		if v.ID == id {
+			return &v, nil
+		}
+	}
+	return nil, domain.ErrAlertRuleNotFound
+}
+
+func (a *alertRepositoryStub) UpdateAlertRule(
+	ctx context.Context,
+	rule domain.AlertRule,
+) error {
+	for i := range a.rules {
+		if a.rules[i].ID == rule.ID {
+			a.rules[i] = rule
+		}
+	}
+	return nil
+}
+
+// webhookStub fails the first failures calls
+type webhookStub struct {
+	failures int
+	calls    int
+}
+
+func (w *webhookStub) Send(
+	ctx context.Context,
+	url string,
+	secret string,
+	payload []byte,
+) (int, error) {
+	w.calls++
+	if w.calls <= w.failures {
+		return 500, errors.New("webhook responded with status 500")
+	}
+	return 200, nil
+}
+
+func TestAlertServiceEvaluateBalance(t *testing.T) {
+	repository := &alertRepositoryStub{
+		rules: []domain.AlertRule{
+			{
+				ID:        1,
+				MarketID:  "1",
+				Type:      domain.AlertRuleBalanceBelow,
+				Asset:     domain.AlertAssetQuote,
+				Threshold: decimal.NewFromInt(1000),
+			},
+			{
+				ID:       2,
+				MarketID: "1",
+				Type:     domain.AlertRuleMarketInactive,
+			},
+		},
+		deliveries: make(chan domain.AlertDelivery, 10),
+	}
+	webhook := &webhookStub{failures: 2}
+	svc := &alertService{
+		alertRepository: repository,
+		webhookSvc:      webhook,
+		retryInterval:   time.Millisecond,
+	}
+
+	balance := func(quote int64) MarketBalance {
+		return MarketBalance{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(quote),
+			Time:         time.Now(),
+		}
+	}
+
+	svc.EvaluateBalance(context.Background(), balance(500))
+	delivery := <-repository.deliveries
+	require.Equal(t, 1, delivery.RuleID)
+	require.True(t, delivery.Delivered)
+	require.Equal(t, 3, delivery.Attempts)
+	require.Equal(t, 200, delivery.StatusCode)
+	require.Contains(t, delivery.Payload, `"value":"500"`)
+	require.True(t, repository.rules[0].Triggered)
+	require.False(t, repository.rules[1].Triggered)
+
+	// still met, not notified again
+	svc.EvaluateBalance(context.Background(), balance(400))
+	require.Equal(t, 3, webhook.calls)
+
+	svc.EvaluateBalance(context.Background(), balance(2000))
+	require.False(t, repository.rules[0].Triggered)
+
+	webhook.failures = webhook.calls + alertDeliveryMaxAttempts
+	svc.EvaluateBalance(context.Background(), balance(500))
+	delivery = <-repository.deliveries
+	require.False(t, delivery.Delivered)
+	require.Equal(t, alertDeliveryMaxAttempts, delivery.Attempts)
+	require.NotEmpty(t, delivery.Error)
+	require.Empty(t, repository.deliveries)
+}
+
+func TestAlertRuleValidate(t *testing.T) {
+	valid := AlertRule{
+		MarketID:      "1",
+		Type:          domain.AlertRulePriceChange,
+		Threshold:     decimal.NewFromInt(5),
+		WindowMinutes: 60,
+		WebhookUrl:    "https://example.com/hook",
+		Secret:        "secret",
+	}
+	require.NoError(t, valid.validate())
+
+	noWindow := valid
+	noWindow.WindowMinutes = 0
+	require.Error(t, noWindow.validate())
+
+	noThreshold := valid
+	noThreshold.Threshold = decimal.Zero
+	require.Error(t, noThreshold.validate())
+
+	noAsset := valid
+	noAsset.Type = domain.AlertRuleBalanceBelow
+	require.Error(t, noAsset.validate())
+
+	unknownType := valid
+	unknownType.Type = "unknown"
+	require.Error(t, unknownType.validate())
+
+	for _, v := range []string{
+		"ftp://example.com/hook",
+		"http://localhost:8080/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+	} {
+		invalidUrl := valid
+		invalidUrl.WebhookUrl = v
+		require.Error(t, invalidUrl.validate(), v)
+	}
+
+	require.True(t, decimal.NewFromInt(-10).Equal(
+		priceChangePercent(decimal.NewFromInt(100), decimal.NewFromInt(90)),
+	))
+}
+
+func TestAlertServiceUpdateAlertRule(t *testing.T) {
+	repository := &alertRepositoryStub{
+		rules: []domain.AlertRule{
+			{
+				ID:            1,
+				MarketID:      "1",
+				Type:          domain.AlertRulePriceChange,
+				Threshold:     decimal.NewFromInt(5),
+				WindowMinutes: 60,
+				WebhookUrl:    "https://example.com/hook",
+				Secret:        "secret",
+				Triggered:     true,
+			},
+		},
+	}
+	svc := &alertService{
+		alertRepository:  repository,
+		marketRepository: &marketRepositoryStub{markets: []domain.Market{{ID: 1}}},
+	}
+
+	rule := alertRuleFromDomain(repository.rules[0])
+	rule.Threshold = decimal.NewFromInt(10)
+	rule.Secret = ""
+	require.NoError(t, svc.UpdateAlertRule(context.Background(), rule))
+
+	// the current secret is kept if none is passed
+	require.Equal(t, "secret", repository.rules[0].Secret)
+	require.True(t, decimal.NewFromInt(10).Equal(repository.rules[0].Threshold))
+	require.False(t, repository.rules[0].Triggered)
+
+	rule.Secret = "other"
+	require.NoError(t, svc.UpdateAlertRule(context.Background(), rule))
+	require.Equal(t, "other", repository.rules[0].Secret)
+}
@@ -3,5 +3,12 @@ package application
 import "errors"
 
 var (
-	ErrInvalidTimeFrame = errors.New("timeFrame must be smaller than timePeriod")
+	ErrInvalidTimeFrame         = errors.New("timeFrame must be smaller than timePeriod")
+	ErrMissingTimeFrame         = errors.New("timeFrame must be provided")
+	ErrMissingReferenceCurrency = errors.New("reference currency must be provided")
+	ErrInvalidFee               = errors.New("fee must not be negative")
+	ErrInvalidTolerance         = errors.New("tolerance must not be negative")
+	ErrInvalidAlertThreshold    = errors.New("threshold must not be negative for balance alerts and positive for price alerts")
+	ErrMarketNotFound           = errors.New("market not found")
+	ErrRateNotRecorded          = errors.New("no rate recorded close to the requested time")
 )
@@ -0,0 +1,212 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+const (
+	// leaderMissedRenewals is number of renewals the leader can miss before
+	//it is not reported as leader anymore
+	leaderMissedRenewals = 3
+)
+
+// LeaderJob is a job ingesting markets data, run only by the leader so that
+// replicas don't store every point more than once
+type LeaderJob struct {
+	Name  string
+	Start func() error
+	Stop  func()
+}
+
+type LeaderService interface {
+	// Start campaigns for the leadership, the first time synchronously and
+	//then periodically, jobs are started when the node is elected and stopped
+	//when it loses the leadership, an error is returned only if the jobs
+	//could not be started the first time
+	Start(jobs ...LeaderJob) error
+	// Stop stops campaigning and the jobs, and gives up the leadership
+	Stop()
+	// GetLeaderStatus returns whether this node is the leader and which node
+	//is leading
+	GetLeaderStatus(ctx context.Context) (*LeaderStatus, error)
+}
+
+type leaderService struct {
+	leaderRepository domain.LeaderRepository
+	nodeID           string
+	interval         time.Duration
+
+	lock     sync.Mutex
+	jobs     []LeaderJob
+	isLeader bool
+	quit     chan struct{}
+	done     chan struct{}
+}
+
+func NewLeaderService(
+	leaderRepository domain.LeaderRepository,
+	nodeID string,
+	interval time.Duration,
+) LeaderService {
+	return &leaderService{
+		leaderRepository: leaderRepository,
+		nodeID:           nodeID,
+		interval:         interval,
+	}
+}
+
+func (l *leaderService) Start(jobs ...LeaderJob) error {
+	l.lock.Lock()
+	l.jobs = jobs
+	l.lock.Unlock()
+
+	if err := l.campaign(); err != nil {
+		return err
+	}
+
+	l.quit, l.done = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(l.done)
+
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-l.quit:
+				return
+			case <-ticker.C:
+				if err := l.campaign(); err != nil {
+					log.Errorf("leader campaign: %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (l *leaderService) Stop() {
+	if l.quit != nil {
+		close(l.quit)
+		<-l.done
+	}
+
+	// jobs are stopped without holding the lock, waiting for the running
+	//ones can take long
+	l.lock.Lock()
+	wasLeader, jobs := l.isLeader, l.jobs
+	l.isLeader = false
+	l.lock.Unlock()
+
+	if wasLeader {
+		stopJobs(jobs)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.interval)
+	defer cancel()
+	if err := l.leaderRepository.ReleaseLeadership(ctx, l.nodeID); err != nil {
+		log.Errorf("Stop -> ReleaseLeadership: %v", err)
+	}
+}
+
+func (l *leaderService) GetLeaderStatus(
+	ctx context.Context,
+) (*LeaderStatus, error) {
+	leader, err := l.leaderRepository.GetLeader(
+		ctx, time.Now().Add(-leaderMissedRenewals*l.interval),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	l.lock.Lock()
+	status := &LeaderStatus{
+		NodeID:   l.nodeID,
+		IsLeader: l.isLeader,
+	}
+	l.lock.Unlock()
+
+	if leader != nil {
+		status.LeaderNodeID = leader.NodeID
+		status.LeaderElectedAt = leader.ElectedAt
+		status.LeaderRenewedAt = leader.RenewedAt
+	}
+
+	return status, nil
+}
+
+// campaign tries to acquire, or to renew, the leadership and starts or stops
+// the jobs if the node has been elected or has lost the leadership, failing
+// to reach the db counts as losing it
+func (l *leaderService) campaign() error {
+	ctx, cancel := context.WithTimeout(context.Background(), l.interval)
+	defer cancel()
+
+	isLeader, err := l.leaderRepository.TryAcquireLeadership(ctx, l.nodeID)
+	if err != nil {
+		log.Errorf("campaign -> TryAcquireLeadership: %v", err)
+	}
+
+	// the status is updated right away, while jobs are started or stopped
+	//after releasing the lock, stopping them waits for the running ones
+	l.lock.Lock()
+	wasLeader, jobs := l.isLeader, l.jobs
+	l.isLeader = isLeader
+	l.lock.Unlock()
+
+	switch {
+	case isLeader && !wasLeader:
+		log.Infof("node %v elected leader, starting jobs", l.nodeID)
+		if err := startJobs(jobs); err != nil {
+			l.lock.Lock()
+			l.isLeader = false
+			l.lock.Unlock()
+
+			if err := l.leaderRepository.ReleaseLeadership(ctx, l.nodeID); err != nil {
+				log.Errorf("campaign -> ReleaseLeadership: %v", err)
+			}
+			return err
+		}
+	case !isLeader && wasLeader:
+		log.Warnf("node %v lost the leadership, stopping jobs", l.nodeID)
+		stopJobs(jobs)
+	}
+
+	return nil
+}
+
+// startJobs starts all jobs, those already started are stopped if one fails
+func startJobs(jobs []LeaderJob) error {
+	for i, v := range jobs {
+		if err := v.Start(); err != nil {
+			for _, j := range jobs[:i] {
+				j.Stop()
+			}
+			log.Errorf("startJobs -> %v: %v", v.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func stopJobs(jobs []LeaderJob) {
+	for _, v := range jobs {
+		v.Stop()
+	}
+}
+
+// stopCronJobs stops the scheduler, waits for the running jobs to complete and
+// removes them, so that they are not added twice when started again
+func stopCronJobs(cronSvc *cron.Cron) {
+	<-cronSvc.Stop().Done()
+	for _, v := range cronSvc.Entries() {
+		cronSvc.Remove(v.ID)
+	}
+}
@@ -0,0 +1,191 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+type leaderRepositoryStub struct {
+	lock   sync.Mutex
+	leader *domain.Leader
+	err    error
+}
+
+func (l *leaderRepositoryStub) TryAcquireLeadership(
+	ctx context.Context,
+	nodeID string,
+) (bool, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.err != nil {
+		return false, l.err
+	}
+	if l.leader == nil {
+		l.leader = &domain.Leader{NodeID: nodeID, ElectedAt: time.Now()}
+	}
+	l.leader.RenewedAt = time.Now()
+	return l.leader.NodeID == nodeID, nil
+}
+
+func (l *leaderRepositoryStub) ReleaseLeadership(
+	ctx context.Context,
+	nodeID string,
+) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.leader != nil && l.leader.NodeID == nodeID {
+		l.leader = nil
+	}
+	return nil
+}
+
+func (l *leaderRepositoryStub) GetLeader(
+	ctx context.Context,
+	renewedSince time.Time,
+) (*domain.Leader, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.leader == nil || l.leader.RenewedAt.Before(renewedSince) {
+		return nil, nil
+	}
+	leader := *l.leader
+	return &leader, nil
+}
+
+func (l *leaderRepositoryStub) setErr(err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.err = err
+}
+
+type leaderJobStub struct {
+	lock    sync.Mutex
+	running bool
+	starts  int
+}
+
+func (j *leaderJobStub) job() LeaderJob {
+	return LeaderJob{
+		Name: "stub",
+		Start: func() error {
+			j.lock.Lock()
+			defer j.lock.Unlock()
+			j.running = true
+			j.starts++
+			return nil
+		},
+		Stop: func() {
+			j.lock.Lock()
+			defer j.lock.Unlock()
+			j.running = false
+		},
+	}
+}
+
+func (j *leaderJobStub) isRunning() bool {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return j.running
+}
+
+func TestLeaderService(t *testing.T) {
+	ctx := context.Background()
+	repository := &leaderRepositoryStub{}
+	interval := 10 * time.Millisecond
+
+	leaderJob, followerJob := &leaderJobStub{}, &leaderJobStub{}
+	leader := NewLeaderService(repository, "node1", interval)
+	follower := NewLeaderService(repository, "node2", interval)
+	require.NoError(t, leader.Start(leaderJob.job()))
+	require.NoError(t, follower.Start(followerJob.job()))
+
+	// only the leader runs the jobs, any node returns the leader
+	require.True(t, leaderJob.isRunning())
+	require.False(t, followerJob.isRunning())
+	status, err := follower.GetLeaderStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "node2", status.NodeID)
+	require.False(t, status.IsLeader)
+	require.Equal(t, "node1", status.LeaderNodeID)
+
+	// jobs are stopped when the leader can't confirm its leadership
+	repository.setErr(errors.New("db unavailable"))
+	require.Eventually(t, func() bool {
+		return !leaderJob.isRunning()
+	}, time.Second, interval)
+	repository.setErr(nil)
+	require.Eventually(t, leaderJob.isRunning, time.Second, interval)
+	require.Equal(t, 2, leaderJob.starts)
+
+	// the follower takes over once the leader gives up the leadership
+	leader.Stop()
+	require.False(t, leaderJob.isRunning())
+	require.Eventually(t, followerJob.isRunning, time.Second, interval)
+
+	status, err = follower.GetLeaderStatus(ctx)
+	require.NoError(t, err)
+	require.True(t, status.IsLeader)
+	require.Equal(t, "node2", status.LeaderNodeID)
+
+	follower.Stop()
+	require.False(t, followerJob.isRunning())
+}
+
+func TestLeaderServiceStartJobsFailure(t *testing.T) {
+	repository := &leaderRepositoryStub{}
+	job := &leaderJobStub{}
+	svc := NewLeaderService(repository, "node1", time.Minute)
+
+	err := svc.Start(job.job(), LeaderJob{
+		Name:  "failing",
+		Start: func() error { return errors.New("invalid cron expression") },
+		Stop:  func() {},
+	})
+	require.Error(t, err)
+	require.False(t, job.isRunning())
+
+	leader, err := repository.GetLeader(context.Background(), time.Time{})
+	require.NoError(t, err)
+	require.Nil(t, leader)
+}
+
+func TestLeaderServiceStatusWhileStoppingJobs(t *testing.T) {
+	repository := &leaderRepositoryStub{}
+	svc := NewLeaderService(repository, "node1", time.Minute)
+
+	stopping, release := make(chan struct{}), make(chan struct{})
+	require.NoError(t, svc.Start(LeaderJob{
+		Name:  "slow",
+		Start: func() error { return nil },
+		Stop: func() {
+			close(stopping)
+			<-release
+		},
+	}))
+
+	stopped := make(chan struct{})
+	go func() {
+		svc.Stop()
+		close(stopped)
+	}()
+	<-stopping
+
+	// the status is not blocked by the jobs waiting for running ones
+	status, err := svc.GetLeaderStatus(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.IsLeader)
+
+	close(release)
+	<-stopped
+}
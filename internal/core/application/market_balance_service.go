@@ -3,11 +3,7 @@ package application
 import (
 	"context"
 	"errors"
-	"fmt"
-	"github.com/robfig/cron/v3"
-	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
-	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
 	"strconv"
 	"time"
 )
@@ -31,31 +27,21 @@ type MarketBalanceService interface {
 		timeFrame TimeFrame,
 		marketIDs ...string,
 	) (*MarketsBalances, error)
-	// StartFetchingBalancesJob starts cron job that will periodically fetch and store balances for all markets
-	StartFetchingBalancesJob() error
 }
 
 type marketBalanceService struct {
-	marketBalanceRepository    domain.MarketBalanceRepository
-	marketRepository           domain.MarketRepository
-	tdexMarketLoaderSvc        tdexmarketloader.Service
-	cronSvc                    *cron.Cron
-	fetchBalanceCronExpression string
+	marketBalanceRepository domain.MarketBalanceRepository
+	marketRepository        domain.MarketRepository
 }
 
 func NewMarketBalanceService(
 	marketBalanceRepository domain.MarketBalanceRepository,
 	marketRepository domain.MarketRepository,
-	tdexMarketLoaderSvc tdexmarketloader.Service,
-	jobPeriodInMinutes string,
 ) MarketBalanceService {
 
 	return &marketBalanceService{
-		marketBalanceRepository:    marketBalanceRepository,
-		cronSvc:                    cron.New(),
-		marketRepository:           marketRepository,
-		tdexMarketLoaderSvc:        tdexMarketLoaderSvc,
-		fetchBalanceCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
+		marketBalanceRepository: marketBalanceRepository,
+		marketRepository:        marketRepository,
 	}
 }
 
@@ -148,63 +134,3 @@ func (m *marketBalanceService) GetBalances(
 		MarketsBalances: result,
 	}, nil
 }
-
-func (m *marketBalanceService) StartFetchingBalancesJob() error {
-	if _, err := m.cronSvc.AddJob(
-		m.fetchBalanceCronExpression,
-		cron.FuncJob(m.FetchBalancesForAllMarkets),
-	); err != nil {
-		return err
-	}
-
-	m.cronSvc.Start()
-
-	return nil
-}
-
-func (m *marketBalanceService) FetchBalancesForAllMarkets() {
-	log.Infof("job FetchBalancesForAllMarkets at: %v", time.Now())
-	ctx := context.Background()
-
-	markets, err := m.marketRepository.GetMarketsForActiveIndicator(ctx, true)
-	if err != nil {
-		log.Errorf("FetchBalancesForAllMarkets -> GetAllMarkets: %v", err)
-		return
-	}
-
-	for _, v := range markets {
-		go func(market domain.Market) {
-			m.FetchAndInsertBalance(ctx, market)
-		}(v)
-	}
-}
-
-func (m *marketBalanceService) FetchAndInsertBalance(
-	ctx context.Context,
-	market domain.Market,
-) {
-	balance, err := m.tdexMarketLoaderSvc.FetchBalance(
-		ctx,
-		tdexmarketloader.Market{
-			Url:        market.Url,
-			QuoteAsset: market.QuoteAsset,
-			BaseAsset:  market.BaseAsset,
-		},
-	)
-	if err != nil {
-		log.Errorf("FetchAndInsertBalance -> FetchBalance: %v", err)
-		return
-	}
-
-	if err := m.InsertBalance(ctx, MarketBalance{
-		MarketID:     strconv.Itoa(market.ID),
-		BaseBalance:  balance.BaseBalance,
-		BaseAsset:    market.BaseAsset,
-		QuoteBalance: balance.QuoteBalance,
-		QuoteAsset:   market.QuoteAsset,
-		Time:         time.Now(),
-	}); err != nil {
-		log.Errorf("FetchAndInsertBalance -> InsertBalance: %v", err)
-		return
-	}
-}
@@ -0,0 +1,280 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+
+	"github.com/robfig/cron/v3"
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+type MarketDepthService interface {
+	// GetDepth returns, for every timeFrame bucket in time range, the effective
+	//price of buying and selling each of the sampled amounts together with its
+	//slippage from the mid price, if marketIDs are not passed depth is returned
+	//for all markets
+	GetDepth(
+		ctx context.Context,
+		timeRange TimeRange,
+		timeFrame TimeFrame,
+		marketIDs ...string,
+	) (*MarketsDepth, error)
+	// StartFetchingDepthJob starts cron job that will periodically preview
+	//trades of the configured amounts and store depth for all markets
+	StartFetchingDepthJob() error
+	// StopFetchingDepthJob stops the cron job, it can be started again
+	StopFetchingDepthJob()
+}
+
+type marketDepthService struct {
+	marketDepthRepository    domain.MarketDepthRepository
+	marketRepository         domain.MarketRepository
+	tdexMarketLoaderSvc      tdexmarketloader.Service
+	amounts                  []uint64
+	cronSvc                  *cron.Cron
+	fetchDepthCronExpression string
+	marketsShardSvc          MarketsShardService
+}
+
+func NewMarketDepthService(
+	marketDepthRepository domain.MarketDepthRepository,
+	marketRepository domain.MarketRepository,
+	tdexMarketLoaderSvc tdexmarketloader.Service,
+	amounts []uint64,
+	jobPeriodInMinutes string,
+	marketsShardSvc MarketsShardService,
+) MarketDepthService {
+	return &marketDepthService{
+		marketDepthRepository:    marketDepthRepository,
+		marketRepository:         marketRepository,
+		tdexMarketLoaderSvc:      tdexMarketLoaderSvc,
+		amounts:                  amounts,
+		cronSvc:                  cron.New(),
+		fetchDepthCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
+		marketsShardSvc:          marketsShardSvc,
+	}
+}
+
+func (m *marketDepthService) GetDepth(
+	ctx context.Context,
+	timeRange TimeRange,
+	timeFrame TimeFrame,
+	marketIDs ...string,
+) (*MarketsDepth, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsMap := make(map[string]domain.Market)
+	for _, v := range markets {
+		marketsMap[strconv.Itoa(v.ID)] = v
+	}
+
+	marketsDepth, err := m.marketDepthRepository.GetDepthForMarkets(
+		ctx,
+		startTime,
+		endTime,
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]MarketDepth)
+	for k, v := range marketsDepth {
+		result[k] = MarketDepth{
+			BaseAsset:  marketsMap[k].BaseAsset,
+			QuoteAsset: marketsMap[k].QuoteAsset,
+			Curves:     buildDepthCurves(v),
+		}
+	}
+
+	return &MarketsDepth{
+		MarketsDepth: result,
+	}, nil
+}
+
+// buildDepthCurves groups depth levels by time and merges buy and sell
+// prices of the same amount into a single level, curves are sorted by time
+// ASC and levels by amount ASC
+func buildDepthCurves(depth []domain.MarketDepth) []DepthCurve {
+	levelsPerTime := make(map[time.Time]map[string]*DepthLevel)
+	for _, v := range depth {
+		levels, ok := levelsPerTime[v.Time]
+		if !ok {
+			levels = make(map[string]*DepthLevel)
+			levelsPerTime[v.Time] = levels
+		}
+
+		amount := v.Amount.Shift(-assetPrecision)
+		level, ok := levels[amount.String()]
+		if !ok {
+			level = &DepthLevel{Amount: amount}
+			levels[amount.String()] = level
+		}
+
+		switch v.TradeType {
+		case domain.TradeTypeBuy:
+			level.BuyPrice = v.Price
+		case domain.TradeTypeSell:
+			level.SellPrice = v.Price
+		}
+	}
+
+	times := make([]time.Time, 0, len(levelsPerTime))
+	for k := range levelsPerTime {
+		times = append(times, k)
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+
+	curves := make([]DepthCurve, 0, len(times))
+	for _, t := range times {
+		levels := make([]DepthLevel, 0, len(levelsPerTime[t]))
+		for _, v := range levelsPerTime[t] {
+			levels = append(levels, *v)
+		}
+		sort.Slice(levels, func(i, j int) bool {
+			return levels[i].Amount.LessThan(levels[j].Amount)
+		})
+
+		midPrice := depthMidPrice(levels)
+		if !midPrice.IsZero() {
+			for i := range levels {
+				if !levels[i].BuyPrice.IsZero() {
+					levels[i].BuySlippageBps = levels[i].BuyPrice.Sub(midPrice).
+						Div(midPrice).Shift(4).Round(2)
+				}
+				if !levels[i].SellPrice.IsZero() {
+					levels[i].SellSlippageBps = midPrice.Sub(levels[i].SellPrice).
+						Div(midPrice).Shift(4).Round(2)
+				}
+			}
+		}
+
+		curves = append(curves, DepthCurve{
+			MidPrice: midPrice,
+			Levels:   levels,
+			Time:     t,
+		})
+	}
+
+	return curves
+}
+
+// depthMidPrice returns the mean of buy and sell prices of the smallest
+// amount having both of them, levels must be sorted by amount ASC
+func depthMidPrice(levels []DepthLevel) decimal.Decimal {
+	for _, v := range levels {
+		if !v.BuyPrice.IsZero() && !v.SellPrice.IsZero() {
+			return v.BuyPrice.Add(v.SellPrice).Div(decimal.NewFromInt(2))
+		}
+	}
+
+	return decimal.Zero
+}
+
+func (m *marketDepthService) StartFetchingDepthJob() error {
+	if _, err := m.cronSvc.AddJob(
+		m.fetchDepthCronExpression,
+		cron.FuncJob(m.FetchDepthForAllMarkets),
+	); err != nil {
+		return err
+	}
+
+	m.cronSvc.Start()
+
+	return nil
+}
+
+func (m *marketDepthService) StopFetchingDepthJob() {
+	stopCronJobs(m.cronSvc)
+}
+
+func (m *marketDepthService) FetchDepthForAllMarkets() {
+	log.Infof("job FetchDepthForAllMarkets at: %v", time.Now())
+	ctx := context.Background()
+
+	markets, err := m.marketRepository.GetMarketsForActiveIndicator(ctx, true)
+	if err != nil {
+		log.Errorf("FetchDepthForAllMarkets -> GetAllMarkets: %v", err)
+		return
+	}
+
+	for _, v := range ownedMarkets(m.marketsShardSvc, markets) {
+		go func(market domain.Market) {
+			m.FetchAndInsertDepth(ctx, market)
+		}(v)
+	}
+}
+
+func (m *marketDepthService) FetchAndInsertDepth(
+	ctx context.Context,
+	market domain.Market,
+) {
+	levels, err := m.tdexMarketLoaderSvc.FetchDepth(
+		ctx,
+		tdexmarketloader.Market{
+			Url:        market.Url,
+			QuoteAsset: market.QuoteAsset,
+			BaseAsset:  market.BaseAsset,
+		},
+		m.amounts,
+	)
+	// depth is previewed only by providers speaking the tdex protocol
+	if errors.Is(err, tdexmarketloader.ErrNotSupportedByAdapter) {
+		log.Debugf("FetchAndInsertDepth for %s -> FetchDepth: %v", market.Url, err)
+		return
+	}
+	if err != nil {
+		log.Errorf("FetchAndInsertDepth for %s -> FetchDepth: %v", market.Url, err)
+		return
+	}
+
+	// all levels of a run share the same time so that they are shown as a
+	//single curve
+	now := time.Now()
+	depth := make([]domain.MarketDepth, 0, len(levels))
+	for _, v := range levels {
+		depth = append(depth, domain.MarketDepth{
+			MarketID:  strconv.Itoa(market.ID),
+			TradeType: v.TradeType.String(),
+			Amount:    decimal.NewFromInt(int64(v.Amount)),
+			Price:     v.Price,
+			Time:      now,
+		})
+	}
+
+	if err := m.marketDepthRepository.InsertDepth(ctx, depth); err != nil {
+		log.Errorf("FetchAndInsertDepth for %s -> InsertDepth: %v", market.Url, err)
+		return
+	}
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestBuildDepthCurves(t *testing.T) {
+	now := time.Now()
+	depth := []domain.MarketDepth{
+		{
+			TradeType: domain.TradeTypeSell,
+			Amount:    decimal.NewFromInt(100000000),
+			Price:     decimal.NewFromInt(29700),
+			Time:      now,
+		},
+		{
+			TradeType: domain.TradeTypeBuy,
+			Amount:    decimal.NewFromInt(1000000),
+			Price:     decimal.NewFromInt(30030),
+			Time:      now,
+		},
+		{
+			TradeType: domain.TradeTypeSell,
+			Amount:    decimal.NewFromInt(1000000),
+			Price:     decimal.NewFromInt(29970),
+			Time:      now,
+		},
+		{
+			TradeType: domain.TradeTypeBuy,
+			Amount:    decimal.NewFromInt(100000000),
+			Price:     decimal.NewFromInt(30300),
+			Time:      now,
+		},
+		{
+			TradeType: domain.TradeTypeBuy,
+			Amount:    decimal.NewFromInt(1000000),
+			Price:     decimal.NewFromInt(31000),
+			Time:      now.Add(-time.Hour),
+		},
+	}
+
+	curves := buildDepthCurves(depth)
+	require.Len(t, curves, 2)
+
+	require.Equal(t, now.Add(-time.Hour), curves[0].Time)
+	require.True(t, curves[0].MidPrice.IsZero())
+	require.Len(t, curves[0].Levels, 1)
+
+	curve := curves[1]
+	require.True(t, decimal.NewFromInt(30000).Equal(curve.MidPrice))
+	require.Len(t, curve.Levels, 2)
+	require.True(t, decimal.NewFromFloat(0.01).Equal(curve.Levels[0].Amount))
+	require.True(t, decimal.NewFromInt(10).Equal(curve.Levels[0].BuySlippageBps))
+	require.True(t, decimal.NewFromInt(10).Equal(curve.Levels[0].SellSlippageBps))
+	require.True(t, decimal.NewFromInt(1).Equal(curve.Levels[1].Amount))
+	require.True(t, decimal.NewFromInt(100).Equal(curve.Levels[1].BuySlippageBps))
+	require.True(t, decimal.NewFromInt(100).Equal(curve.Levels[1].SellSlippageBps))
+}
@@ -0,0 +1,273 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// intermediateCurrency is used to cross the external rate of an asset
+	//pair when rater can't convert directly between the two currencies
+	intermediateCurrency = "usd"
+)
+
+var (
+	ErrExternalRateNotFound = errors.New("external rate not found")
+)
+
+type MarketDeviationService interface {
+	// GetDeviations returns, for every market, the distance in basis points of
+	//recorded quote price from the external rate of the same asset pair, plus
+	//mean, max and percentage of time outside tolerance, if toleranceBps is 0
+	//the default one is used, if marketIDs are not passed all markets are
+	//considered, every price is compared with the rate recorded closest to
+	//its time, prices without a recorded rate are skipped and markets left
+	//without any are omitted
+	GetDeviations(
+		ctx context.Context,
+		timeRange TimeRange,
+		timeFrame TimeFrame,
+		toleranceBps int,
+		marketIDs ...string,
+	) (*MarketsDeviations, error)
+}
+
+type marketDeviationService struct {
+	marketPriceRepository domain.MarketPriceRepository
+	rateRepository        domain.RateRepository
+	marketRepository      domain.MarketRepository
+	raterSvc              port.RateService
+	defaultToleranceBps   int
+}
+
+func NewMarketDeviationService(
+	marketPriceRepository domain.MarketPriceRepository,
+	rateRepository domain.RateRepository,
+	marketRepository domain.MarketRepository,
+	raterSvc port.RateService,
+	defaultToleranceBps int,
+) MarketDeviationService {
+	return &marketDeviationService{
+		marketPriceRepository: marketPriceRepository,
+		rateRepository:        rateRepository,
+		marketRepository:      marketRepository,
+		raterSvc:              raterSvc,
+		defaultToleranceBps:   defaultToleranceBps,
+	}
+}
+
+func (m *marketDeviationService) GetDeviations(
+	ctx context.Context,
+	timeRange TimeRange,
+	timeFrame TimeFrame,
+	toleranceBps int,
+	marketIDs ...string,
+) (*MarketsDeviations, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	if toleranceBps < 0 {
+		return nil, ErrInvalidTolerance
+	}
+	if toleranceBps == 0 {
+		toleranceBps = m.defaultToleranceBps
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsMap := make(map[string]domain.Market)
+	for _, v := range markets {
+		marketsMap[strconv.Itoa(v.ID)] = v
+	}
+
+	marketsPrices, err := getAllPrices(
+		ctx,
+		m.marketPriceRepository,
+		startTime,
+		endTime,
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := newRateHistory(m.rateRepository, m.raterSvc, startTime, endTime)
+	result := make(map[string]MarketDeviations)
+	for k, v := range marketsPrices {
+		market := marketsMap[k]
+		baseTicker, quoteTicker, err := getAssetsCurrencies(
+			m.raterSvc, market.BaseAsset, market.QuoteAsset,
+		)
+		if err != nil {
+			log.Debugf("GetDeviations -> getAssetsCurrencies for market %s: %v", k, err)
+			continue
+		}
+
+		deviations := calculateDeviations(
+			v,
+			func(at time.Time) (decimal.Decimal, bool) {
+				rate, err := externalRateAt(ctx, rates, baseTicker, quoteTicker, at)
+				if err != nil {
+					return decimal.Zero, false
+				}
+				return rate, true
+			},
+			decimal.NewFromInt(int64(toleranceBps)),
+		)
+		if len(deviations.Deviations) == 0 {
+			log.Debugf(
+				"GetDeviations -> no external rate recorded for market %s (%s/%s)",
+				k, baseTicker, quoteTicker,
+			)
+			continue
+		}
+		deviations.BaseAsset = market.BaseAsset
+		deviations.QuoteAsset = market.QuoteAsset
+
+		result[k] = deviations
+	}
+
+	return &MarketsDeviations{
+		MarketsDeviations: result,
+	}, nil
+}
+
+// getAssetsCurrencies returns the currencies tickers of base and quote assets
+func getAssetsCurrencies(
+	raterSvc port.RateService,
+	baseAsset, quoteAsset string,
+) (string, string, error) {
+	baseTicker, err := raterSvc.GetAssetCurrency(baseAsset)
+	if err != nil {
+		return "", "", err
+	}
+	quoteTicker, err := raterSvc.GetAssetCurrency(quoteAsset)
+	if err != nil {
+		return "", "", err
+	}
+
+	return strings.ToLower(baseTicker), strings.ToLower(quoteTicker), nil
+}
+
+// externalRateAt returns the amount of quote currency one unit of base
+// currency was worth at the given time according to the recorded rates, the
+// rate is looked up directly, then inverted and finally crossed through
+// intermediateCurrency
+func externalRateAt(
+	ctx context.Context,
+	rates *rateHistory,
+	baseTicker, quoteTicker string,
+	at time.Time,
+) (decimal.Decimal, error) {
+	if baseTicker == quoteTicker {
+		return decimal.NewFromInt(1), nil
+	}
+
+	if rate, ok := rates.recordedRateAt(ctx, baseTicker, quoteTicker, at); ok {
+		return rate, nil
+	}
+
+	if inverseRate, ok := rates.recordedRateAt(
+		ctx, quoteTicker, baseTicker, at,
+	); ok {
+		return decimal.NewFromInt(1).Div(inverseRate), nil
+	}
+
+	if baseRate, ok := rates.recordedRateAt(
+		ctx, baseTicker, intermediateCurrency, at,
+	); ok {
+		if quoteRate, ok := rates.recordedRateAt(
+			ctx, quoteTicker, intermediateCurrency, at,
+		); ok {
+			return baseRate.Div(quoteRate), nil
+		}
+	}
+
+	return decimal.Zero, fmt.Errorf(
+		"%w: %s/%s at %s", ErrExternalRateNotFound, baseTicker, quoteTicker,
+		at.Format(time.RFC3339),
+	)
+}
+
+// calculateDeviations returns deviation in basis points of every price from
+// the reference one at the same time, positive if market price is higher,
+// together with mean and max of absolute deviations and percentage of points
+// further than toleranceBps from reference price
+func calculateDeviations(
+	prices []domain.MarketPrice,
+	referencePriceAt func(time.Time) (decimal.Decimal, bool),
+	toleranceBps decimal.Decimal,
+) MarketDeviations {
+	deviations := make([]Deviation, 0, len(prices))
+	sumBps, maxBps := decimal.Zero, decimal.Zero
+	outside := 0
+	for _, v := range prices {
+		if v.QuotePrice.IsZero() {
+			continue
+		}
+
+		referencePrice, ok := referencePriceAt(v.Time)
+		if !ok || referencePrice.IsZero() {
+			continue
+		}
+
+		deviationBps := v.QuotePrice.Sub(referencePrice).
+			Div(referencePrice).Shift(4).Round(2)
+		absDeviationBps := deviationBps.Abs()
+
+		sumBps = sumBps.Add(absDeviationBps)
+		if absDeviationBps.GreaterThan(maxBps) {
+			maxBps = absDeviationBps
+		}
+		if absDeviationBps.GreaterThan(toleranceBps) {
+			outside++
+		}
+
+		deviations = append(deviations, Deviation{
+			Price:          v.QuotePrice,
+			ReferencePrice: referencePrice,
+			DeviationBps:   deviationBps,
+			Time:           v.Time,
+		})
+	}
+
+	result := MarketDeviations{
+		Deviations: deviations,
+		MaxBps:     maxBps,
+	}
+	if len(deviations) > 0 {
+		count := decimal.NewFromInt(int64(len(deviations)))
+		result.MeanBps = sumBps.Div(count).Round(2)
+		result.OutsideTolerancePercentage = decimal.NewFromInt(int64(outside)).
+			Div(count).Shift(2).Round(2)
+	}
+
+	return result
+}
@@ -0,0 +1,141 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+)
+
+func TestExternalRateAt(t *testing.T) {
+	now := time.Now()
+	rate := func(value decimal.Decimal, at time.Time) domain.Rate {
+		return domain.Rate{Value: value, Time: at}
+	}
+
+	testCases := []struct {
+		name          string
+		quoteCurrency string
+		rates         map[[2]string][]domain.Rate
+		at            time.Time
+		expectedRate  decimal.Decimal
+		expectedErr   error
+	}{
+		{
+			name:          "direct rate",
+			quoteCurrency: "usd",
+			rates: map[[2]string][]domain.Rate{
+				{"bitcoin", "usd"}: {
+					rate(decimal.NewFromInt(30000), now.Add(-2*time.Hour)),
+					rate(decimal.NewFromInt(20000), now),
+				},
+			},
+			at:           now.Add(-90 * time.Minute),
+			expectedRate: decimal.NewFromInt(30000),
+		},
+		{
+			name:          "inverse rate",
+			quoteCurrency: "usd",
+			rates: map[[2]string][]domain.Rate{
+				{"usd", "bitcoin"}: {rate(decimal.NewFromFloat(0.00004), now)},
+			},
+			at:           now,
+			expectedRate: decimal.NewFromInt(25000),
+		},
+		{
+			name:          "crossed rate",
+			quoteCurrency: "cad",
+			rates: map[[2]string][]domain.Rate{
+				{"bitcoin", "usd"}: {rate(decimal.NewFromInt(30000), now)},
+				{"cad", "usd"}:     {rate(decimal.NewFromFloat(0.75), now)},
+			},
+			at:           now,
+			expectedRate: decimal.NewFromInt(40000),
+		},
+		{
+			name:          "rate not recorded",
+			quoteCurrency: "usd",
+			rates:         map[[2]string][]domain.Rate{},
+			at:            now,
+			expectedErr:   ErrExternalRateNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// ConvertCurrency is not mocked, current rates must not be used
+			raterMock := new(port.MockRateService)
+			rates := newRateHistory(
+				rateRepositoryStub(tt.rates), raterMock, now.Add(-time.Hour), now,
+			)
+
+			rate, err := externalRateAt(
+				context.Background(), rates, "bitcoin", tt.quoteCurrency, tt.at,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.expectedRate.Equal(rate), rate.String())
+		})
+	}
+}
+
+func TestGetDeviationsMissingTimeFrame(t *testing.T) {
+	predefinedPeriod := LastDay
+	svc := NewMarketDeviationService(nil, nil, nil, nil, 100)
+
+	_, err := svc.GetDeviations(
+		context.Background(),
+		TimeRange{PredefinedPeriod: &predefinedPeriod},
+		TzNil,
+		0,
+	)
+	require.ErrorIs(t, err, ErrMissingTimeFrame)
+}
+
+func TestCalculateDeviations(t *testing.T) {
+	now := time.Now()
+	prices := []domain.MarketPrice{
+		{QuotePrice: decimal.NewFromInt(30000), Time: now},
+		{QuotePrice: decimal.NewFromInt(30150), Time: now.Add(time.Hour)},
+		{QuotePrice: decimal.NewFromInt(29400), Time: now.Add(2 * time.Hour)},
+		{QuotePrice: decimal.NewFromInt(30030), Time: now.Add(3 * time.Hour)},
+	}
+
+	deviations := calculateDeviations(
+		prices,
+		func(time.Time) (decimal.Decimal, bool) {
+			return decimal.NewFromInt(30000), true
+		},
+		decimal.NewFromInt(100),
+	)
+
+	require.Len(t, deviations.Deviations, 4)
+	require.True(t, decimal.Zero.Equal(deviations.Deviations[0].DeviationBps))
+	require.True(t, decimal.NewFromInt(50).Equal(deviations.Deviations[1].DeviationBps))
+	require.True(t, decimal.NewFromInt(-200).Equal(deviations.Deviations[2].DeviationBps))
+	require.True(t, decimal.NewFromInt(10).Equal(deviations.Deviations[3].DeviationBps))
+	require.True(t, decimal.NewFromInt(65).Equal(deviations.MeanBps), deviations.MeanBps.String())
+	require.True(t, decimal.NewFromInt(200).Equal(deviations.MaxBps))
+	require.True(t, decimal.NewFromInt(25).Equal(deviations.OutsideTolerancePercentage))
+}
+
+type rateRepositoryStub map[[2]string][]domain.Rate
+
+func (r rateRepositoryStub) InsertRate(context.Context, domain.Rate) error {
+	return nil
+}
+
+func (r rateRepositoryStub) GetRates(
+	_ context.Context,
+	source, target string,
+	_, _ time.Time,
+) ([]domain.Rate, error) {
+	return r[[2]string{source, target}], nil
+}
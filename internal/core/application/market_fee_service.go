@@ -0,0 +1,192 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+
+	"github.com/robfig/cron/v3"
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+type MarketFeeService interface {
+	// GetFees returns fees charged by markets in the given time range, if
+	//timeFrame is passed the last fee of every bucket is returned, if marketIDs
+	//are not passed fees are returned for all markets
+	GetFees(
+		ctx context.Context,
+		timeRange TimeRange,
+		page Page,
+		timeFrame TimeFrame,
+		marketIDs ...string,
+	) (*MarketsFees, error)
+	// StartFetchingFeesJob starts cron job that will periodically fetch and store fees for all markets
+	StartFetchingFeesJob() error
+	// StopFetchingFeesJob stops the cron job, it can be started again
+	StopFetchingFeesJob()
+}
+
+type marketFeeService struct {
+	marketFeeRepository    domain.MarketFeeRepository
+	marketRepository       domain.MarketRepository
+	tdexMarketLoaderSvc    tdexmarketloader.Service
+	cronSvc                *cron.Cron
+	fetchFeeCronExpression string
+	marketsShardSvc        MarketsShardService
+}
+
+func NewMarketFeeService(
+	marketFeeRepository domain.MarketFeeRepository,
+	marketRepository domain.MarketRepository,
+	tdexMarketLoaderSvc tdexmarketloader.Service,
+	jobPeriodInMinutes string,
+	marketsShardSvc MarketsShardService,
+) MarketFeeService {
+	return &marketFeeService{
+		marketFeeRepository:    marketFeeRepository,
+		marketRepository:       marketRepository,
+		tdexMarketLoaderSvc:    tdexMarketLoaderSvc,
+		cronSvc:                cron.New(),
+		fetchFeeCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
+		marketsShardSvc:        marketsShardSvc,
+	}
+}
+
+func (m *marketFeeService) GetFees(
+	ctx context.Context,
+	timeRange TimeRange,
+	page Page,
+	timeFrame TimeFrame,
+	marketIDs ...string,
+) (*MarketsFees, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsMap := make(map[string]domain.Market)
+	for _, v := range markets {
+		marketsMap[strconv.Itoa(v.ID)] = v
+	}
+
+	marketsFees, err := m.marketFeeRepository.GetFeesForMarkets(
+		ctx,
+		startTime,
+		endTime,
+		page.ToDomain(),
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]Fee)
+	for k, v := range marketsFees {
+		fees := make([]Fee, 0, len(v))
+		for _, v1 := range v {
+			fees = append(fees, Fee{
+				BaseAsset:          marketsMap[k].BaseAsset,
+				QuoteAsset:         marketsMap[k].QuoteAsset,
+				BasePercentageFee:  v1.BasePercentageFee,
+				QuotePercentageFee: v1.QuotePercentageFee,
+				BaseFixedFee:       v1.BaseFixedFee,
+				QuoteFixedFee:      v1.QuoteFixedFee,
+				PreviewFeeAmount:   v1.PreviewFeeAmount,
+				PreviewFeeAsset:    v1.PreviewFeeAsset,
+				Time:               v1.Time,
+			})
+		}
+
+		result[k] = fees
+	}
+
+	return &MarketsFees{
+		MarketsFees: result,
+	}, nil
+}
+
+func (m *marketFeeService) StartFetchingFeesJob() error {
+	if _, err := m.cronSvc.AddJob(
+		m.fetchFeeCronExpression,
+		cron.FuncJob(m.FetchFeesForAllMarkets),
+	); err != nil {
+		return err
+	}
+
+	m.cronSvc.Start()
+
+	return nil
+}
+
+func (m *marketFeeService) StopFetchingFeesJob() {
+	stopCronJobs(m.cronSvc)
+}
+
+func (m *marketFeeService) FetchFeesForAllMarkets() {
+	log.Infof("job FetchFeesForAllMarkets at: %v", time.Now())
+	ctx := context.Background()
+
+	markets, err := m.marketRepository.GetMarketsForActiveIndicator(ctx, true)
+	if err != nil {
+		log.Errorf("FetchFeesForAllMarkets -> GetAllMarkets: %v", err)
+		return
+	}
+
+	for _, v := range ownedMarkets(m.marketsShardSvc, markets) {
+		go func(market domain.Market) {
+			m.FetchAndInsertFee(ctx, market)
+		}(v)
+	}
+}
+
+func (m *marketFeeService) FetchAndInsertFee(
+	ctx context.Context,
+	market domain.Market,
+) {
+	fee, err := m.tdexMarketLoaderSvc.FetchFee(
+		ctx,
+		tdexmarketloader.Market{
+			Url:        market.Url,
+			QuoteAsset: market.QuoteAsset,
+			BaseAsset:  market.BaseAsset,
+		},
+	)
+	// providers supporting only v1 protocol don't expose fees
+	if err != nil {
+		log.Debugf("FetchAndInsertFee for %s -> FetchFee: %v", market.Url, err)
+		return
+	}
+
+	if err := m.marketFeeRepository.InsertFee(ctx, domain.MarketFee{
+		MarketID:           strconv.Itoa(market.ID),
+		BasePercentageFee:  decimal.NewFromInt(fee.BasePercentageFee),
+		QuotePercentageFee: decimal.NewFromInt(fee.QuotePercentageFee),
+		BaseFixedFee:       decimal.NewFromInt(fee.BaseFixedFee),
+		QuoteFixedFee:      decimal.NewFromInt(fee.QuoteFixedFee),
+		PreviewFeeAmount:   decimal.NewFromInt(int64(fee.PreviewFeeAmount)),
+		PreviewFeeAsset:    fee.PreviewFeeAsset,
+		Time:               time.Now(),
+	}); err != nil {
+		log.Errorf("FetchAndInsertFee for %s -> InsertFee: %v", market.Url, err)
+		return
+	}
+}
@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
 	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+	"strconv"
 	"time"
 )
 
@@ -17,22 +18,30 @@ const (
 
 type MarketsLoaderService interface {
 	StartFetchingMarketsJob() error
+	// StopFetchingMarketsJob stops the cron job, it can be started again
+	StopFetchingMarketsJob()
 }
 
 type marketsLoaderService struct {
-	marketRepository    domain.MarketRepository
-	tdexMarketLoaderSvc tdexmarketloader.Service
-	cronSvc             *cron.Cron
+	marketRepository      domain.MarketRepository
+	marketEventRepository domain.MarketEventRepository
+	tdexMarketLoaderSvc   tdexmarketloader.Service
+	cronSvc               *cron.Cron
+	alertSvc              AlertService
 }
 
 func NewMarketsLoaderService(
 	marketRepository domain.MarketRepository,
+	marketEventRepository domain.MarketEventRepository,
 	tdexMarketLoaderSvc tdexmarketloader.Service,
+	alertSvc AlertService,
 ) MarketsLoaderService {
 	return &marketsLoaderService{
-		marketRepository:    marketRepository,
-		tdexMarketLoaderSvc: tdexMarketLoaderSvc,
-		cronSvc:             cron.New(),
+		marketRepository:      marketRepository,
+		marketEventRepository: marketEventRepository,
+		tdexMarketLoaderSvc:   tdexMarketLoaderSvc,
+		cronSvc:               cron.New(),
+		alertSvc:              alertSvc,
 	}
 }
 
@@ -52,6 +61,10 @@ func (m *marketsLoaderService) StartFetchingMarketsJob() error {
 	return nil
 }
 
+func (m *marketsLoaderService) StopFetchingMarketsJob() {
+	stopCronJobs(m.cronSvc)
+}
+
 func (m *marketsLoaderService) FetchMarkets() {
 	log.Infof("job FetchMarkets at: %v", time.Now())
 	//TODO add context with timeout
@@ -87,7 +100,73 @@ func (m *marketsLoaderService) FetchMarkets() {
 
 	if err := m.updateMarketActiveStatusAndInsertNew(existingMarkets, activeMarkets); err != nil {
 		log.Errorf("FetchMarkets -> updateMarketActiveStatusAndInsertNew: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for marketID, active := range marketStatusChanges(existingMarkets, activeMarkets) {
+		eventType := domain.MarketEventDeactivated
+		if active {
+			eventType = domain.MarketEventActivated
+		}
+		m.insertMarketEvent(marketID, eventType, now)
+
+		m.alertSvc.EvaluateMarketStatus(
+			context.Background(),
+			strconv.Itoa(marketID),
+			active,
+		)
 	}
+
+	//markets inserted by this run are the ones discovered
+	markets, err := m.marketRepository.GetAllMarkets(context.Background())
+	if err != nil {
+		log.Errorf("FetchMarkets -> GetAllMarkets: %v", err)
+		return
+	}
+	existingKeys := make(map[string]bool)
+	for _, v := range existingMarkets {
+		existingKeys[v.Key()] = true
+	}
+	for _, v := range markets {
+		if !existingKeys[v.Key()] {
+			m.insertMarketEvent(v.ID, domain.MarketEventDiscovered, now)
+		}
+	}
+}
+
+func (m *marketsLoaderService) insertMarketEvent(
+	marketID int,
+	eventType string,
+	tm time.Time,
+) {
+	if err := m.marketEventRepository.InsertMarketEvent(
+		context.Background(),
+		domain.MarketEvent{
+			MarketID: marketID,
+			Type:     eventType,
+			Time:     tm,
+		},
+	); err != nil {
+		log.Errorf("FetchMarkets -> InsertMarketEvent: %v", err)
+	}
+}
+
+// marketStatusChanges returns the active status, by market id, of the existing
+// markets that got activated or inactivated by the last fetch
+func marketStatusChanges(
+	existingMarkets []domain.Market,
+	activeMarkets map[string]domain.Market,
+) map[int]bool {
+	changes := make(map[int]bool)
+	for _, v := range existingMarkets {
+		_, active := activeMarkets[v.Key()]
+		if active != v.Active {
+			changes[v.ID] = active
+		}
+	}
+
+	return changes
 }
 
 func (m *marketsLoaderService) updateMarketActiveStatusAndInsertNew(
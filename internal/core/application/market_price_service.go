@@ -8,9 +8,7 @@ import (
 
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
 	"github.com/tdex-network/tdex-analytics/internal/core/port"
-	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
 
-	"github.com/robfig/cron/v3"
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 )
@@ -23,6 +21,8 @@ type MarketPriceService interface {
 	) error
 	// GetPrices returns all markets prices from time in past equal to passed arg fromTime
 	//if marketID is passed method will return data for all market's, otherwise only for provided one
+	//average prices are weighted by market base balance, not by inferred
+	//trade volume as the vwap returned by MarketVolumeService
 	GetPrices(
 		ctx context.Context,
 		timeRange TimeRange,
@@ -31,33 +31,37 @@ type MarketPriceService interface {
 		timeFrame TimeFrame,
 		marketIDs ...string,
 	) (*MarketsPrices, error)
-	// StartFetchingPricesJob starts cron job that will periodically fetch and store prices for all markets
-	StartFetchingPricesJob() error
+	// GetCandles returns open, high, low and close quote prices of the markets
+	//for every timeFrame bucket in the given time range, if marketIDs are not
+	//passed candles are returned for all markets
+	GetCandles(
+		ctx context.Context,
+		timeRange TimeRange,
+		page Page,
+		referenceCurrency string,
+		timeFrame TimeFrame,
+		marketIDs ...string,
+	) (*MarketsCandles, error)
 }
 
 type marketPriceService struct {
-	marketPriceRepository    domain.MarketPriceRepository
-	marketRepository         domain.MarketRepository
-	tdexMarketLoaderSvc      tdexmarketloader.Service
-	cronSvc                  *cron.Cron
-	fetchPriceCronExpression string
-	raterSvc                 port.RateService
+	marketPriceRepository domain.MarketPriceRepository
+	marketRepository      domain.MarketRepository
+	raterSvc              port.RateService
+	rateRepository        domain.RateRepository
 }
 
 func NewMarketPriceService(
 	marketPriceRepository domain.MarketPriceRepository,
 	marketRepository domain.MarketRepository,
-	tdexMarketLoaderSvc tdexmarketloader.Service,
-	jobPeriodInMinutes string,
 	raterSvc port.RateService,
+	rateRepository domain.RateRepository,
 ) MarketPriceService {
 	return &marketPriceService{
-		marketPriceRepository:    marketPriceRepository,
-		cronSvc:                  cron.New(),
-		marketRepository:         marketRepository,
-		tdexMarketLoaderSvc:      tdexMarketLoaderSvc,
-		fetchPriceCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
-		raterSvc:                 raterSvc,
+		marketPriceRepository: marketPriceRepository,
+		marketRepository:      marketRepository,
+		raterSvc:              raterSvc,
+		rateRepository:        rateRepository,
 	}
 }
 
@@ -85,14 +89,8 @@ func (m *marketPriceService) GetPrices(
 	timeFrame TimeFrame,
 	marketIDs ...string,
 ) (*MarketsPrices, error) {
-	if referenceCurrency != "" {
-		supportedFiat, err := m.raterSvc.IsFiatSymbolSupported(referenceCurrency)
-		if err != nil {
-			return nil, err
-		}
-		if !supportedFiat {
-			return nil, fmt.Errorf("reference currency %s is not supported", referenceCurrency)
-		}
+	if err := validateReferenceCurrency(m.raterSvc, referenceCurrency); err != nil {
+		return nil, err
 	}
 
 	result := make(map[string][]Price)
@@ -119,6 +117,8 @@ func (m *marketPriceService) GetPrices(
 		return nil, err
 	}
 
+	rates := newRateHistory(m.rateRepository, m.raterSvc, startTime, endTime)
+
 	averagePricesInfos := make([]AveragePriceInfo, 0)
 	if len(marketIDs) > 0 {
 		averageWindow := getAverageWindow(startTime, endTime)
@@ -139,7 +139,7 @@ func (m *marketPriceService) GetPrices(
 					marketsMap[mktId].QuoteAsset,
 				)
 				if err == nil {
-					unitOfQuotePriceInRefCurrency, err := m.raterSvc.ConvertCurrency(
+					unitOfQuotePriceInRefCurrency, err := rates.averageRate(
 						ctx,
 						quoteAssetTicker,
 						referenceCurrency,
@@ -187,6 +187,7 @@ func (m *marketPriceService) GetPrices(
 			if referenceCurrency != "" {
 				b, q, err := m.getPricesInReferenceCurrency(
 					ctx,
+					rates,
 					v1,
 					referenceCurrency,
 				)
@@ -218,6 +219,171 @@ func (m *marketPriceService) GetPrices(
 	}, nil
 }
 
+func (m *marketPriceService) GetCandles(
+	ctx context.Context,
+	timeRange TimeRange,
+	page Page,
+	referenceCurrency string,
+	timeFrame TimeFrame,
+	marketIDs ...string,
+) (*MarketsCandles, error) {
+	if err := validateReferenceCurrency(m.raterSvc, referenceCurrency); err != nil {
+		return nil, err
+	}
+
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsMap, _, err := groupMarkets(markets, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := newRateHistory(m.rateRepository, m.raterSvc, startTime, endTime)
+
+	marketsCandles, err := m.marketPriceRepository.GetCandlesForMarkets(
+		ctx,
+		startTime,
+		endTime,
+		page.ToDomain(),
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]Candle)
+	for k, v := range marketsCandles {
+		marketIdInt, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, err
+		}
+		baseAsset := marketsMap[marketIdInt].BaseAsset
+		quoteAsset := marketsMap[marketIdInt].QuoteAsset
+
+		candles := make([]Candle, 0, len(v))
+		for _, v1 := range v {
+			candle := Candle{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+				Open:       v1.Open,
+				High:       v1.High,
+				Low:        v1.Low,
+				Close:      v1.Close,
+				Time:       v1.Time,
+			}
+
+			if referenceCurrency != "" {
+				factor := m.getCandleReferentFactor(
+					ctx,
+					rates,
+					v1,
+					baseAsset,
+					quoteAsset,
+					referenceCurrency,
+				)
+				candle.ReferentOpen = v1.Open.Mul(factor).Round(2)
+				candle.ReferentHigh = v1.High.Mul(factor).Round(2)
+				candle.ReferentLow = v1.Low.Mul(factor).Round(2)
+				candle.ReferentClose = v1.Close.Mul(factor).Round(2)
+			}
+
+			candles = append(candles, candle)
+		}
+
+		result[k] = candles
+	}
+
+	return &MarketsCandles{
+		MarketsCandles: result,
+	}, nil
+}
+
+func validateReferenceCurrency(
+	raterSvc port.RateService,
+	referenceCurrency string,
+) error {
+	if referenceCurrency == "" {
+		return nil
+	}
+
+	supportedFiat, err := raterSvc.IsFiatSymbolSupported(referenceCurrency)
+	if err != nil {
+		return err
+	}
+	if !supportedFiat {
+		return fmt.Errorf("reference currency %s is not supported", referenceCurrency)
+	}
+
+	return nil
+}
+
+// getCandleReferentFactor returns the value to multiply candle quote prices
+// with in order to express them in reference currency at candle time, if the
+// quote asset currency is unknown the factor is derived from the base asset
+// currency and the candle close price, zero is returned if none of them is known
+func (m *marketPriceService) getCandleReferentFactor(
+	ctx context.Context,
+	rates *rateHistory,
+	candle domain.MarketCandle,
+	baseAsset, quoteAsset, referenceCurrency string,
+) decimal.Decimal {
+	quoteAssetTicker, err := m.raterSvc.GetAssetCurrency(quoteAsset)
+	if err == nil {
+		unitOfQuoteInRefCurrency, err := rates.convertCurrencyAt(
+			ctx,
+			quoteAssetTicker,
+			referenceCurrency,
+			candle.Time,
+		)
+		if err == nil && !unitOfQuoteInRefCurrency.IsZero() {
+			return unitOfQuoteInRefCurrency
+		}
+	}
+
+	if candle.Close.IsZero() {
+		return decimal.Zero
+	}
+
+	baseAssetTicker, err := m.raterSvc.GetAssetCurrency(baseAsset)
+	if err != nil {
+		log.Debugf("GetCandles -> getCandleReferentFactor: %v", err)
+		return decimal.Zero
+	}
+	unitOfBaseInRefCurrency, err := rates.convertCurrencyAt(
+		ctx,
+		baseAssetTicker,
+		referenceCurrency,
+		candle.Time,
+	)
+	if err != nil {
+		log.Debugf("GetCandles -> getCandleReferentFactor: %v", err)
+		return decimal.Zero
+	}
+
+	return unitOfBaseInRefCurrency.Div(candle.Close)
+}
+
 func getAverageWindow(startTime, endTime time.Time) string {
 	rangeDuration := endTime.Sub(startTime)
 
@@ -264,8 +430,11 @@ func groupMarkets(
 	return marketsMap, marketsWithSameAssetPair, nil
 }
 
+// getPricesInReferenceCurrency converts market prices to reference currency
+// using the rates closest to the price time
 func (m *marketPriceService) getPricesInReferenceCurrency(
 	ctx context.Context,
+	rates *rateHistory,
 	mktPrice domain.MarketPrice,
 	referenceCurrency string,
 ) (decimal.Decimal, decimal.Decimal, error) {
@@ -282,17 +451,19 @@ func (m *marketPriceService) getPricesInReferenceCurrency(
 	var basePriceInRefCurrency, quotePriceInRefCurrency decimal.Decimal
 
 	if baseAssetTickerFound {
-		quotePriceInRefCurrency, _ = m.raterSvc.ConvertCurrency(
+		quotePriceInRefCurrency, _ = rates.convertCurrencyAt(
 			ctx,
 			baseAssetTicker,
 			referenceCurrency,
+			mktPrice.Time,
 		)
 	}
 	if quoteAssetTickerFound {
-		basePriceInRefCurrency, _ = m.raterSvc.ConvertCurrency(
+		basePriceInRefCurrency, _ = rates.convertCurrencyAt(
 			ctx,
 			quoteAssetTicker,
 			referenceCurrency,
+			mktPrice.Time,
 		)
 	}
 
@@ -314,66 +485,6 @@ func (m *marketPriceService) getPricesInReferenceCurrency(
 	return basePriceInRefCurrency, quotePriceInRefCurrency, nil
 }
 
-func (m *marketPriceService) StartFetchingPricesJob() error {
-	if _, err := m.cronSvc.AddJob(
-		m.fetchPriceCronExpression,
-		cron.FuncJob(m.FetchPricesForAllMarkets),
-	); err != nil {
-		return err
-	}
-
-	m.cronSvc.Start()
-
-	return nil
-}
-
-func (m *marketPriceService) FetchPricesForAllMarkets() {
-	log.Infof("job FetchPricesForAllMarkets at: %v", time.Now())
-	ctx := context.Background()
-
-	markets, err := m.marketRepository.GetMarketsForActiveIndicator(ctx, true)
-	if err != nil {
-		log.Errorf("FetchPricesForAllMarkets -> GetAllMarkets: %v", err)
-		return
-	}
-
-	for _, v := range markets {
-		go func(market domain.Market) {
-			m.FetchAndInsertPrice(ctx, market)
-		}(v)
-	}
-}
-
-func (m *marketPriceService) FetchAndInsertPrice(
-	ctx context.Context,
-	market domain.Market,
-) {
-	price, err := m.tdexMarketLoaderSvc.FetchPrice(
-		ctx,
-		tdexmarketloader.Market{
-			Url:        market.Url,
-			QuoteAsset: market.QuoteAsset,
-			BaseAsset:  market.BaseAsset,
-		},
-	)
-	if err != nil {
-		log.Errorf("FetchAndInsertPrice for %s -> FetchPrice: %v", market.Url, err)
-		return
-	}
-
-	if err := m.InsertPrice(ctx, MarketPrice{
-		MarketID:   strconv.Itoa(market.ID),
-		BasePrice:  price.BasePrice,
-		BaseAsset:  market.BaseAsset,
-		QuotePrice: price.QuotePrice,
-		QuoteAsset: market.QuoteAsset,
-		Time:       time.Now(),
-	}); err != nil {
-		log.Errorf("FetchAndInsertPrice for %s -> InsertPrice: %v", market.Url, err)
-		return
-	}
-}
-
 type referenceCurrencyPrice struct {
 	basePrice  decimal.Decimal
 	quotePrice decimal.Decimal
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
@@ -93,6 +94,7 @@ func TestMarketPriceService(t *testing.T) {
 					m := &marketPriceService{raterSvc: tt.raterSvc}
 					basePriceInRefCurrency, quotePriceInRefCurrency, err := m.getPricesInReferenceCurrency(
 						context.Background(),
+						newRateHistory(nil, tt.raterSvc, time.Time{}, time.Now()),
 						tt.args.price,
 						tt.args.referenceCurrency,
 					)
@@ -117,6 +119,7 @@ func TestMarketPriceService(t *testing.T) {
 					m := &marketPriceService{raterSvc: tt.raterSvc}
 					basePriceInRefCurrency, quotePriceInRefCurrency, err := m.getPricesInReferenceCurrency(
 						context.Background(),
+						newRateHistory(nil, tt.raterSvc, time.Time{}, time.Now()),
 						tt.args.price,
 						tt.args.referenceCurrency,
 					)
@@ -225,3 +228,48 @@ func TestGroupMarkets(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCandleReferentFactor(t *testing.T) {
+	candle := domain.MarketCandle{
+		Open:  decimal.NewFromFloat(40000),
+		High:  decimal.NewFromFloat(41000),
+		Low:   decimal.NewFromFloat(39000),
+		Close: decimal.NewFromFloat(40381.20),
+	}
+
+	testCases := []struct {
+		name           string
+		raterSvc       port.RateService
+		expectedFactor decimal.Decimal
+	}{
+		{
+			name:           "quote asset currency known",
+			raterSvc:       mockRater("LBTC", "USDT", "LBTC", "USDT", decimal.NewFromFloat(37384.11), decimal.NewFromFloat(0.93), false, true, nil),
+			expectedFactor: decimal.NewFromFloat(0.93),
+		},
+		{
+			name:           "only base asset currency known",
+			raterSvc:       mockRater("LBTC", "USDT", "LBTC", "USDT", decimal.NewFromFloat(37384.11), decimal.Zero, false, true, nil),
+			expectedFactor: decimal.NewFromFloat(0.9258),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := &marketPriceService{raterSvc: testCase.raterSvc}
+			factor := m.getCandleReferentFactor(
+				context.Background(),
+				newRateHistory(nil, testCase.raterSvc, time.Time{}, time.Now()),
+				candle,
+				"LBTC",
+				"USDT",
+				"EUR",
+			)
+			require.True(
+				t,
+				testCase.expectedFactor.Equal(factor.Round(4)),
+				"expected factor %s, got %s", testCase.expectedFactor, factor,
+			)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+type MarketSnapshotService interface {
+	// StartFetchingSnapshotsJob starts cron job that will periodically fetch
+	//price and balance of all markets in one pass, and store both with the
+	//same time and snapshot id
+	StartFetchingSnapshotsJob() error
+	// StopFetchingSnapshotsJob stops the cron job, it can be started again
+	StopFetchingSnapshotsJob()
+}
+
+type marketSnapshotService struct {
+	marketSnapshotRepository    domain.MarketSnapshotRepository
+	marketRepository            domain.MarketRepository
+	tdexMarketLoaderSvc         tdexmarketloader.Service
+	cronSvc                     *cron.Cron
+	fetchSnapshotCronExpression string
+	marketUpdatesSvc            MarketUpdatesService
+	alertSvc                    AlertService
+	marketStatusSvc             MarketStatusService
+	marketsJobRunner            MarketsJobRunner
+}
+
+func NewMarketSnapshotService(
+	marketSnapshotRepository domain.MarketSnapshotRepository,
+	marketRepository domain.MarketRepository,
+	tdexMarketLoaderSvc tdexmarketloader.Service,
+	jobPeriodInMinutes string,
+	marketUpdatesSvc MarketUpdatesService,
+	alertSvc AlertService,
+	marketStatusSvc MarketStatusService,
+	marketsJobRunner MarketsJobRunner,
+) MarketSnapshotService {
+	return &marketSnapshotService{
+		marketSnapshotRepository:    marketSnapshotRepository,
+		marketRepository:            marketRepository,
+		tdexMarketLoaderSvc:         tdexMarketLoaderSvc,
+		cronSvc:                     cron.New(),
+		fetchSnapshotCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
+		marketUpdatesSvc:            marketUpdatesSvc,
+		alertSvc:                    alertSvc,
+		marketStatusSvc:             marketStatusSvc,
+		marketsJobRunner:            marketsJobRunner,
+	}
+}
+
+func (m *marketSnapshotService) StartFetchingSnapshotsJob() error {
+	if _, err := m.cronSvc.AddJob(
+		m.fetchSnapshotCronExpression,
+		cron.FuncJob(m.FetchSnapshotsForAllMarkets),
+	); err != nil {
+		return err
+	}
+
+	m.cronSvc.Start()
+
+	return nil
+}
+
+func (m *marketSnapshotService) StopFetchingSnapshotsJob() {
+	stopCronJobs(m.cronSvc)
+}
+
+func (m *marketSnapshotService) FetchSnapshotsForAllMarkets() {
+	m.marketsJobRunner.Run(
+		domain.FetchJobSnapshot,
+		func(ctx context.Context) ([]domain.Market, error) {
+			return m.marketRepository.GetMarketsForActiveIndicator(ctx, true)
+		},
+		m.FetchAndInsertSnapshot,
+	)
+}
+
+func (m *marketSnapshotService) FetchAndInsertSnapshot(
+	ctx context.Context,
+	market domain.Market,
+) {
+	snapshot, err := m.tdexMarketLoaderSvc.FetchSnapshot(
+		ctx,
+		tdexmarketloader.Market{
+			Url:        market.Url,
+			QuoteAsset: market.QuoteAsset,
+			BaseAsset:  market.BaseAsset,
+		},
+	)
+	if errors.Is(err, tdexmarketloader.ErrProviderUnavailable) {
+		log.Debugf("FetchAndInsertSnapshot for %s -> FetchSnapshot: %v", market.Url, err)
+		return
+	}
+	if err != nil {
+		m.recordFailure(ctx, market, err)
+		log.Errorf("FetchAndInsertSnapshot for %s -> FetchSnapshot: %v", market.Url, err)
+		return
+	}
+
+	marketID := strconv.Itoa(market.ID)
+	marketPrice := MarketPrice{
+		MarketID:   marketID,
+		BasePrice:  snapshot.Price.BasePrice,
+		BaseAsset:  market.BaseAsset,
+		QuotePrice: snapshot.Price.QuotePrice,
+		QuoteAsset: market.QuoteAsset,
+	}
+	marketBalance := MarketBalance{
+		MarketID:     marketID,
+		BaseBalance:  snapshot.Balance.BaseBalance,
+		BaseAsset:    market.BaseAsset,
+		QuoteBalance: snapshot.Balance.QuoteBalance,
+		QuoteAsset:   market.QuoteAsset,
+	}
+	if err := m.insertSnapshot(ctx, &marketPrice, &marketBalance); err != nil {
+		m.recordFailure(ctx, market, err)
+		log.Errorf("FetchAndInsertSnapshot for %s -> InsertSnapshot: %v", market.Url, err)
+		return
+	}
+
+	m.onSnapshotInserted(ctx, market, *snapshot, marketPrice, marketBalance)
+}
+
+// onSnapshotInserted runs the hooks following the insertion of a snapshot,
+// it publishes price and balance to subscribers, evaluates alert rules on
+// them and records the success of the market fetch
+func (m *marketSnapshotService) onSnapshotInserted(
+	ctx context.Context,
+	market domain.Market,
+	snapshot tdexmarketloader.Snapshot,
+	marketPrice MarketPrice,
+	marketBalance MarketBalance,
+) {
+	m.marketUpdatesSvc.PublishPrice(marketPrice)
+	m.marketUpdatesSvc.PublishBalance(marketBalance)
+	m.alertSvc.EvaluatePrice(ctx, marketPrice)
+	m.alertSvc.EvaluateBalance(ctx, marketBalance)
+	m.marketStatusSvc.RecordSuccess(
+		ctx, market.ID, domain.FetchJobPrice, snapshot.Price.Protocol,
+	)
+	m.marketStatusSvc.RecordSuccess(
+		ctx, market.ID, domain.FetchJobBalance, snapshot.Balance.Protocol,
+	)
+}
+
+// insertSnapshot stores price and balance with the same time and snapshot
+// id, which are set to both of them
+func (m *marketSnapshotService) insertSnapshot(
+	ctx context.Context,
+	marketPrice *MarketPrice,
+	marketBalance *MarketBalance,
+) error {
+	price, err := marketPrice.toDomain()
+	if err != nil {
+		return err
+	}
+	balance, err := marketBalance.toDomain()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := domain.NewMarketSnapshot(*price, *balance, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := m.marketSnapshotRepository.InsertSnapshot(ctx, *snapshot); err != nil {
+		return err
+	}
+
+	marketPrice.Time, marketPrice.SnapshotID = snapshot.Price.Time, snapshot.ID
+	marketBalance.Time, marketBalance.SnapshotID = snapshot.Balance.Time, snapshot.ID
+
+	return nil
+}
+
+func (m *marketSnapshotService) recordFailure(
+	ctx context.Context,
+	market domain.Market,
+	err error,
+) {
+	m.marketStatusSvc.RecordFailure(ctx, market.ID, domain.FetchJobPrice, err)
+	m.marketStatusSvc.RecordFailure(ctx, market.ID, domain.FetchJobBalance, err)
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+type snapshotRepositoryStub struct {
+	snapshots []domain.MarketSnapshot
+}
+
+func (s *snapshotRepositoryStub) InsertSnapshot(
+	ctx context.Context,
+	snapshot domain.MarketSnapshot,
+) error {
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+type snapshotLoaderStub struct {
+	tdexmarketloader.Service
+	snapshot *tdexmarketloader.Snapshot
+	err      error
+}
+
+func (s *snapshotLoaderStub) FetchSnapshot(
+	ctx context.Context,
+	market tdexmarketloader.Market,
+) (*tdexmarketloader.Snapshot, error) {
+	return s.snapshot, s.err
+}
+
+type alertServiceStub struct {
+	AlertService
+	prices   []MarketPrice
+	balances []MarketBalance
+}
+
+func (a *alertServiceStub) EvaluatePrice(ctx context.Context, price MarketPrice) {
+	a.prices = append(a.prices, price)
+}
+
+func (a *alertServiceStub) EvaluateBalance(
+	ctx context.Context,
+	balance MarketBalance,
+) {
+	a.balances = append(a.balances, balance)
+}
+
+func TestMarketSnapshotService(t *testing.T) {
+	ctx := context.Background()
+	market := domain.Market{
+		ID:         1,
+		Url:        "provider1",
+		BaseAsset:  "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225",
+		QuoteAsset: "ce091c998b83c78bb71a632313ba3760f1763d9cfcffae02258ffa9865a37bd2",
+	}
+	repository := &snapshotRepositoryStub{}
+	loader := &snapshotLoaderStub{
+		snapshot: &tdexmarketloader.Snapshot{
+			Price: tdexmarketloader.Price{
+				BasePrice:  decimal.RequireFromString("0.00005"),
+				QuotePrice: decimal.NewFromInt(20000),
+				Protocol:   tdexmarketloader.ProtocolV2,
+			},
+			Balance: tdexmarketloader.Balance{
+				BaseBalance:  decimal.NewFromInt(1),
+				QuoteBalance: decimal.NewFromInt(20000),
+				Protocol:     tdexmarketloader.ProtocolV2,
+			},
+		},
+	}
+	alertSvc := &alertServiceStub{}
+	marketStatusSvc := NewMarketStatusService(
+		&marketRepositoryStub{markets: []domain.Market{market}},
+		&fetchStatusRepositoryStub{},
+		NewMarketsShardService(
+			&workerRepositoryStub{workers: make(map[string]domain.Worker)},
+			&tdexMarketLoaderStub{},
+			"node1",
+			time.Minute,
+		),
+	)
+	svc := &marketSnapshotService{
+		marketSnapshotRepository: repository,
+		tdexMarketLoaderSvc:      loader,
+		marketUpdatesSvc:         NewMarketUpdatesService(nil),
+		alertSvc:                 alertSvc,
+		marketStatusSvc:          marketStatusSvc,
+	}
+
+	svc.FetchAndInsertSnapshot(ctx, market)
+
+	// price and balance are stored with the same time and snapshot id
+	require.Len(t, repository.snapshots, 1)
+	snapshot := repository.snapshots[0]
+	require.NotEmpty(t, snapshot.ID)
+	require.Equal(t, snapshot.ID, snapshot.Price.SnapshotID)
+	require.Equal(t, snapshot.ID, snapshot.Balance.SnapshotID)
+	require.Equal(t, snapshot.Price.Time, snapshot.Balance.Time)
+	require.Equal(t, "1", snapshot.Price.MarketID)
+	require.True(t, decimal.NewFromInt(20000).Equal(snapshot.Price.QuotePrice))
+
+	require.Len(t, alertSvc.prices, 1)
+	require.Len(t, alertSvc.balances, 1)
+	require.Equal(t, snapshot.ID, alertSvc.prices[0].SnapshotID)
+	require.Equal(t, alertSvc.prices[0].Time, alertSvc.balances[0].Time)
+
+	status, err := marketStatusSvc.GetMarketsStatus(ctx)
+	require.NoError(t, err)
+	fetchStatuses := status.MarketsStatus["1"].FetchStatuses
+	require.Len(t, fetchStatuses, 2)
+	for _, v := range fetchStatuses {
+		require.Zero(t, v.ConsecutiveFailures)
+		require.Equal(t, tdexmarketloader.ProtocolV2, v.Protocol)
+	}
+
+	// failures are recorded for both price and balance
+	loader.snapshot, loader.err = nil, errors.New("timeout")
+	svc.FetchAndInsertSnapshot(ctx, market)
+	require.Len(t, repository.snapshots, 1)
+
+	status, err = marketStatusSvc.GetMarketsStatus(ctx)
+	require.NoError(t, err)
+	fetchStatuses = status.MarketsStatus["1"].FetchStatuses
+	require.Len(t, fetchStatuses, 2)
+	for _, v := range fetchStatuses {
+		require.Equal(t, 1, v.ConsecutiveFailures)
+		require.Equal(t, "timeout", v.LastError)
+	}
+
+	// unavailable providers are not recorded as failing
+	loader.err = tdexmarketloader.ErrProviderUnavailable
+	svc.FetchAndInsertSnapshot(ctx, market)
+	status, err = marketStatusSvc.GetMarketsStatus(ctx)
+	require.NoError(t, err)
+	for _, v := range status.MarketsStatus["1"].FetchStatuses {
+		require.Equal(t, 1, v.ConsecutiveFailures)
+	}
+}
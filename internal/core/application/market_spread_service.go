@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+type MarketSpreadService interface {
+	// GetSpreads returns, for every asset pair traded by more than one market,
+	//the best bid/ask across providers for every timeFrame bucket in time range
+	//if feeBps is nil the fee recorded for every market, or the default one if
+	//none has been recorded, is used to derive bid and ask from market price,
+	//if marketIDs are not passed all markets are considered
+	GetSpreads(
+		ctx context.Context,
+		timeRange TimeRange,
+		timeFrame TimeFrame,
+		feeBps *int,
+		marketIDs ...string,
+	) ([]PairSpreads, error)
+}
+
+type marketSpreadService struct {
+	marketPriceRepository domain.MarketPriceRepository
+	marketFeeRepository   domain.MarketFeeRepository
+	marketRepository      domain.MarketRepository
+	defaultFeeBps         int
+}
+
+func NewMarketSpreadService(
+	marketPriceRepository domain.MarketPriceRepository,
+	marketFeeRepository domain.MarketFeeRepository,
+	marketRepository domain.MarketRepository,
+	defaultFeeBps int,
+) MarketSpreadService {
+	return &marketSpreadService{
+		marketPriceRepository: marketPriceRepository,
+		marketFeeRepository:   marketFeeRepository,
+		marketRepository:      marketRepository,
+		defaultFeeBps:         defaultFeeBps,
+	}
+}
+
+func (m *marketSpreadService) GetSpreads(
+	ctx context.Context,
+	timeRange TimeRange,
+	timeFrame TimeFrame,
+	feeBps *int,
+	marketIDs ...string,
+) ([]PairSpreads, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	if feeBps != nil && *feeBps < 0 {
+		return nil, ErrInvalidFee
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(marketIDs) == 0 {
+		for _, v := range markets {
+			marketIDs = append(marketIDs, strconv.Itoa(v.ID))
+		}
+	}
+
+	marketsMap, marketsWithSameAssetPair, err := groupMarkets(markets, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]string, 0, len(marketsWithSameAssetPair))
+	for k, v := range marketsWithSameAssetPair {
+		if len(v) > 1 {
+			pairs = append(pairs, k)
+		}
+	}
+	sort.Strings(pairs)
+
+	// one page must contain all the buckets of the time range so that every
+	//market price of a bucket is compared with the others
+	numOfBuckets := int(endTime.Sub(startTime).Minutes())/timeFrame.toMinutes() + 2
+	page := domain.NewPage(1, numOfBuckets)
+
+	result := make([]PairSpreads, 0, len(pairs))
+	for _, pair := range pairs {
+		ids := marketsWithSameAssetPair[pair]
+
+		marketsPrices, err := m.marketPriceRepository.GetPricesForMarkets(
+			ctx,
+			startTime,
+			endTime,
+			page,
+			timeFrame.toFluxDuration(),
+			ids...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var feeAt func(string, time.Time) decimal.Decimal
+		if feeBps != nil {
+			fee := decimal.New(int64(*feeBps), -4)
+			feeAt = func(string, time.Time) decimal.Decimal {
+				return fee
+			}
+		} else {
+			marketsFees, err := m.marketFeeRepository.GetFeesForMarkets(
+				ctx,
+				startTime,
+				endTime,
+				page,
+				timeFrame.toFluxDuration(),
+				ids...,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			defaultFee := decimal.New(int64(m.defaultFeeBps), -4)
+			feeAt = func(marketID string, tm time.Time) decimal.Decimal {
+				if fee, ok := lastFeeAt(marketsFees[marketID], tm); ok {
+					return fee
+				}
+				return defaultFee
+			}
+		}
+
+		mktId, err := strconv.Atoi(ids[0])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, PairSpreads{
+			BaseAsset:  marketsMap[mktId].BaseAsset,
+			QuoteAsset: marketsMap[mktId].QuoteAsset,
+			MarketIDs:  ids,
+			Spreads:    calculateSpreads(marketsPrices, feeAt),
+		})
+	}
+
+	return result, nil
+}
+
+// lastFeeAt returns, as a fraction, the mean of base and quote percentage fees
+// of the last fee recorded at or before the given time, the first recorded
+// one is returned if all of them are later, fees must be sorted by time ASC
+func lastFeeAt(fees []domain.MarketFee, at time.Time) (decimal.Decimal, bool) {
+	if len(fees) == 0 {
+		return decimal.Zero, false
+	}
+
+	fee := fees[0]
+	for _, v := range fees {
+		if v.Time.After(at) {
+			break
+		}
+		fee = v
+	}
+
+	return fee.BasePercentageFee.Add(fee.QuotePercentageFee).
+		Div(decimal.NewFromInt(2)).Shift(-4), true
+}
+
+// calculateSpreads joins prices of markets with the same asset pair by time
+// and returns the best bid/ask for every point in time where at least two
+// markets have a price, feeAt returns the fee of a market at a given time
+func calculateSpreads(
+	marketsPrices map[string][]domain.MarketPrice,
+	feeAt func(marketID string, tm time.Time) decimal.Decimal,
+) []Spread {
+	pricesPerTime := make(map[time.Time]map[string]decimal.Decimal)
+	for k, v := range marketsPrices {
+		for _, v1 := range v {
+			if v1.QuotePrice.IsZero() {
+				continue
+			}
+			if _, ok := pricesPerTime[v1.Time]; !ok {
+				pricesPerTime[v1.Time] = make(map[string]decimal.Decimal)
+			}
+			pricesPerTime[v1.Time][k] = v1.QuotePrice
+		}
+	}
+
+	times := make([]time.Time, 0, len(pricesPerTime))
+	for k, v := range pricesPerTime {
+		if len(v) > 1 {
+			times = append(times, k)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+
+	spreads := make([]Spread, 0, len(times))
+	for _, v := range times {
+		fees := make(map[string]decimal.Decimal)
+		for k := range pricesPerTime[v] {
+			fees[k] = feeAt(k, v)
+		}
+
+		spread := calculateSpread(pricesPerTime[v], fees)
+		spread.Time = v
+		spreads = append(spreads, spread)
+	}
+
+	return spreads
+}
+
+// calculateSpread derives bid and ask of every market by applying its fee to
+// the market price and returns the best ones, the arbitrage flag is set when
+// the best bid is higher than the best ask, meaning that the price difference
+// between two providers is larger than their fees
+func calculateSpread(
+	prices map[string]decimal.Decimal,
+	fees map[string]decimal.Decimal,
+) Spread {
+	marketIDs := make([]string, 0, len(prices))
+	for k := range prices {
+		marketIDs = append(marketIDs, k)
+	}
+	sort.Strings(marketIDs)
+
+	var spread Spread
+	for _, v := range marketIDs {
+		price := prices[v]
+		fee := fees[v]
+		bid := price.Mul(decimal.NewFromInt(1).Sub(fee))
+		ask := price.Mul(decimal.NewFromInt(1).Add(fee))
+
+		if spread.BestBidMarketID == "" || bid.GreaterThan(spread.BestBid) {
+			spread.BestBid = bid
+			spread.BestBidMarketID = v
+		}
+		if spread.BestAskMarketID == "" || ask.LessThan(spread.BestAsk) {
+			spread.BestAsk = ask
+			spread.BestAskMarketID = v
+		}
+	}
+
+	spread.Spread = spread.BestAsk.Sub(spread.BestBid)
+	mid := spread.BestAsk.Add(spread.BestBid).Div(decimal.NewFromInt(2))
+	if !mid.IsZero() {
+		spread.SpreadBps = spread.Spread.Div(mid).Shift(4).Round(2)
+	}
+	spread.Arbitrage = spread.BestBid.GreaterThan(spread.BestAsk)
+
+	return spread
+}
@@ -0,0 +1,213 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestCalculateSpread(t *testing.T) {
+	fees := map[string]decimal.Decimal{
+		"1": decimal.NewFromFloat(0.0025),
+		"2": decimal.NewFromFloat(0.0025),
+		"3": decimal.NewFromFloat(0.0025),
+	}
+
+	testCases := []struct {
+		name                    string
+		prices                  map[string]decimal.Decimal
+		expectedBestBid         decimal.Decimal
+		expectedBestBidMarketID string
+		expectedBestAsk         decimal.Decimal
+		expectedBestAskMarketID string
+		expectedArbitrage       bool
+	}{
+		{
+			name: "prices within fees",
+			prices: map[string]decimal.Decimal{
+				"1": decimal.NewFromInt(30000),
+				"2": decimal.NewFromInt(30100),
+			},
+			expectedBestBid:         decimal.NewFromFloat(30024.75),
+			expectedBestBidMarketID: "2",
+			expectedBestAsk:         decimal.NewFromInt(30075),
+			expectedBestAskMarketID: "1",
+			expectedArbitrage:       false,
+		},
+		{
+			name: "price difference larger than fees",
+			prices: map[string]decimal.Decimal{
+				"1": decimal.NewFromInt(30000),
+				"2": decimal.NewFromInt(31000),
+				"3": decimal.NewFromInt(30500),
+			},
+			expectedBestBid:         decimal.NewFromFloat(30922.5),
+			expectedBestBidMarketID: "2",
+			expectedBestAsk:         decimal.NewFromInt(30075),
+			expectedBestAskMarketID: "1",
+			expectedArbitrage:       true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			spread := calculateSpread(tt.prices, fees)
+			require.True(t, tt.expectedBestBid.Equal(spread.BestBid), spread.BestBid.String())
+			require.Equal(t, tt.expectedBestBidMarketID, spread.BestBidMarketID)
+			require.True(t, tt.expectedBestAsk.Equal(spread.BestAsk), spread.BestAsk.String())
+			require.Equal(t, tt.expectedBestAskMarketID, spread.BestAskMarketID)
+			require.True(t, spread.BestAsk.Sub(spread.BestBid).Equal(spread.Spread))
+			require.Equal(t, tt.expectedArbitrage, spread.Arbitrage)
+		})
+	}
+}
+
+func TestCalculateSpreads(t *testing.T) {
+	now := time.Now()
+	zeroFee := func(string, time.Time) decimal.Decimal {
+		return decimal.Zero
+	}
+	marketsPrices := map[string][]domain.MarketPrice{
+		"1": {
+			{QuotePrice: decimal.NewFromInt(100), Time: now},
+			{QuotePrice: decimal.NewFromInt(101), Time: now.Add(time.Hour)},
+		},
+		"2": {
+			{QuotePrice: decimal.NewFromInt(102), Time: now.Add(time.Hour)},
+			{QuotePrice: decimal.NewFromInt(103), Time: now.Add(2 * time.Hour)},
+		},
+	}
+
+	spreads := calculateSpreads(marketsPrices, zeroFee)
+	require.Len(t, spreads, 1)
+	require.Equal(t, now.Add(time.Hour), spreads[0].Time)
+	require.True(t, decimal.NewFromInt(102).Equal(spreads[0].BestBid))
+	require.True(t, decimal.NewFromInt(101).Equal(spreads[0].BestAsk))
+	require.True(t, spreads[0].Arbitrage)
+}
+
+type spreadPriceRepositoryStub struct {
+	domain.MarketPriceRepository
+	prices map[string][]domain.MarketPrice
+}
+
+func (s *spreadPriceRepositoryStub) GetPricesForMarkets(
+	_ context.Context,
+	_, _ time.Time,
+	_ domain.Page,
+	_ string,
+	marketIDs ...string,
+) (map[string][]domain.MarketPrice, error) {
+	return s.prices, nil
+}
+
+type spreadFeeRepositoryStub struct {
+	domain.MarketFeeRepository
+	fees map[string][]domain.MarketFee
+}
+
+func (s *spreadFeeRepositoryStub) GetFeesForMarkets(
+	_ context.Context,
+	_, _ time.Time,
+	_ domain.Page,
+	_ string,
+	marketIDs ...string,
+) (map[string][]domain.MarketFee, error) {
+	return s.fees, nil
+}
+
+func TestGetSpreadsFee(t *testing.T) {
+	now := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	svc := NewMarketSpreadService(
+		&spreadPriceRepositoryStub{prices: map[string][]domain.MarketPrice{
+			"1": {{QuotePrice: decimal.NewFromInt(30000), Time: now}},
+			"2": {{QuotePrice: decimal.NewFromInt(30100), Time: now}},
+		}},
+		&spreadFeeRepositoryStub{fees: map[string][]domain.MarketFee{
+			"1": {{BasePercentageFee: decimal.NewFromInt(100), QuotePercentageFee: decimal.NewFromInt(100), Time: now}},
+			"2": {{BasePercentageFee: decimal.NewFromInt(100), QuotePercentageFee: decimal.NewFromInt(100), Time: now}},
+		}},
+		&marketRepositoryStub{markets: []domain.Market{
+			{ID: 1, BaseAsset: "LBTC", QuoteAsset: "USDT"},
+			{ID: 2, BaseAsset: "LBTC", QuoteAsset: "USDT"},
+		}},
+		25,
+	)
+	predefinedPeriod := LastDay
+	timeRange := TimeRange{PredefinedPeriod: &predefinedPeriod}
+	zeroFeeBps, invalidFeeBps := 0, -1
+
+	testCases := []struct {
+		name            string
+		feeBps          *int
+		expectedBestBid decimal.Decimal
+		expectedBestAsk decimal.Decimal
+	}{
+		{
+			name:            "recorded fees",
+			feeBps:          nil,
+			expectedBestBid: decimal.NewFromInt(29799),
+			expectedBestAsk: decimal.NewFromInt(30300),
+		},
+		{
+			name:            "explicit zero fee",
+			feeBps:          &zeroFeeBps,
+			expectedBestBid: decimal.NewFromInt(30100),
+			expectedBestAsk: decimal.NewFromInt(30000),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			spreads, err := svc.GetSpreads(
+				context.Background(), timeRange, TimeFrameHour, tt.feeBps,
+			)
+			require.NoError(t, err)
+			require.Len(t, spreads, 1)
+			require.Len(t, spreads[0].Spreads, 1)
+			spread := spreads[0].Spreads[0]
+			require.True(t, tt.expectedBestBid.Equal(spread.BestBid), spread.BestBid.String())
+			require.True(t, tt.expectedBestAsk.Equal(spread.BestAsk), spread.BestAsk.String())
+		})
+	}
+
+	_, err := svc.GetSpreads(
+		context.Background(), timeRange, TimeFrameHour, &invalidFeeBps,
+	)
+	require.ErrorIs(t, err, ErrInvalidFee)
+}
+
+func TestLastFeeAt(t *testing.T) {
+	now := time.Now()
+	fees := []domain.MarketFee{
+		{
+			BasePercentageFee:  decimal.NewFromInt(20),
+			QuotePercentageFee: decimal.NewFromInt(30),
+			Time:               now,
+		},
+		{
+			BasePercentageFee:  decimal.NewFromInt(50),
+			QuotePercentageFee: decimal.NewFromInt(50),
+			Time:               now.Add(time.Hour),
+		},
+	}
+
+	fee, ok := lastFeeAt(fees, now.Add(-time.Hour))
+	require.True(t, ok)
+	require.True(t, decimal.NewFromFloat(0.0025).Equal(fee), fee.String())
+
+	fee, ok = lastFeeAt(fees, now.Add(30*time.Minute))
+	require.True(t, ok)
+	require.True(t, decimal.NewFromFloat(0.0025).Equal(fee), fee.String())
+
+	fee, ok = lastFeeAt(fees, now.Add(2*time.Hour))
+	require.True(t, ok)
+	require.True(t, decimal.NewFromFloat(0.005).Equal(fee), fee.String())
+
+	_, ok = lastFeeAt(nil, now)
+	require.False(t, ok)
+}
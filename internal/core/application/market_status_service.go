@@ -0,0 +1,176 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+type MarketStatusService interface {
+	// RecordSuccess resets the failures of the job for the market and stores
+	//the protocol path the data was fetched with
+	RecordSuccess(ctx context.Context, marketID int, job, protocol string)
+	// RecordFailure stores the error of the job for the market, typed as TLS
+	//if the provider certificate failed verification, and increments its
+	//consecutive failures
+	RecordFailure(ctx context.Context, marketID int, job string, err error)
+	// GetMarketsStatus returns, by market id, the fetch status of every job of
+	//the markets with the passed ids, or of all markets if marketIDs are not
+	//passed, together with the circuit breakers of their providers, as seen
+	//by all the workers
+	GetMarketsStatus(
+		ctx context.Context,
+		marketIDs ...string,
+	) (*MarketsStatus, error)
+}
+
+type marketStatusService struct {
+	marketRepository            domain.MarketRepository
+	marketFetchStatusRepository domain.MarketFetchStatusRepository
+	marketsShardSvc             MarketsShardService
+}
+
+func NewMarketStatusService(
+	marketRepository domain.MarketRepository,
+	marketFetchStatusRepository domain.MarketFetchStatusRepository,
+	marketsShardSvc MarketsShardService,
+) MarketStatusService {
+	return &marketStatusService{
+		marketRepository:            marketRepository,
+		marketFetchStatusRepository: marketFetchStatusRepository,
+		marketsShardSvc:             marketsShardSvc,
+	}
+}
+
+func (m *marketStatusService) RecordSuccess(
+	ctx context.Context,
+	marketID int,
+	job string,
+	protocol string,
+) {
+	m.record(ctx, marketID, job, func(status *domain.MarketFetchStatus) {
+		status.RecordSuccess(protocol, time.Now())
+	})
+}
+
+func (m *marketStatusService) RecordFailure(
+	ctx context.Context,
+	marketID int,
+	job string,
+	err error,
+) {
+	errType := domain.FetchErrorOther
+	if errors.Is(err, tdexmarketloader.ErrTLSVerification) {
+		errType = domain.FetchErrorTLS
+	}
+
+	m.record(ctx, marketID, job, func(status *domain.MarketFetchStatus) {
+		status.RecordFailure(errType, err.Error(), time.Now())
+	})
+}
+
+func (m *marketStatusService) record(
+	ctx context.Context,
+	marketID int,
+	job string,
+	update func(status *domain.MarketFetchStatus),
+) {
+	status, err := m.marketFetchStatusRepository.GetFetchStatus(ctx, marketID, job)
+	if err != nil {
+		log.Errorf("record %s status of market %v -> GetFetchStatus: %v", job, marketID, err)
+		return
+	}
+
+	update(status)
+
+	if err := m.marketFetchStatusRepository.UpsertFetchStatus(
+		ctx, *status,
+	); err != nil {
+		log.Errorf("record %s status of market %v -> UpsertFetchStatus: %v", job, marketID, err)
+	}
+}
+
+func (m *marketStatusService) GetMarketsStatus(
+	ctx context.Context,
+	marketIDs ...string,
+) (*MarketsStatus, error) {
+	ids := make([]int, 0, len(marketIDs))
+	for _, v := range marketIDs {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := m.marketFetchStatusRepository.GetFetchStatuses(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchStatuses := make(map[int][]FetchStatus)
+	for _, v := range statuses {
+		fetchStatuses[v.MarketID] = append(fetchStatuses[v.MarketID], FetchStatus{
+			Job:                 v.Job,
+			LastAttempt:         v.LastAttempt,
+			LastSuccess:         v.LastSuccess,
+			LastError:           v.LastError,
+			LastErrorType:       v.LastErrorType,
+			LastErrorTime:       v.LastErrorTime,
+			ConsecutiveFailures: v.ConsecutiveFailures,
+			FailingSince:        v.FailingSince,
+			Protocol:            v.Protocol,
+		})
+	}
+
+	wanted := make(map[int]bool)
+	for _, v := range ids {
+		wanted[v] = true
+	}
+
+	breakers, err := m.marketsShardSvc.ProvidersCircuitBreaker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]MarketStatus)
+	providersBreaker := make(map[string]CircuitBreaker)
+	for _, v := range markets {
+		if len(wanted) > 0 && !wanted[v.ID] {
+			continue
+		}
+
+		jobs := fetchStatuses[v.ID]
+		if jobs == nil {
+			jobs = make([]FetchStatus, 0)
+		}
+
+		result[strconv.Itoa(v.ID)] = MarketStatus{
+			ProviderName:  v.ProviderName,
+			Url:           v.Url,
+			BaseAsset:     v.BaseAsset,
+			QuoteAsset:    v.QuoteAsset,
+			Active:        v.Active,
+			FetchStatuses: jobs,
+		}
+
+		if breaker, ok := breakers[v.Url]; ok {
+			providersBreaker[v.Url] = breaker
+		}
+	}
+
+	return &MarketsStatus{
+		MarketsStatus:           result,
+		ProvidersCircuitBreaker: providersBreaker,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+type marketRepositoryStub struct {
+	domain.MarketRepository
+	markets []domain.Market
+}
+
+func (m *marketRepositoryStub) GetAllMarkets(
+	ctx context.Context,
+) ([]domain.Market, error) {
+	return m.markets, nil
+}
+
+type fetchStatusRepositoryStub struct {
+	domain.MarketFetchStatusRepository
+	statuses []domain.MarketFetchStatus
+}
+
+func (f *fetchStatusRepositoryStub) GetFetchStatus(
+	ctx context.Context,
+	marketID int,
+	job string,
+) (*domain.MarketFetchStatus, error) {
+	for _, v := range f.statuses {
+		if v.MarketID == marketID && v.Job == job {
+			return &v, nil
+		}
+	}
+	return &domain.MarketFetchStatus{MarketID: marketID, Job: job}, nil
+}
+
+func (f *fetchStatusRepositoryStub) UpsertFetchStatus(
+	ctx context.Context,
+	status domain.MarketFetchStatus,
+) error {
+	for i, v := range f.statuses {
+		if v.MarketID == status.MarketID && v.Job == status.Job {
+			f.statuses[i] = status
+			return nil
+		}
+	}
+	f.statuses = append(f.statuses, status)
+	return nil
+}
+
+func (f *fetchStatusRepositoryStub) GetFetchStatuses(
+	ctx context.Context,
+	marketIDs ...int,
+) ([]domain.MarketFetchStatus, error) {
+	return f.statuses, nil
+}
+
+type tdexMarketLoaderStub struct {
+	tdexmarketloader.Service
+	breakers map[string]tdexmarketloader.CircuitBreakerStatus
+}
+
+func (t *tdexMarketLoaderStub) ProvidersCircuitBreaker() map[string]tdexmarketloader.CircuitBreakerStatus {
+	return t.breakers
+}
+
+func TestMarketStatusService(t *testing.T) {
+	ctx := context.Background()
+	retryAt := time.Now().Add(time.Minute)
+	// breakers of every worker are returned, the most severe one if more
+	//workers called the same provider
+	workers := &workerRepositoryStub{
+		workers: map[string]domain.Worker{
+			"node1": {
+				NodeID:      "node1",
+				HeartbeatAt: time.Now(),
+				CircuitBreakers: map[string]domain.CircuitBreaker{
+					"provider2": {
+						State:               tdexmarketloader.CircuitOpen,
+						ConsecutiveFailures: 5,
+						RetryAt:             retryAt,
+					},
+				},
+			},
+			"node2": {
+				NodeID:      "node2",
+				HeartbeatAt: time.Now(),
+				CircuitBreakers: map[string]domain.CircuitBreaker{
+					"provider2": {
+						State:               tdexmarketloader.CircuitClosed,
+						ConsecutiveFailures: 1,
+					},
+					"unknown": {State: tdexmarketloader.CircuitOpen},
+				},
+			},
+		},
+	}
+	svc := NewMarketStatusService(
+		&marketRepositoryStub{
+			markets: []domain.Market{
+				{ID: 1, Url: "provider1", Active: true},
+				{ID: 2, Url: "provider2"},
+			},
+		},
+		&fetchStatusRepositoryStub{},
+		NewMarketsShardService(workers, &tdexMarketLoaderStub{}, "node1", time.Minute),
+	)
+
+	svc.RecordFailure(ctx, 1, domain.FetchJobPrice, errors.New("unavailable"))
+	svc.RecordFailure(ctx, 1, domain.FetchJobPrice, errors.New("timeout"))
+	svc.RecordFailure(
+		ctx, 1, domain.FetchJobBalance,
+		fmt.Errorf("%w: x509", tdexmarketloader.ErrTLSVerification),
+	)
+	svc.RecordSuccess(ctx, 1, domain.FetchJobBalance, "v1/http1")
+
+	status, err := svc.GetMarketsStatus(ctx)
+	require.NoError(t, err)
+	statuses := status.MarketsStatus
+	require.Len(t, statuses, 2)
+	require.Empty(t, statuses["2"].FetchStatuses)
+
+	// only breakers of providers of the markets are returned
+	require.Len(t, status.ProvidersCircuitBreaker, 1)
+	breaker := status.ProvidersCircuitBreaker["provider2"]
+	require.Equal(t, tdexmarketloader.CircuitOpen, breaker.State)
+	require.Equal(t, 5, breaker.ConsecutiveFailures)
+	require.Equal(t, retryAt, breaker.RetryAt)
+
+	market := statuses["1"]
+	require.True(t, market.Active)
+	require.Len(t, market.FetchStatuses, 2)
+
+	price := market.FetchStatuses[0]
+	require.Equal(t, domain.FetchJobPrice, price.Job)
+	require.Equal(t, 2, price.ConsecutiveFailures)
+	require.Equal(t, "timeout", price.LastError)
+	require.Equal(t, domain.FetchErrorOther, price.LastErrorType)
+	require.True(t, price.LastSuccess.IsZero())
+	require.False(t, price.FailingSince.After(price.LastErrorTime))
+
+	balance := market.FetchStatuses[1]
+	require.Zero(t, balance.ConsecutiveFailures)
+	require.Equal(t, "v1/http1", balance.Protocol)
+	require.Equal(t, domain.FetchErrorTLS, balance.LastErrorType)
+	require.Equal(t, balance.LastAttempt, balance.LastSuccess)
+
+	// failures are reset by a success, the last error is kept
+	svc.RecordSuccess(ctx, 1, domain.FetchJobPrice, "v2")
+	status, err = svc.GetMarketsStatus(ctx, "1")
+	require.NoError(t, err)
+	statuses = status.MarketsStatus
+	require.Len(t, statuses, 1)
+	require.Empty(t, status.ProvidersCircuitBreaker)
+	price = statuses["1"].FetchStatuses[0]
+	require.Zero(t, price.ConsecutiveFailures)
+	require.True(t, price.FailingSince.IsZero())
+	require.Equal(t, "timeout", price.LastError)
+	require.Equal(t, "v2", price.Protocol)
+}
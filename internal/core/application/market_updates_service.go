@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+)
+
+const (
+	// marketUpdatesBufferSize is the number of updates buffered for every
+	//subscriber, updates are dropped if a subscriber falls that much behind
+	marketUpdatesBufferSize = 100
+)
+
+type MarketUpdatesService interface {
+	// Subscribe returns a channel receiving prices and balances of the markets
+	//with the passed ids as soon as fetch jobs record them, updates of all
+	//markets are received if marketIDs are not passed, channel is closed once
+	//ctx is done
+	Subscribe(ctx context.Context, marketIDs ...string) <-chan MarketUpdate
+	// PublishPrice notifies subscribers of every replica of a recorded price
+	PublishPrice(price MarketPrice)
+	// PublishBalance notifies subscribers of every replica of a recorded
+	//balance
+	PublishBalance(balance MarketBalance)
+	// StartListening starts delivering to subscribers the updates published
+	//by every replica, since markets are partitioned among them
+	StartListening() error
+	// StopListening stops delivering updates
+	StopListening()
+}
+
+type marketUpdatesService struct {
+	marketUpdatesBroker port.MarketUpdatesBroker
+	mtx                 *sync.RWMutex
+	subscribers         map[*marketUpdatesSubscriber]struct{}
+	stopListening       context.CancelFunc
+}
+
+type marketUpdatesSubscriber struct {
+	marketIDs map[string]bool
+	updates   chan MarketUpdate
+}
+
+// NewMarketUpdatesService returns the service delivering updates through
+// marketUpdatesBroker, or to the subscribers of this replica only if nil
+func NewMarketUpdatesService(
+	marketUpdatesBroker port.MarketUpdatesBroker,
+) MarketUpdatesService {
+	return &marketUpdatesService{
+		marketUpdatesBroker: marketUpdatesBroker,
+		mtx:                 &sync.RWMutex{},
+		subscribers:         make(map[*marketUpdatesSubscriber]struct{}),
+	}
+}
+
+func (m *marketUpdatesService) Subscribe(
+	ctx context.Context,
+	marketIDs ...string,
+) <-chan MarketUpdate {
+	subscriber := &marketUpdatesSubscriber{
+		marketIDs: make(map[string]bool),
+		updates:   make(chan MarketUpdate, marketUpdatesBufferSize),
+	}
+	for _, v := range marketIDs {
+		subscriber.marketIDs[v] = true
+	}
+
+	m.mtx.Lock()
+	m.subscribers[subscriber] = struct{}{}
+	m.mtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		m.mtx.Lock()
+		delete(m.subscribers, subscriber)
+		close(subscriber.updates)
+		m.mtx.Unlock()
+	}()
+
+	return subscriber.updates
+}
+
+func (m *marketUpdatesService) PublishPrice(price MarketPrice) {
+	m.broadcast(MarketUpdate{
+		MarketID: price.MarketID,
+		Price:    &price,
+	})
+}
+
+func (m *marketUpdatesService) PublishBalance(balance MarketBalance) {
+	m.broadcast(MarketUpdate{
+		MarketID: balance.MarketID,
+		Balance:  &balance,
+	})
+}
+
+func (m *marketUpdatesService) StartListening() error {
+	if m.marketUpdatesBroker == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	payloads, err := m.marketUpdatesBroker.Listen(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+	m.stopListening = cancel
+
+	go func() {
+		for payload := range payloads {
+			var update MarketUpdate
+			if err := json.Unmarshal(payload, &update); err != nil {
+				log.Errorf("StartListening -> Unmarshal: %v", err)
+				continue
+			}
+			m.publish(update)
+		}
+	}()
+
+	return nil
+}
+
+func (m *marketUpdatesService) StopListening() {
+	if m.stopListening != nil {
+		m.stopListening()
+	}
+}
+
+// broadcast sends the update to the subscribers of every replica through the
+// broker, the ones of this replica are notified directly if it fails
+func (m *marketUpdatesService) broadcast(update MarketUpdate) {
+	if m.marketUpdatesBroker == nil {
+		m.publish(update)
+		return
+	}
+
+	payload, err := json.Marshal(update)
+	if err == nil {
+		err = m.marketUpdatesBroker.Publish(context.Background(), payload)
+	}
+	if err != nil {
+		log.Errorf("market %s update not broadcast: %v", update.MarketID, err)
+		m.publish(update)
+	}
+}
+
+// publish never blocks fetch jobs, the update is dropped for subscribers
+// whose buffer is full
+func (m *marketUpdatesService) publish(update MarketUpdate) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for subscriber := range m.subscribers {
+		if len(subscriber.marketIDs) > 0 && !subscriber.marketIDs[update.MarketID] {
+			continue
+		}
+
+		select {
+		case subscriber.updates <- update:
+		default:
+			log.Warnf("market %s update dropped for slow subscriber", update.MarketID)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+// marketUpdatesBrokerStub delivers payloads to the listeners of all the
+// services sharing it, like replicas sharing the db
+type marketUpdatesBrokerStub struct {
+	lock      sync.Mutex
+	listeners map[chan []byte]struct{}
+}
+
+func (m *marketUpdatesBrokerStub) Publish(
+	ctx context.Context,
+	payload []byte,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for listener := range m.listeners {
+		listener <- payload
+	}
+	return nil
+}
+
+func (m *marketUpdatesBrokerStub) Listen(
+	ctx context.Context,
+) (<-chan []byte, error) {
+	listener := make(chan []byte, marketUpdatesBufferSize)
+
+	m.lock.Lock()
+	m.listeners[listener] = struct{}{}
+	m.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		m.lock.Lock()
+		delete(m.listeners, listener)
+		close(listener)
+		m.lock.Unlock()
+	}()
+
+	return listener, nil
+}
+
+func (m *marketRepositoryStub) GetMarketsForActiveIndicator(
+	ctx context.Context,
+	active bool,
+) ([]domain.Market, error) {
+	return m.markets, nil
+}
+
+func TestMarketUpdatesService(t *testing.T) {
+	svc := NewMarketUpdatesService(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	allUpdates := svc.Subscribe(ctx)
+	marketUpdates := svc.Subscribe(ctx, "2")
+
+	svc.PublishPrice(MarketPrice{
+		MarketID:   "1",
+		QuotePrice: decimal.NewFromInt(30000),
+		Time:       time.Now(),
+	})
+	svc.PublishBalance(MarketBalance{
+		MarketID:    "2",
+		BaseBalance: decimal.NewFromInt(100000000),
+		Time:        time.Now(),
+	})
+
+	update := <-allUpdates
+	require.Equal(t, "1", update.MarketID)
+	require.NotNil(t, update.Price)
+	require.Nil(t, update.Balance)
+
+	update = <-allUpdates
+	require.Equal(t, "2", update.MarketID)
+	require.NotNil(t, update.Balance)
+
+	update = <-marketUpdates
+	require.Equal(t, "2", update.MarketID)
+	require.True(t, decimal.NewFromInt(100000000).Equal(update.Balance.BaseBalance))
+
+	cancel()
+	_, ok := <-marketUpdates
+	require.False(t, ok)
+}
+
+func TestMarketUpdatesServiceDropsUpdatesOfSlowSubscribers(t *testing.T) {
+	svc := NewMarketUpdatesService(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := svc.Subscribe(ctx)
+
+	for i := 0; i < marketUpdatesBufferSize+1; i++ {
+		svc.PublishPrice(MarketPrice{MarketID: "1", Time: time.Now()})
+	}
+
+	require.Len(t, updates, marketUpdatesBufferSize)
+}
+
+func TestMarketUpdatesServiceAcrossWorkers(t *testing.T) {
+	markets := make([]domain.Market, 0)
+	for i := 1; i <= 20; i++ {
+		markets = append(markets, domain.Market{
+			ID:         i,
+			Url:        fmt.Sprintf("http://provider%d.onion", i),
+			BaseAsset:  "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225",
+			QuoteAsset: "ce091c998b83c78bb71a632313ba3760f1763d9cfcffae02258ffa9865a37bd2",
+		})
+	}
+	marketRepository := &marketRepositoryStub{markets: markets}
+	workerRepository := &workerRepositoryStub{workers: make(map[string]domain.Worker)}
+	broker := &marketUpdatesBrokerStub{listeners: make(map[chan []byte]struct{})}
+	loader := &snapshotLoaderStub{
+		snapshot: &tdexmarketloader.Snapshot{
+			Price: tdexmarketloader.Price{
+				BasePrice:  decimal.RequireFromString("0.00005"),
+				QuotePrice: decimal.NewFromInt(20000),
+			},
+			Balance: tdexmarketloader.Balance{
+				BaseBalance:  decimal.NewFromInt(1),
+				QuoteBalance: decimal.NewFromInt(20000),
+			},
+		},
+	}
+
+	// every worker publishes the updates of the markets assigned to it
+	workers := make([]*marketSnapshotService, 0)
+	shards := make([]MarketsShardService, 0)
+	for _, nodeID := range []string{"node1", "node2"} {
+		marketsShardSvc := NewMarketsShardService(
+			workerRepository, &tdexMarketLoaderStub{}, nodeID, time.Minute,
+		)
+		marketsShardSvc.Start()
+		defer marketsShardSvc.Stop()
+		shards = append(shards, marketsShardSvc)
+
+		marketUpdatesSvc := NewMarketUpdatesService(broker)
+		require.NoError(t, marketUpdatesSvc.StartListening())
+		defer marketUpdatesSvc.StopListening()
+
+		workers = append(workers, &marketSnapshotService{
+			marketSnapshotRepository: &snapshotRepositoryStub{},
+			marketRepository:         marketRepository,
+			tdexMarketLoaderSvc:      loader,
+			marketUpdatesSvc:         marketUpdatesSvc,
+			alertSvc:                 &alertServiceStub{},
+			marketStatusSvc: NewMarketStatusService(
+				marketRepository, &fetchStatusRepositoryStub{}, marketsShardSvc,
+			),
+			marketsJobRunner: NewMarketsJobRunner(1, marketsShardSvc),
+		})
+	}
+	// the first worker sees the second one at its next heartbeat
+	shards[0].(*marketsShardService).heartbeat()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := workers[0].marketUpdatesSvc.Subscribe(ctx)
+
+	fetched := make([]int, 0)
+	for _, w := range workers {
+		w.FetchSnapshotsForAllMarkets()
+		fetched = append(fetched, len(w.marketSnapshotRepository.(*snapshotRepositoryStub).snapshots))
+	}
+	require.NotZero(t, fetched[0])
+	require.NotZero(t, fetched[1])
+	require.Equal(t, len(markets), fetched[0]+fetched[1])
+
+	// the subscriber of the first worker receives updates of every market
+	prices := make(map[string]bool)
+	balances := make(map[string]bool)
+	for len(prices) < len(markets) || len(balances) < len(markets) {
+		select {
+		case update := <-updates:
+			if update.Price != nil {
+				prices[update.MarketID] = true
+			}
+			if update.Balance != nil {
+				balances[update.MarketID] = true
+			}
+		case <-time.After(time.Second):
+			t.Fatalf(
+				"updates received for %d prices and %d balances of %d markets",
+				len(prices), len(balances), len(markets),
+			)
+		}
+	}
+	for _, v := range markets {
+		require.True(t, prices[strconv.Itoa(v.ID)])
+	}
+}
@@ -0,0 +1,280 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+type MarketUptimeService interface {
+	// GetUptime returns uptime percentage, outages and first/last seen dates
+	//of markets, and of the providers they belong to, in the given time
+	//range, if marketIDs are not passed uptime is returned for all markets
+	GetUptime(
+		ctx context.Context,
+		timeRange TimeRange,
+		marketIDs ...string,
+	) (*MarketsUptime, error)
+}
+
+type marketUptimeService struct {
+	marketRepository      domain.MarketRepository
+	marketEventRepository domain.MarketEventRepository
+}
+
+func NewMarketUptimeService(
+	marketRepository domain.MarketRepository,
+	marketEventRepository domain.MarketEventRepository,
+) MarketUptimeService {
+	return &marketUptimeService{
+		marketRepository:      marketRepository,
+		marketEventRepository: marketEventRepository,
+	}
+}
+
+// activity is the time a market, or a provider, was active in a time range
+type activity struct {
+	// observedFrom is the later of range start and first seen
+	observedFrom time.Time
+	// intervals in which it was active, sorted by start
+	intervals []timeInterval
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+type timeInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (m *marketUptimeService) GetUptime(
+	ctx context.Context,
+	timeRange TimeRange,
+	marketIDs ...string,
+) (*MarketsUptime, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(marketIDs))
+	for _, v := range marketIDs {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := m.marketEventRepository.GetMarketEvents(ctx, endTime, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsEvents := make(map[int][]domain.MarketEvent)
+	for _, v := range events {
+		marketsEvents[v.MarketID] = append(marketsEvents[v.MarketID], v)
+	}
+
+	wanted := make(map[int]bool)
+	for _, v := range ids {
+		wanted[v] = true
+	}
+
+	marketsUptime := make(map[string]MarketUptime)
+	providersActivity := make(map[string][]activity)
+	providersName := make(map[string]string)
+	for _, v := range markets {
+		if len(wanted) > 0 && !wanted[v.ID] {
+			continue
+		}
+
+		a, ok := marketActivity(v, marketsEvents[v.ID], startTime, endTime)
+		if !ok {
+			continue
+		}
+
+		marketsUptime[strconv.Itoa(v.ID)] = MarketUptime{
+			ProviderName: v.ProviderName,
+			Url:          v.Url,
+			BaseAsset:    v.BaseAsset,
+			QuoteAsset:   v.QuoteAsset,
+			Uptime:       a.uptime(endTime),
+		}
+		providersActivity[v.Url] = append(providersActivity[v.Url], a)
+		providersName[v.Url] = v.ProviderName
+	}
+
+	providersUptime := make(map[string]ProviderUptime)
+	for k, v := range providersActivity {
+		providersUptime[k] = ProviderUptime{
+			ProviderName: providersName[k],
+			Uptime:       mergeActivities(v).uptime(endTime),
+		}
+	}
+
+	return &MarketsUptime{
+		MarketsUptime:   marketsUptime,
+		ProvidersUptime: providersUptime,
+	}, nil
+}
+
+// marketActivity replays the lifecycle events of the market, sorted by time
+// and recorded before endTime, to find when it was active in the time range,
+// false is returned if the market was discovered after the range, markets
+// without events are assumed to have kept their current status
+func marketActivity(
+	market domain.Market,
+	events []domain.MarketEvent,
+	startTime time.Time,
+	endTime time.Time,
+) (activity, bool) {
+	a := activity{
+		observedFrom: startTime,
+	}
+
+	active := market.Active
+	if len(events) > 0 {
+		// status before the first event is the opposite of the one it sets
+		active = !events[0].IsActivation()
+		if events[0].Type == domain.MarketEventDiscovered {
+			a.firstSeen = events[0].Time
+			if a.firstSeen.After(startTime) {
+				a.observedFrom = a.firstSeen
+			}
+		}
+	}
+	if !a.observedFrom.Before(endTime) {
+		return activity{}, false
+	}
+
+	var activeSince time.Time
+	for _, v := range events {
+		if !v.Time.After(a.observedFrom) {
+			active = v.IsActivation()
+			continue
+		}
+		if v.IsActivation() == active {
+			continue
+		}
+
+		if active {
+			a.intervals = append(a.intervals, timeInterval{
+				start: maxTime(activeSince, a.observedFrom),
+				end:   v.Time,
+			})
+			a.lastSeen = v.Time
+		} else {
+			activeSince = v.Time
+		}
+		active = v.IsActivation()
+	}
+
+	if active {
+		a.intervals = append(a.intervals, timeInterval{
+			start: maxTime(activeSince, a.observedFrom),
+			end:   endTime,
+		})
+		a.lastSeen = endTime
+	} else if a.lastSeen.IsZero() {
+		// deactivated before the range
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].Type == domain.MarketEventDeactivated {
+				a.lastSeen = events[i].Time
+				break
+			}
+		}
+	}
+
+	return a, true
+}
+
+// mergeActivities returns the time at least one of the activities was active
+func mergeActivities(activities []activity) activity {
+	merged := activities[0]
+	intervals := append([]timeInterval{}, merged.intervals...)
+	for _, v := range activities[1:] {
+		if v.observedFrom.Before(merged.observedFrom) {
+			merged.observedFrom = v.observedFrom
+		}
+		if merged.firstSeen.IsZero() ||
+			(!v.firstSeen.IsZero() && v.firstSeen.Before(merged.firstSeen)) {
+			merged.firstSeen = v.firstSeen
+		}
+		if v.lastSeen.After(merged.lastSeen) {
+			merged.lastSeen = v.lastSeen
+		}
+		intervals = append(intervals, v.intervals...)
+	}
+	// a market known before events were recorded makes the provider known too
+	for _, v := range activities {
+		if v.firstSeen.IsZero() {
+			merged.firstSeen = time.Time{}
+			break
+		}
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+	merged.intervals = make([]timeInterval, 0, len(intervals))
+	for _, v := range intervals {
+		last := len(merged.intervals) - 1
+		if last >= 0 && !v.start.After(merged.intervals[last].end) {
+			merged.intervals[last].end = maxTime(merged.intervals[last].end, v.end)
+			continue
+		}
+		merged.intervals = append(merged.intervals, v)
+	}
+
+	return merged
+}
+
+// uptime returns the active time in percent of the observed time and the
+// gaps between active intervals as outages
+func (a activity) uptime(endTime time.Time) Uptime {
+	outages := make([]Outage, 0)
+	var activeTime time.Duration
+	from := a.observedFrom
+	for _, v := range a.intervals {
+		if v.start.After(from) {
+			outages = append(outages, Outage{Start: from, End: v.start})
+		}
+		activeTime += v.end.Sub(v.start)
+		from = v.end
+	}
+	if from.Before(endTime) {
+		outages = append(outages, Outage{Start: from, End: endTime})
+	}
+
+	percentage := decimal.Zero
+	if observed := endTime.Sub(a.observedFrom); observed > 0 {
+		percentage = decimal.NewFromInt(int64(activeTime)).
+			Div(decimal.NewFromInt(int64(observed))).
+			Mul(decimal.NewFromInt(100)).
+			Round(2)
+	}
+
+	return Uptime{
+		UptimePercentage: percentage,
+		Outages:          outages,
+		FirstSeen:        a.firstSeen,
+		LastSeen:         a.lastSeen,
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
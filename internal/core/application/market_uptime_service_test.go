@@ -0,0 +1,59 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestMarketActivity(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	hour := func(h int) time.Time { return start.Add(time.Duration(h) * time.Hour) }
+
+	// discovered in range, down from hour 4 to hour 6
+	events := []domain.MarketEvent{
+		{MarketID: 1, Type: domain.MarketEventDiscovered, Time: hour(2)},
+		{MarketID: 1, Type: domain.MarketEventDeactivated, Time: hour(4)},
+		{MarketID: 1, Type: domain.MarketEventActivated, Time: hour(6)},
+	}
+	a, ok := marketActivity(domain.Market{ID: 1, Active: true}, events, start, end)
+	require.True(t, ok)
+	uptime := a.uptime(end)
+	require.True(t, decimal.NewFromInt(75).Equal(uptime.UptimePercentage))
+	require.Equal(t, []Outage{{Start: hour(4), End: hour(6)}}, uptime.Outages)
+	require.Equal(t, hour(2), uptime.FirstSeen)
+	require.Equal(t, end, uptime.LastSeen)
+
+	// known before events were recorded, down since hour 5
+	other := []domain.MarketEvent{
+		{MarketID: 2, Type: domain.MarketEventDeactivated, Time: hour(5)},
+	}
+	b, ok := marketActivity(domain.Market{ID: 2}, other, start, end)
+	require.True(t, ok)
+	uptime = b.uptime(end)
+	require.True(t, decimal.NewFromInt(50).Equal(uptime.UptimePercentage))
+	require.True(t, uptime.FirstSeen.IsZero())
+	require.Equal(t, hour(5), uptime.LastSeen)
+
+	// provider is up while at least one market is
+	uptime = mergeActivities([]activity{a, b}).uptime(end)
+	require.True(t, decimal.NewFromInt(90).Equal(uptime.UptimePercentage))
+	require.Equal(t, []Outage{{Start: hour(5), End: hour(6)}}, uptime.Outages)
+	require.True(t, uptime.FirstSeen.IsZero())
+	require.Equal(t, end, uptime.LastSeen)
+
+	// discovered after the range
+	_, ok = marketActivity(domain.Market{ID: 1}, events, start, hour(1))
+	require.False(t, ok)
+
+	// no events, current status is assumed
+	c, ok := marketActivity(domain.Market{ID: 3}, nil, start, end)
+	require.True(t, ok)
+	uptime = c.uptime(end)
+	require.True(t, uptime.UptimePercentage.IsZero())
+	require.Equal(t, []Outage{{Start: start, End: end}}, uptime.Outages)
+}
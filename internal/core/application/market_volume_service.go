@@ -0,0 +1,291 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// allPointsQueryPageSize is the number of points fetched per query when
+	//all balances or prices of a time range are needed
+	allPointsQueryPageSize = 1000
+)
+
+type MarketVolumeService interface {
+	// GetVolumes returns trade volume and trade count, inferred from
+	//consecutive balance changes, for every timeFrame bucket in time range
+	//if marketIDs are not passed volumes are returned for all markets
+	GetVolumes(
+		ctx context.Context,
+		timeRange TimeRange,
+		timeFrame TimeFrame,
+		marketIDs ...string,
+	) (*MarketsVolumes, error)
+}
+
+type marketVolumeService struct {
+	marketBalanceRepository domain.MarketBalanceRepository
+	marketPriceRepository   domain.MarketPriceRepository
+	marketRepository        domain.MarketRepository
+	priceTolerance          decimal.Decimal
+}
+
+func NewMarketVolumeService(
+	marketBalanceRepository domain.MarketBalanceRepository,
+	marketPriceRepository domain.MarketPriceRepository,
+	marketRepository domain.MarketRepository,
+	priceToleranceBps int,
+) MarketVolumeService {
+	return &marketVolumeService{
+		marketBalanceRepository: marketBalanceRepository,
+		marketPriceRepository:   marketPriceRepository,
+		marketRepository:        marketRepository,
+		priceTolerance:          decimal.New(int64(priceToleranceBps), -4),
+	}
+}
+
+func (m *marketVolumeService) GetVolumes(
+	ctx context.Context,
+	timeRange TimeRange,
+	timeFrame TimeFrame,
+	marketIDs ...string,
+) (*MarketsVolumes, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	markets, err := m.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsMap := make(map[string]domain.Market)
+	for _, v := range markets {
+		marketsMap[strconv.Itoa(v.ID)] = v
+	}
+
+	marketsBalances, err := getAllBalances(
+		ctx, m.marketBalanceRepository, startTime, endTime, "", marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsPrices, err := getAllPrices(
+		ctx, m.marketPriceRepository, startTime, endTime, "", marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]Volume)
+	for k, v := range marketsBalances {
+		trades := inferTrades(v, marketsPrices[k], m.priceTolerance)
+		volumes := groupTradesByTimeFrame(trades, timeFrame)
+
+		for i := range volumes {
+			volumes[i].BaseAsset = marketsMap[k].BaseAsset
+			volumes[i].QuoteAsset = marketsMap[k].QuoteAsset
+		}
+
+		result[k] = volumes
+	}
+
+	return &MarketsVolumes{
+		MarketsVolumes: result,
+	}, nil
+}
+
+// getAllBalances collects balances in time range going through all pages,
+// balances are sorted by time ASC
+func getAllBalances(
+	ctx context.Context,
+	marketBalanceRepository domain.MarketBalanceRepository,
+	startTime, endTime time.Time,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketBalance, error) {
+	result := make(map[string][]domain.MarketBalance)
+	for pageNum := 1; ; pageNum++ {
+		balances, err := marketBalanceRepository.GetBalancesForMarkets(
+			ctx,
+			startTime,
+			endTime,
+			domain.NewPage(pageNum, allPointsQueryPageSize),
+			groupBy,
+			marketIDs...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		hasMore := false
+		for k, v := range balances {
+			result[k] = append(result[k], v...)
+			if len(v) >= allPointsQueryPageSize {
+				hasMore = true
+			}
+		}
+		if !hasMore {
+			break
+		}
+	}
+
+	for _, v := range result {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].Time.Before(v[j].Time)
+		})
+	}
+
+	return result, nil
+}
+
+// getAllPrices collects prices in time range going through all pages, prices
+// are sorted by time ASC
+func getAllPrices(
+	ctx context.Context,
+	marketPriceRepository domain.MarketPriceRepository,
+	startTime, endTime time.Time,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketPrice, error) {
+	result := make(map[string][]domain.MarketPrice)
+	for pageNum := 1; ; pageNum++ {
+		prices, err := marketPriceRepository.GetPricesForMarkets(
+			ctx,
+			startTime,
+			endTime,
+			domain.NewPage(pageNum, allPointsQueryPageSize),
+			groupBy,
+			marketIDs...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		hasMore := false
+		for k, v := range prices {
+			result[k] = append(result[k], v...)
+			if len(v) >= allPointsQueryPageSize {
+				hasMore = true
+			}
+		}
+		if !hasMore {
+			break
+		}
+	}
+
+	for _, v := range result {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].Time.Before(v[j].Time)
+		})
+	}
+
+	return result, nil
+}
+
+type inferredTrade struct {
+	baseAmount  decimal.Decimal
+	quoteAmount decimal.Decimal
+	time        time.Time
+}
+
+// inferTrades compares consecutive balances, sorted by time ASC, and returns
+// the changes that look like a swap: base and quote balances moving in
+// opposite directions with a ratio close to the market quote price.
+// Changes moving both balances the same way are deposits or withdrawals and
+// are ignored, as well as those whose implied price is further than
+// tolerance from the recorded one.
+func inferTrades(
+	balances []domain.MarketBalance,
+	prices []domain.MarketPrice,
+	tolerance decimal.Decimal,
+) []inferredTrade {
+	trades := make([]inferredTrade, 0)
+	for i := 1; i < len(balances); i++ {
+		baseDelta := balances[i].BaseBalance.Sub(balances[i-1].BaseBalance)
+		quoteDelta := balances[i].QuoteBalance.Sub(balances[i-1].QuoteBalance)
+		if baseDelta.IsZero() || quoteDelta.IsZero() {
+			continue
+		}
+		if baseDelta.Sign() == quoteDelta.Sign() {
+			continue
+		}
+
+		price, ok := lastPriceAt(prices, balances[i].Time)
+		if !ok || price.QuotePrice.IsZero() {
+			continue
+		}
+
+		impliedPrice := quoteDelta.Abs().Div(baseDelta.Abs())
+		distance := impliedPrice.Sub(price.QuotePrice).Abs().Div(price.QuotePrice)
+		if distance.GreaterThan(tolerance) {
+			continue
+		}
+
+		trades = append(trades, inferredTrade{
+			baseAmount:  baseDelta.Abs().Shift(-assetPrecision),
+			quoteAmount: quoteDelta.Abs().Shift(-assetPrecision),
+			time:        balances[i].Time,
+		})
+	}
+
+	return trades
+}
+
+// groupTradesByTimeFrame sums trades into timeFrame buckets, sorted by time
+// ASC, buckets without trades are omitted
+func groupTradesByTimeFrame(
+	trades []inferredTrade,
+	timeFrame TimeFrame,
+) []Volume {
+	volumesPerBucket := make(map[time.Time]*Volume)
+	buckets := make([]time.Time, 0)
+	for _, v := range trades {
+		bucket := timeFrame.bucketStart(v.time)
+		volume, ok := volumesPerBucket[bucket]
+		if !ok {
+			volume = &Volume{Time: bucket}
+			volumesPerBucket[bucket] = volume
+			buckets = append(buckets, bucket)
+		}
+
+		volume.BaseVolume = volume.BaseVolume.Add(v.baseAmount)
+		volume.QuoteVolume = volume.QuoteVolume.Add(v.quoteAmount)
+		volume.TradeCount++
+	}
+
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Before(buckets[j])
+	})
+
+	volumes := make([]Volume, 0, len(buckets))
+	for _, v := range buckets {
+		volume := volumesPerBucket[v]
+		if !volume.BaseVolume.IsZero() {
+			volume.Vwap = volume.QuoteVolume.Div(volume.BaseVolume)
+		}
+		volumes = append(volumes, *volume)
+	}
+
+	return volumes
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestInferTrades(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	balance := func(minutes, base, quote int64) domain.MarketBalance {
+		return domain.MarketBalance{
+			BaseBalance:  decimal.NewFromInt(base),
+			QuoteBalance: decimal.NewFromInt(quote),
+			Time:         start.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+	prices := []domain.MarketPrice{
+		{QuotePrice: decimal.NewFromInt(100), Time: start},
+	}
+
+	balances := []domain.MarketBalance{
+		balance(0, 1000, 100000),
+		// sell of 10 base for 1000 quote
+		balance(1, 1010, 99000),
+		// buy of 20 base for ~2010 quote
+		balance(2, 990, 101010),
+		// deposit of both assets
+		balance(3, 1090, 111010),
+		// withdrawal of quote only
+		balance(4, 1090, 110000),
+		// opposite moves far from market price
+		balance(5, 1080, 120000),
+		// no changes
+		balance(6, 1080, 120000),
+	}
+
+	trades := inferTrades(balances, prices, decimal.NewFromFloat(0.01))
+	require.Len(t, trades, 2)
+	require.True(t, decimal.NewFromInt(10).Shift(-assetPrecision).Equal(trades[0].baseAmount))
+	require.True(t, decimal.NewFromInt(1000).Shift(-assetPrecision).Equal(trades[0].quoteAmount))
+	require.Equal(t, start.Add(time.Minute), trades[0].time)
+	require.True(t, decimal.NewFromInt(20).Shift(-assetPrecision).Equal(trades[1].baseAmount))
+	require.True(t, decimal.NewFromInt(2010).Shift(-assetPrecision).Equal(trades[1].quoteAmount))
+
+	require.Empty(t, inferTrades(balances, nil, decimal.NewFromFloat(0.01)))
+}
+
+func TestGroupTradesByTimeFrame(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	trades := []inferredTrade{
+		{baseAmount: decimal.NewFromInt(1), quoteAmount: decimal.NewFromInt(100), time: start.Add(10 * time.Minute)},
+		{baseAmount: decimal.NewFromInt(3), quoteAmount: decimal.NewFromInt(330), time: start.Add(50 * time.Minute)},
+		{baseAmount: decimal.NewFromInt(2), quoteAmount: decimal.NewFromInt(200), time: start.Add(3 * time.Hour)},
+	}
+
+	timeFrame := TimeFrameHour
+	volumes := groupTradesByTimeFrame(trades, timeFrame)
+	require.Len(t, volumes, 2)
+
+	require.Equal(t, start, volumes[0].Time)
+	require.Equal(t, 2, volumes[0].TradeCount)
+	require.True(t, decimal.NewFromInt(4).Equal(volumes[0].BaseVolume))
+	require.True(t, decimal.NewFromInt(430).Equal(volumes[0].QuoteVolume))
+	require.True(t, decimal.NewFromFloat(107.5).Equal(volumes[0].Vwap))
+
+	require.Equal(t, start.Add(3*time.Hour), volumes[1].Time)
+	require.Equal(t, 1, volumes[1].TradeCount)
+	require.True(t, decimal.NewFromInt(100).Equal(volumes[1].Vwap))
+
+	timeFrame = TimeFrameDay
+	volumes = groupTradesByTimeFrame(trades, timeFrame)
+	require.Len(t, volumes, 1)
+	require.Equal(t, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), volumes[0].Time)
+	require.Equal(t, 3, volumes[0].TradeCount)
+}
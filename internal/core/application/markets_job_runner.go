@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+type MarketsJobRunner interface {
+	// Run calls fetch for every market returned by listMarkets on a bounded
+	//pool of workers shared by all jobs, and returns once all calls are done;
+	//if the previous run of the job is still in flight the run is coalesced
+	//into a single one started as soon as it completes
+	Run(
+		job string,
+		listMarkets func(ctx context.Context) ([]domain.Market, error),
+		fetch func(ctx context.Context, market domain.Market),
+	)
+}
+
+type marketsJobRunner struct {
+	tasks           chan func()
+	marketsShardSvc MarketsShardService
+
+	mtx *sync.Mutex
+	// jobs are the jobs in flight, true if another run is pending
+	jobs map[string]bool
+}
+
+// NewMarketsJobRunner returns a MarketsJobRunner fetching at most workers
+// markets at a time, only the ones owned by this node if marketsShardSvc is
+// not nil
+func NewMarketsJobRunner(
+	workers int,
+	marketsShardSvc MarketsShardService,
+) MarketsJobRunner {
+	r := &marketsJobRunner{
+		tasks:           make(chan func()),
+		marketsShardSvc: marketsShardSvc,
+		mtx:             &sync.Mutex{},
+		jobs:            make(map[string]bool),
+	}
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for task := range r.tasks {
+				task()
+			}
+		}()
+	}
+
+	return r
+}
+
+func (r *marketsJobRunner) Run(
+	job string,
+	listMarkets func(ctx context.Context) ([]domain.Market, error),
+	fetch func(ctx context.Context, market domain.Market),
+) {
+	r.mtx.Lock()
+	if pending, inFlight := r.jobs[job]; inFlight {
+		if pending {
+			log.Warnf("job %s still in flight, run skipped", job)
+		} else {
+			log.Warnf("job %s still in flight, run delayed", job)
+		}
+		r.jobs[job] = true
+		r.mtx.Unlock()
+		return
+	}
+	r.jobs[job] = false
+	r.mtx.Unlock()
+
+	for {
+		r.runOnce(job, listMarkets, fetch)
+
+		r.mtx.Lock()
+		if !r.jobs[job] {
+			delete(r.jobs, job)
+			r.mtx.Unlock()
+			return
+		}
+		r.jobs[job] = false
+		r.mtx.Unlock()
+	}
+}
+
+func (r *marketsJobRunner) runOnce(
+	job string,
+	listMarkets func(ctx context.Context) ([]domain.Market, error),
+	fetch func(ctx context.Context, market domain.Market),
+) {
+	log.Infof("job %s at: %v", job, time.Now())
+	ctx := context.Background()
+
+	markets, err := listMarkets(ctx)
+	if err != nil {
+		log.Errorf("job %s -> listMarkets: %v", job, err)
+		return
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, v := range ownedMarkets(r.marketsShardSvc, markets) {
+		market := v
+		wg.Add(1)
+		r.tasks <- func() {
+			defer wg.Done()
+			fetch(ctx, market)
+		}
+	}
+	wg.Wait()
+}
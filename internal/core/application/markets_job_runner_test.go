@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestMarketsJobRunner(t *testing.T) {
+	runner := NewMarketsJobRunner(2, nil)
+
+	markets := make([]domain.Market, 0)
+	for i := 1; i <= 6; i++ {
+		markets = append(markets, domain.Market{ID: i})
+	}
+	listMarkets := func(ctx context.Context) ([]domain.Market, error) {
+		return markets, nil
+	}
+
+	mtx := &sync.Mutex{}
+	running, maxRunning, fetched := 0, 0, 0
+	release := make(chan struct{})
+	fetch := func(ctx context.Context, market domain.Market) {
+		mtx.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mtx.Unlock()
+
+		<-release
+
+		mtx.Lock()
+		running--
+		fetched++
+		mtx.Unlock()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		runner.Run(domain.FetchJobPrice, listMarkets, fetch)
+		close(done)
+	}()
+
+	// runs requested while the first one is in flight are coalesced into one
+	time.Sleep(50 * time.Millisecond)
+	runner.Run(domain.FetchJobPrice, listMarkets, fetch)
+	runner.Run(domain.FetchJobPrice, listMarkets, fetch)
+
+	close(release)
+	<-done
+
+	require.Equal(t, 2, maxRunning)
+	require.Equal(t, 2*len(markets), fetched)
+
+	// job is not in flight anymore
+	runner.Run(domain.FetchJobPrice, listMarkets, fetch)
+	require.Equal(t, 3*len(markets), fetched)
+}
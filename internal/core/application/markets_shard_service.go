@@ -0,0 +1,230 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/pkg/hashring"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+const (
+	// shardVirtualNodes is number of points of every worker on the hash ring
+	shardVirtualNodes = 100
+	// workerMissedHeartbeats is number of heartbeats a worker can miss before
+	//its markets are assigned to the others
+	workerMissedHeartbeats = 3
+)
+
+type MarketsShardService interface {
+	// Start registers the node as worker heartbeating periodically, the
+	//workers alive are read every heartbeat and markets rebalanced among them
+	Start()
+	// Stop stops heartbeating and unregisters the worker, so that its markets
+	//are assigned to the others right away
+	Stop()
+	// OwnedMarkets returns the markets assigned to this node by consistent
+	//hash of their key, none if the node could not heartbeat recently since
+	//its markets may have been assigned to the others
+	OwnedMarkets(markets []domain.Market) []domain.Market
+	// ProvidersCircuitBreaker returns, by provider url, the circuit breakers
+	//of the workers alive, the most severe one if more workers called the
+	//same provider
+	ProvidersCircuitBreaker(ctx context.Context) (map[string]CircuitBreaker, error)
+}
+
+type marketsShardService struct {
+	workerRepository    domain.WorkerRepository
+	tdexMarketLoaderSvc tdexmarketloader.Service
+	nodeID              string
+	interval            time.Duration
+	startedAt           time.Time
+
+	lock          sync.RWMutex
+	ring          *hashring.Ring
+	lastHeartbeat time.Time
+	quit          chan struct{}
+	done          chan struct{}
+}
+
+func NewMarketsShardService(
+	workerRepository domain.WorkerRepository,
+	tdexMarketLoaderSvc tdexmarketloader.Service,
+	nodeID string,
+	interval time.Duration,
+) MarketsShardService {
+	return &marketsShardService{
+		workerRepository:    workerRepository,
+		tdexMarketLoaderSvc: tdexMarketLoaderSvc,
+		nodeID:              nodeID,
+		interval:            interval,
+	}
+}
+
+func (m *marketsShardService) Start() {
+	m.startedAt = time.Now()
+	m.heartbeat()
+
+	m.quit, m.done = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.quit:
+				return
+			case <-ticker.C:
+				m.heartbeat()
+			}
+		}
+	}()
+}
+
+func (m *marketsShardService) Stop() {
+	if m.quit != nil {
+		close(m.quit)
+		<-m.done
+	}
+
+	m.lock.Lock()
+	m.ring, m.lastHeartbeat = nil, time.Time{}
+	m.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+	if err := m.workerRepository.DeleteWorker(ctx, m.nodeID); err != nil {
+		log.Errorf("Stop -> DeleteWorker: %v", err)
+	}
+}
+
+func (m *marketsShardService) OwnedMarkets(
+	markets []domain.Market,
+) []domain.Market {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.ring == nil || time.Since(m.lastHeartbeat) > m.ttl() {
+		log.Warnf("worker %v not heartbeating, no markets owned", m.nodeID)
+		return nil
+	}
+
+	owned := make([]domain.Market, 0)
+	for _, v := range markets {
+		if m.ring.Get(v.Key()) == m.nodeID {
+			owned = append(owned, v)
+		}
+	}
+
+	return owned
+}
+
+func (m *marketsShardService) ProvidersCircuitBreaker(
+	ctx context.Context,
+) (map[string]CircuitBreaker, error) {
+	workers, err := m.workerRepository.GetWorkers(ctx, time.Now().Add(-m.ttl()))
+	if err != nil {
+		return nil, err
+	}
+
+	breakers := make(map[string]CircuitBreaker)
+	for _, w := range workers {
+		for url, v := range w.CircuitBreakers {
+			breaker := CircuitBreaker(v)
+			if current, ok := breakers[url]; !ok || moreSevere(breaker, current) {
+				breakers[url] = breaker
+			}
+		}
+	}
+
+	return breakers, nil
+}
+
+// heartbeat renews the worker, sharing the circuit breakers of the providers
+// it called, and rebuilds the ring with the workers alive
+func (m *marketsShardService) heartbeat() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+
+	breakers := make(map[string]domain.CircuitBreaker)
+	for url, v := range m.tdexMarketLoaderSvc.ProvidersCircuitBreaker() {
+		breakers[url] = domain.CircuitBreaker(v)
+	}
+
+	now := time.Now()
+	if err := m.workerRepository.Heartbeat(ctx, domain.Worker{
+		NodeID:          m.nodeID,
+		StartedAt:       m.startedAt,
+		HeartbeatAt:     now,
+		CircuitBreakers: breakers,
+	}); err != nil {
+		log.Errorf("heartbeat -> Heartbeat: %v", err)
+		return
+	}
+
+	workers, err := m.workerRepository.GetWorkers(ctx, now.Add(-m.ttl()))
+	if err != nil {
+		log.Errorf("heartbeat -> GetWorkers: %v", err)
+		return
+	}
+
+	nodes := []string{m.nodeID}
+	for _, v := range workers {
+		nodes = append(nodes, v.NodeID)
+	}
+	ring := hashring.New(shardVirtualNodes, nodes...)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.ring == nil || !equalNodes(m.ring.Nodes(), ring.Nodes()) {
+		log.Infof("workers %v, markets rebalanced", ring.Nodes())
+	}
+	m.ring, m.lastHeartbeat = ring, now
+}
+
+func (m *marketsShardService) ttl() time.Duration {
+	return workerMissedHeartbeats * m.interval
+}
+
+// ownedMarkets returns the markets owned by this node, all of them if
+// markets are not sharded
+func ownedMarkets(
+	marketsShardSvc MarketsShardService,
+	markets []domain.Market,
+) []domain.Market {
+	if marketsShardSvc == nil {
+		return markets
+	}
+	return marketsShardSvc.OwnedMarkets(markets)
+}
+
+// moreSevere returns whether breaker a is more severe than b, open breakers
+// first and then the ones with more failures
+func moreSevere(a, b CircuitBreaker) bool {
+	severity := map[string]int{
+		tdexmarketloader.CircuitOpen:     2,
+		tdexmarketloader.CircuitHalfOpen: 1,
+	}
+	if severity[a.State] != severity[b.State] {
+		return severity[a.State] > severity[b.State]
+	}
+	return a.ConsecutiveFailures > b.ConsecutiveFailures
+}
+
+func equalNodes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
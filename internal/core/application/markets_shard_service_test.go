@@ -0,0 +1,145 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+)
+
+type workerRepositoryStub struct {
+	lock    sync.Mutex
+	workers map[string]domain.Worker
+	err     error
+}
+
+func (w *workerRepositoryStub) Heartbeat(
+	ctx context.Context,
+	worker domain.Worker,
+) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	w.workers[worker.NodeID] = worker
+	return nil
+}
+
+func (w *workerRepositoryStub) GetWorkers(
+	ctx context.Context,
+	aliveSince time.Time,
+) ([]domain.Worker, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.err != nil {
+		return nil, w.err
+	}
+	workers := make([]domain.Worker, 0)
+	for _, v := range w.workers {
+		if !v.HeartbeatAt.Before(aliveSince) {
+			workers = append(workers, v)
+		}
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].NodeID < workers[j].NodeID
+	})
+	return workers, nil
+}
+
+func (w *workerRepositoryStub) DeleteWorker(
+	ctx context.Context,
+	nodeID string,
+) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.workers, nodeID)
+	return nil
+}
+
+func (w *workerRepositoryStub) setErr(err error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.err = err
+}
+
+func TestMarketsShardService(t *testing.T) {
+	markets := make([]domain.Market, 0)
+	for i := 1; i <= 100; i++ {
+		markets = append(markets, domain.Market{
+			ID:  i,
+			Url: fmt.Sprintf("http://provider%d.onion", i),
+		})
+	}
+	repository := &workerRepositoryStub{workers: make(map[string]domain.Worker)}
+	interval := 10 * time.Millisecond
+
+	worker1 := NewMarketsShardService(
+		repository,
+		&tdexMarketLoaderStub{
+			breakers: map[string]tdexmarketloader.CircuitBreakerStatus{
+				"http://provider1.onion": {State: tdexmarketloader.CircuitOpen},
+			},
+		},
+		"node1",
+		interval,
+	)
+	worker1.Start()
+	require.Len(t, worker1.OwnedMarkets(markets), len(markets))
+
+	// circuit breakers are shared with the other workers on heartbeat
+	breakers, err := worker1.ProvidersCircuitBreaker(context.Background())
+	require.NoError(t, err)
+	require.Equal(
+		t, tdexmarketloader.CircuitOpen, breakers["http://provider1.onion"].State,
+	)
+
+	// markets are rebalanced when a worker joins
+	worker2 := NewMarketsShardService(
+		repository, &tdexMarketLoaderStub{}, "node2", interval,
+	)
+	worker2.Start()
+	defer worker2.Stop()
+	require.Eventually(t, func() bool {
+		return len(worker1.OwnedMarkets(markets)) < len(markets)
+	}, time.Second, interval)
+
+	// every market is owned by exactly one worker
+	owned1, owned2 := worker1.OwnedMarkets(markets), worker2.OwnedMarkets(markets)
+	require.NotEmpty(t, owned1)
+	require.NotEmpty(t, owned2)
+	require.Len(t, append(owned1, owned2...), len(markets))
+	ids := make(map[int]bool)
+	for _, v := range append(owned1, owned2...) {
+		ids[v.ID] = true
+	}
+	require.Len(t, ids, len(markets))
+
+	// and when a worker leaves
+	worker1.Stop()
+	require.Empty(t, worker1.OwnedMarkets(markets))
+	require.Eventually(t, func() bool {
+		return len(worker2.OwnedMarkets(markets)) == len(markets)
+	}, time.Second, interval)
+
+	// a worker not able to heartbeat gives up its markets
+	repository.setErr(errors.New("db unavailable"))
+	require.Eventually(t, func() bool {
+		return len(worker2.OwnedMarkets(markets)) == 0
+	}, time.Second, interval)
+	repository.setErr(nil)
+	require.Eventually(t, func() bool {
+		return len(worker2.OwnedMarkets(markets)) == len(markets)
+	}, time.Second, interval)
+}
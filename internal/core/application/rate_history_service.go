@@ -0,0 +1,258 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+
+	"github.com/robfig/cron/v3"
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// rateHistoryMargin extends the time range rates are loaded for so that
+	//points at the edges of the range are converted with the closest rate
+	//even if it was recorded just outside the range
+	rateHistoryMargin = 24 * time.Hour
+)
+
+type RateHistoryService interface {
+	// StartFetchingRatesJob starts cron job that will periodically snapshot
+	//and store the rates of assets currencies to reference currencies
+	StartFetchingRatesJob() error
+	// StopFetchingRatesJob stops the cron job, it can be started again
+	StopFetchingRatesJob()
+}
+
+type rateHistoryService struct {
+	rateRepository          domain.RateRepository
+	raterSvc                port.RateService
+	currencies              []string
+	referenceCurrencies     []string
+	cronSvc                 *cron.Cron
+	fetchRateCronExpression string
+}
+
+func NewRateHistoryService(
+	rateRepository domain.RateRepository,
+	raterSvc port.RateService,
+	currencies []string,
+	referenceCurrencies []string,
+	jobPeriodInMinutes string,
+) RateHistoryService {
+	return &rateHistoryService{
+		rateRepository:          rateRepository,
+		raterSvc:                raterSvc,
+		currencies:              currencies,
+		referenceCurrencies:     referenceCurrencies,
+		cronSvc:                 cron.New(),
+		fetchRateCronExpression: fmt.Sprintf("@every %vm", jobPeriodInMinutes),
+	}
+}
+
+func (r *rateHistoryService) StartFetchingRatesJob() error {
+	if _, err := r.cronSvc.AddJob(
+		r.fetchRateCronExpression,
+		cron.FuncJob(r.FetchRates),
+	); err != nil {
+		return err
+	}
+
+	r.cronSvc.Start()
+
+	return nil
+}
+
+func (r *rateHistoryService) StopFetchingRatesJob() {
+	stopCronJobs(r.cronSvc)
+}
+
+func (r *rateHistoryService) FetchRates() {
+	log.Infof("job FetchRates at: %v", time.Now())
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, source := range r.currencies {
+		for _, target := range r.referenceCurrencies {
+			if strings.EqualFold(source, target) {
+				continue
+			}
+
+			rate, err := r.raterSvc.ConvertCurrency(ctx, source, target)
+			if err != nil {
+				log.Errorf("FetchRates for %s/%s -> ConvertCurrency: %v", source, target, err)
+				continue
+			}
+			if rate.IsZero() {
+				continue
+			}
+
+			if err := r.rateRepository.InsertRate(ctx, domain.Rate{
+				Source: source,
+				Target: target,
+				Value:  rate,
+				Time:   now,
+			}); err != nil {
+				log.Errorf("FetchRates for %s/%s -> InsertRate: %v", source, target, err)
+			}
+		}
+	}
+}
+
+// rateHistory converts currencies using the recorded rate closest to a given
+// moment, rates of every currency pair are loaded once for the whole time
+// range, current rates are used only if no rate repository is given
+type rateHistory struct {
+	rateRepository domain.RateRepository
+	raterSvc       port.RateService
+	startTime      time.Time
+	endTime        time.Time
+	rates          map[string][]domain.Rate
+}
+
+func newRateHistory(
+	rateRepository domain.RateRepository,
+	raterSvc port.RateService,
+	startTime, endTime time.Time,
+) *rateHistory {
+	return &rateHistory{
+		rateRepository: rateRepository,
+		raterSvc:       raterSvc,
+		startTime:      startTime,
+		endTime:        endTime,
+		rates:          make(map[string][]domain.Rate),
+	}
+}
+
+// convertCurrencyAt converts 1 unit of source currency to target currency
+// with the rate recorded closest to the given time, ErrRateNotRecorded is
+// returned if none has been recorded around the time range
+func (r *rateHistory) convertCurrencyAt(
+	ctx context.Context,
+	source, target string,
+	at time.Time,
+) (decimal.Decimal, error) {
+	if r.rateRepository == nil {
+		return r.raterSvc.ConvertCurrency(ctx, source, target)
+	}
+
+	rates, err := r.getRates(ctx, source, target)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	rate, ok := closestRate(rates, at)
+	if !ok {
+		return decimal.Zero, fmt.Errorf(
+			"%w: %s/%s at %s", ErrRateNotRecorded, source, target, at,
+		)
+	}
+
+	return rate.Value, nil
+}
+
+// recordedRateAt returns the rate of source currency to target currency
+// recorded closest to the given time, false if none has been recorded
+func (r *rateHistory) recordedRateAt(
+	ctx context.Context,
+	source, target string,
+	at time.Time,
+) (decimal.Decimal, bool) {
+	rates, err := r.getRates(ctx, source, target)
+	if err != nil {
+		log.Debugf("recordedRateAt -> getRates: %v", err)
+	}
+
+	rate, ok := closestRate(rates, at)
+	if !ok || rate.Value.IsZero() {
+		return decimal.Zero, false
+	}
+
+	return rate.Value, true
+}
+
+// averageRate returns the mean of the rates of source currency to target
+// currency recorded in time range, the one closest to the end of the range
+// is used if none has been recorded within it, see convertCurrencyAt
+func (r *rateHistory) averageRate(
+	ctx context.Context,
+	source, target string,
+) (decimal.Decimal, error) {
+	rates, err := r.getRates(ctx, source, target)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	sum, count := decimal.Zero, 0
+	for _, v := range rates {
+		if v.Time.Before(r.startTime) || v.Time.After(r.endTime) {
+			continue
+		}
+		sum = sum.Add(v.Value)
+		count++
+	}
+	if count > 0 {
+		return sum.Div(decimal.NewFromInt(int64(count))), nil
+	}
+
+	return r.convertCurrencyAt(ctx, source, target, r.endTime)
+}
+
+func (r *rateHistory) getRates(
+	ctx context.Context,
+	source, target string,
+) ([]domain.Rate, error) {
+	if r.rateRepository == nil {
+		return nil, nil
+	}
+
+	key := strings.ToLower(source) + "/" + strings.ToLower(target)
+	if rates, ok := r.rates[key]; ok {
+		return rates, nil
+	}
+
+	rates, err := r.rateRepository.GetRates(
+		ctx,
+		source,
+		target,
+		r.startTime.Add(-rateHistoryMargin),
+		r.endTime.Add(rateHistoryMargin),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rates[key] = rates
+
+	return rates, nil
+}
+
+// closestRate returns the rate whose time is closest to the given one, rates
+// must be sorted by time ASC
+func closestRate(rates []domain.Rate, at time.Time) (domain.Rate, bool) {
+	if len(rates) == 0 {
+		return domain.Rate{}, false
+	}
+
+	i := sort.Search(len(rates), func(i int) bool {
+		return !rates[i].Time.Before(at)
+	})
+	if i == 0 {
+		return rates[0], true
+	}
+	if i == len(rates) {
+		return rates[len(rates)-1], true
+	}
+
+	if at.Sub(rates[i-1].Time) <= rates[i].Time.Sub(at) {
+		return rates[i-1], true
+	}
+
+	return rates[i], true
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+)
+
+func TestClosestRate(t *testing.T) {
+	now := time.Now()
+	rates := []domain.Rate{
+		{Value: decimal.NewFromInt(1), Time: now},
+		{Value: decimal.NewFromInt(2), Time: now.Add(time.Hour)},
+		{Value: decimal.NewFromInt(3), Time: now.Add(2 * time.Hour)},
+	}
+
+	testCases := []struct {
+		name          string
+		at            time.Time
+		expectedValue decimal.Decimal
+	}{
+		{
+			name:          "before first rate",
+			at:            now.Add(-time.Hour),
+			expectedValue: decimal.NewFromInt(1),
+		},
+		{
+			name:          "closer to previous rate",
+			at:            now.Add(20 * time.Minute),
+			expectedValue: decimal.NewFromInt(1),
+		},
+		{
+			name:          "closer to next rate",
+			at:            now.Add(40 * time.Minute),
+			expectedValue: decimal.NewFromInt(2),
+		},
+		{
+			name:          "exact time",
+			at:            now.Add(time.Hour),
+			expectedValue: decimal.NewFromInt(2),
+		},
+		{
+			name:          "after last rate",
+			at:            now.Add(5 * time.Hour),
+			expectedValue: decimal.NewFromInt(3),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, ok := closestRate(rates, tt.at)
+			require.True(t, ok)
+			require.True(t, tt.expectedValue.Equal(rate.Value), rate.Value.String())
+		})
+	}
+
+	_, ok := closestRate(nil, now)
+	require.False(t, ok)
+}
+
+func TestRateHistoryFallbackToCurrentRate(t *testing.T) {
+	raterMock := new(port.MockRateService)
+	raterMock.On("ConvertCurrency", mock.Anything, "bitcoin", "eur").
+		Return(decimal.NewFromInt(30000), nil)
+
+	rates := newRateHistory(nil, raterMock, time.Now().Add(-time.Hour), time.Now())
+
+	rate, err := rates.convertCurrencyAt(context.Background(), "bitcoin", "eur", time.Now())
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(30000).Equal(rate))
+
+	averageRate, err := rates.averageRate(context.Background(), "bitcoin", "eur")
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(30000).Equal(averageRate))
+}
+
+func TestRateHistoryRateNotRecorded(t *testing.T) {
+	// ConvertCurrency is not mocked, current rates must not be used
+	raterMock := new(port.MockRateService)
+
+	now := time.Now()
+	rates := newRateHistory(rateRepositoryStub{
+		{"bitcoin", "eur"}: {
+			{Value: decimal.NewFromInt(30000), Time: now.Add(-time.Hour)},
+		},
+	}, raterMock, now.Add(-time.Hour), now)
+
+	rate, err := rates.convertCurrencyAt(context.Background(), "bitcoin", "eur", now)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(30000).Equal(rate))
+
+	_, err = rates.convertCurrencyAt(context.Background(), "bitcoin", "usd", now)
+	require.ErrorIs(t, err, ErrRateNotRecorded)
+
+	_, err = rates.averageRate(context.Background(), "bitcoin", "usd")
+	require.ErrorIs(t, err, ErrRateNotRecorded)
+}
@@ -0,0 +1,476 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// assetPrecision is the number of decimals used by volume and depth
+	//amounts stored as satoshis
+	assetPrecision = 8
+)
+
+type TotalValueLockedService interface {
+	// GetTotalValueLocked returns value locked in markets expressed in
+	//reference currency, grouped per market, provider, asset and in total
+	//if timeRange is nil only the latest snapshot is returned, valued with
+	//current rates, otherwise one snapshot for every timeFrame bucket in the
+	//time range, each valued with the rates recorded closest to its time
+	GetTotalValueLocked(
+		ctx context.Context,
+		timeRange *TimeRange,
+		timeFrame TimeFrame,
+		referenceCurrency string,
+		marketIDs ...string,
+	) ([]TotalValueLocked, error)
+}
+
+type totalValueLockedService struct {
+	marketBalanceRepository domain.MarketBalanceRepository
+	marketPriceRepository   domain.MarketPriceRepository
+	marketRepository        domain.MarketRepository
+	raterSvc                port.RateService
+	rateRepository          domain.RateRepository
+	// assetPrecisions is the number of decimals of every asset, markets of
+	//assets not included are skipped
+	assetPrecisions map[string]int
+}
+
+func NewTotalValueLockedService(
+	marketBalanceRepository domain.MarketBalanceRepository,
+	marketPriceRepository domain.MarketPriceRepository,
+	marketRepository domain.MarketRepository,
+	raterSvc port.RateService,
+	rateRepository domain.RateRepository,
+	assetPrecisions map[string]int,
+) TotalValueLockedService {
+	return &totalValueLockedService{
+		marketBalanceRepository: marketBalanceRepository,
+		marketPriceRepository:   marketPriceRepository,
+		marketRepository:        marketRepository,
+		raterSvc:                raterSvc,
+		rateRepository:          rateRepository,
+		assetPrecisions:         assetPrecisions,
+	}
+}
+
+func (t *totalValueLockedService) GetTotalValueLocked(
+	ctx context.Context,
+	timeRange *TimeRange,
+	timeFrame TimeFrame,
+	referenceCurrency string,
+	marketIDs ...string,
+) ([]TotalValueLocked, error) {
+	if referenceCurrency == "" {
+		return nil, ErrMissingReferenceCurrency
+	}
+	if err := validateReferenceCurrency(t.raterSvc, referenceCurrency); err != nil {
+		return nil, err
+	}
+
+	allMarkets, err := t.marketRepository.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	markets := marketsWithKnownPrecision(allMarkets, t.assetPrecisions)
+
+	marketsMap := make(map[string]domain.Market)
+	for _, v := range markets {
+		marketsMap[strconv.Itoa(v.ID)] = v
+	}
+
+	if timeRange == nil {
+		return t.getLatestTotalValueLocked(
+			ctx, markets, marketsMap, referenceCurrency, marketIDs...,
+		)
+	}
+
+	return t.getTotalValueLockedSeries(
+		ctx, *timeRange, timeFrame, marketsMap, referenceCurrency, marketIDs...,
+	)
+}
+
+func (t *totalValueLockedService) getLatestTotalValueLocked(
+	ctx context.Context,
+	markets []domain.Market,
+	marketsMap map[string]domain.Market,
+	referenceCurrency string,
+	marketIDs ...string,
+) ([]TotalValueLocked, error) {
+	// stale balances of inactive markets are not locked value anymore
+	if len(marketIDs) == 0 {
+		for _, v := range markets {
+			if v.Active {
+				marketIDs = append(marketIDs, strconv.Itoa(v.ID))
+			}
+		}
+		if len(marketIDs) == 0 {
+			return []TotalValueLocked{}, nil
+		}
+	}
+
+	balances, err := t.marketBalanceRepository.GetLatestBalances(
+		ctx, marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := t.marketPriceRepository.GetLatestPrices(ctx, marketIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotTime := time.Time{}
+	for _, v := range balances {
+		if v.Time.After(snapshotTime) {
+			snapshotTime = v.Time
+		}
+	}
+
+	// without recorded rates the current ones are used
+	rates := newAssetRates(
+		t.raterSvc,
+		newRateHistory(nil, t.raterSvc, snapshotTime, snapshotTime),
+		referenceCurrency,
+	)
+	tvl := calculateTotalValueLocked(
+		ctx, rates, t.assetPrecisions, marketsMap, balances, prices, snapshotTime,
+	)
+
+	return []TotalValueLocked{tvl}, nil
+}
+
+func (t *totalValueLockedService) getTotalValueLockedSeries(
+	ctx context.Context,
+	timeRange TimeRange,
+	timeFrame TimeFrame,
+	marketsMap map[string]domain.Market,
+	referenceCurrency string,
+	marketIDs ...string,
+) ([]TotalValueLocked, error) {
+	startTime, endTime, err := timeRange.getStartAndEndTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if timeFrame == TzNil {
+		return nil, ErrMissingTimeFrame
+	}
+
+	if err := timeFrame.validate(); err != nil {
+		return nil, err
+	}
+
+	if int(endTime.Sub(startTime).Minutes()) <= timeFrame.toMinutes() {
+		return nil, ErrInvalidTimeFrame
+	}
+
+	// one page must contain all the buckets of the time range so that every
+	//snapshot is built from all markets
+	numOfBuckets := int(endTime.Sub(startTime).Minutes())/timeFrame.toMinutes() + 2
+	page := domain.NewPage(1, numOfBuckets)
+
+	marketsBalances, err := t.marketBalanceRepository.GetBalancesForMarkets(
+		ctx,
+		startTime,
+		endTime,
+		page,
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsPrices, err := t.marketPriceRepository.GetPricesForMarkets(
+		ctx,
+		startTime,
+		endTime,
+		page,
+		timeFrame.toFluxDuration(),
+		marketIDs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	balancesPerTime := make(map[time.Time]map[string]domain.MarketBalance)
+	for k, v := range marketsBalances {
+		for _, v1 := range v {
+			if _, ok := balancesPerTime[v1.Time]; !ok {
+				balancesPerTime[v1.Time] = make(map[string]domain.MarketBalance)
+			}
+			balancesPerTime[v1.Time][k] = v1
+		}
+	}
+
+	times := make([]time.Time, 0, len(balancesPerTime))
+	for k := range balancesPerTime {
+		times = append(times, k)
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+
+	rates := newAssetRates(
+		t.raterSvc,
+		newRateHistory(t.rateRepository, t.raterSvc, startTime, endTime),
+		referenceCurrency,
+	)
+	result := make([]TotalValueLocked, 0, len(times))
+	for _, v := range times {
+		balances := balancesPerTime[v]
+		prices := make(map[string]domain.MarketPrice)
+		for k := range balances {
+			if price, ok := lastPriceAt(marketsPrices[k], v); ok {
+				prices[k] = price
+			}
+		}
+
+		result = append(result, calculateTotalValueLocked(
+			ctx, rates, t.assetPrecisions, marketsMap, balances, prices, v,
+		))
+	}
+
+	return result, nil
+}
+
+// lastPriceAt returns the most recent price not after the given time, prices
+// are expected to be sorted by time ASC
+func lastPriceAt(
+	prices []domain.MarketPrice,
+	at time.Time,
+) (domain.MarketPrice, bool) {
+	var (
+		price domain.MarketPrice
+		found bool
+	)
+	for _, v := range prices {
+		if v.Time.After(at) {
+			break
+		}
+		price = v
+		found = true
+	}
+
+	return price, found
+}
+
+// marketsWithKnownPrecision returns the markets whose assets have a known
+// precision, the others can't be valued and are skipped
+func marketsWithKnownPrecision(
+	markets []domain.Market,
+	precisions map[string]int,
+) []domain.Market {
+	result := make([]domain.Market, 0, len(markets))
+	for _, v := range markets {
+		_, baseOk := precisions[v.BaseAsset]
+		_, quoteOk := precisions[v.QuoteAsset]
+		if !baseOk || !quoteOk {
+			log.Warnf(
+				"skipping value locked in market %v, precision of its assets unknown",
+				v.ID,
+			)
+			continue
+		}
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// calculateTotalValueLocked values the balances of the markets of marketsMap,
+// the ones of other markets are skipped
+func calculateTotalValueLocked(
+	ctx context.Context,
+	rates *assetRates,
+	precisions map[string]int,
+	marketsMap map[string]domain.Market,
+	balances map[string]domain.MarketBalance,
+	prices map[string]domain.MarketPrice,
+	snapshotTime time.Time,
+) TotalValueLocked {
+	total := decimal.Zero
+	marketsValues := make([]MarketValueLocked, 0, len(balances))
+	providersValues := make(map[string]decimal.Decimal)
+	assetsValues := make(map[string]AssetValueLocked)
+
+	for k, v := range balances {
+		market, ok := marketsMap[k]
+		if !ok {
+			continue
+		}
+		baseRate, quoteRate := rates.getMarketRates(
+			ctx, market.BaseAsset, market.QuoteAsset, prices[k].QuotePrice,
+			snapshotTime,
+		)
+
+		baseAmount := v.BaseBalance.Shift(-int32(precisions[market.BaseAsset]))
+		quoteAmount := v.QuoteBalance.Shift(-int32(precisions[market.QuoteAsset]))
+		baseValue := baseAmount.Mul(baseRate)
+		quoteValue := quoteAmount.Mul(quoteRate)
+		marketTotal := baseValue.Add(quoteValue)
+
+		marketsValues = append(marketsValues, MarketValueLocked{
+			MarketID:     k,
+			ProviderName: market.ProviderName,
+			BaseAsset:    market.BaseAsset,
+			BaseAmount:   baseAmount,
+			BaseValue:    baseValue.Round(2),
+			QuoteAsset:   market.QuoteAsset,
+			QuoteAmount:  quoteAmount,
+			QuoteValue:   quoteValue.Round(2),
+			Total:        marketTotal.Round(2),
+		})
+
+		providersValues[market.ProviderName] =
+			providersValues[market.ProviderName].Add(marketTotal)
+
+		for _, a := range []struct {
+			asset  string
+			amount decimal.Decimal
+			value  decimal.Decimal
+		}{
+			{market.BaseAsset, baseAmount, baseValue},
+			{market.QuoteAsset, quoteAmount, quoteValue},
+		} {
+			assetValue := assetsValues[a.asset]
+			assetValue.Asset = a.asset
+			assetValue.Amount = assetValue.Amount.Add(a.amount)
+			assetValue.Value = assetValue.Value.Add(a.value)
+			assetsValues[a.asset] = assetValue
+		}
+
+		total = total.Add(marketTotal)
+	}
+
+	sort.Slice(marketsValues, func(i, j int) bool {
+		a, _ := strconv.Atoi(marketsValues[i].MarketID)
+		b, _ := strconv.Atoi(marketsValues[j].MarketID)
+		return a < b
+	})
+
+	providers := make([]ProviderValueLocked, 0, len(providersValues))
+	for k, v := range providersValues {
+		providers = append(providers, ProviderValueLocked{
+			ProviderName: k,
+			Total:        v.Round(2),
+		})
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].ProviderName < providers[j].ProviderName
+	})
+
+	assets := make([]AssetValueLocked, 0, len(assetsValues))
+	for _, v := range assetsValues {
+		v.Value = v.Value.Round(2)
+		assets = append(assets, v)
+	}
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Asset < assets[j].Asset
+	})
+
+	return TotalValueLocked{
+		ReferenceCurrency: rates.referenceCurrency,
+		Total:             total.Round(2),
+		Markets:           marketsValues,
+		Providers:         providers,
+		Assets:            assets,
+		Time:              snapshotTime,
+	}
+}
+
+// assetRates caches the value of one unit of an asset in reference currency
+// at a given time for the duration of a single request
+type assetRates struct {
+	raterSvc          port.RateService
+	history           *rateHistory
+	referenceCurrency string
+	rates             map[assetRateKey]*decimal.Decimal
+}
+
+type assetRateKey struct {
+	asset string
+	at    int64
+}
+
+func newAssetRates(
+	raterSvc port.RateService,
+	history *rateHistory,
+	referenceCurrency string,
+) *assetRates {
+	return &assetRates{
+		raterSvc:          raterSvc,
+		history:           history,
+		referenceCurrency: referenceCurrency,
+		rates:             make(map[assetRateKey]*decimal.Decimal),
+	}
+}
+
+// getAssetRate returns the value of one unit of asset in reference currency
+// at the given time and false if the asset currency is unknown to the rater
+func (a *assetRates) getAssetRate(
+	ctx context.Context,
+	asset string,
+	at time.Time,
+) (decimal.Decimal, bool) {
+	key := assetRateKey{asset, at.UnixNano()}
+	if rate, ok := a.rates[key]; ok {
+		if rate == nil {
+			return decimal.Zero, false
+		}
+		return *rate, true
+	}
+
+	ticker, err := a.raterSvc.GetAssetCurrency(asset)
+	if err != nil {
+		a.rates[key] = nil
+		return decimal.Zero, false
+	}
+
+	rate, err := a.history.convertCurrencyAt(ctx, ticker, a.referenceCurrency, at)
+	if err != nil || rate.IsZero() {
+		log.Debugf("GetTotalValueLocked -> convertCurrencyAt %s: %v", ticker, err)
+		a.rates[key] = nil
+		return decimal.Zero, false
+	}
+
+	a.rates[key] = &rate
+	return rate, true
+}
+
+// getMarketRates returns the value of one unit of base and quote asset in
+// reference currency at the given time, if only one of the two is known to
+// the rater the other one is derived from the market quote price
+func (a *assetRates) getMarketRates(
+	ctx context.Context,
+	baseAsset, quoteAsset string,
+	quotePrice decimal.Decimal,
+	at time.Time,
+) (decimal.Decimal, decimal.Decimal) {
+	baseRate, baseFound := a.getAssetRate(ctx, baseAsset, at)
+	quoteRate, quoteFound := a.getAssetRate(ctx, quoteAsset, at)
+
+	if quotePrice.IsZero() {
+		return baseRate, quoteRate
+	}
+
+	if !baseFound && quoteFound {
+		baseRate = quoteRate.Mul(quotePrice)
+	}
+	if baseFound && !quoteFound {
+		quoteRate = baseRate.Div(quotePrice)
+	}
+
+	return baseRate, quoteRate
+}
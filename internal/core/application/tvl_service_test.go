@@ -0,0 +1,184 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+)
+
+func TestCalculateTotalValueLocked(t *testing.T) {
+	raterMock := new(port.MockRateService)
+	raterMock.On("GetAssetCurrency", "LBTC").Return("LBTC", nil)
+	raterMock.On("GetAssetCurrency", "USDT").Return("USDT", nil)
+	raterMock.On("GetAssetCurrency", "UNKNOWN").Return("", port.ErrCurrencyNotFound)
+	raterMock.On("ConvertCurrency", mock.Anything, "LBTC", "EUR").Return(decimal.NewFromInt(30000), nil)
+	raterMock.On("ConvertCurrency", mock.Anything, "USDT", "EUR").Return(decimal.Zero, errors.New("rate not available"))
+
+	marketsMap := map[string]domain.Market{
+		"1": {ID: 1, ProviderName: "provider-a", BaseAsset: "LBTC", QuoteAsset: "USDT"},
+		"2": {ID: 2, ProviderName: "provider-b", BaseAsset: "LBTC", QuoteAsset: "USDT"},
+		"3": {ID: 3, ProviderName: "provider-b", BaseAsset: "UNKNOWN", QuoteAsset: "LBTC"},
+	}
+	balances := map[string]domain.MarketBalance{
+		"1": {MarketID: "1", BaseBalance: decimal.NewFromInt(100000000), QuoteBalance: decimal.NewFromInt(3000000000000)},
+		"2": {MarketID: "2", BaseBalance: decimal.NewFromInt(50000000), QuoteBalance: decimal.NewFromInt(1500000000000)},
+		"3": {MarketID: "3", BaseBalance: decimal.NewFromInt(1000000000), QuoteBalance: decimal.NewFromInt(10000000)},
+	}
+	prices := map[string]domain.MarketPrice{
+		"1": {MarketID: "1", QuotePrice: decimal.NewFromInt(30000)},
+		"2": {MarketID: "2", QuotePrice: decimal.NewFromInt(30000)},
+		"3": {MarketID: "3", QuotePrice: decimal.NewFromFloat(0.001)},
+	}
+	now := time.Now()
+
+	tvl := calculateTotalValueLocked(
+		context.Background(),
+		newAssetRates(raterMock, newRateHistory(nil, raterMock, now, now), "EUR"),
+		map[string]int{"LBTC": 8, "USDT": 8, "UNKNOWN": 8},
+		marketsMap,
+		balances,
+		prices,
+		now,
+	)
+
+	// market 1: 1 LBTC + 30000 USDT, USDT rate derived from LBTC price
+	// market 2: 0.5 LBTC + 15000 USDT
+	// market 3: 10 UNKNOWN + 0.1 LBTC, UNKNOWN rate derived from LBTC
+	require.Equal(t, "EUR", tvl.ReferenceCurrency)
+	require.Equal(t, now, tvl.Time)
+	require.Len(t, tvl.Markets, 3)
+	require.Equal(t, []string{"1", "2", "3"}, []string{
+		tvl.Markets[0].MarketID, tvl.Markets[1].MarketID, tvl.Markets[2].MarketID,
+	})
+	require.True(t, decimal.NewFromInt(60000).Equal(tvl.Markets[0].Total))
+	require.True(t, decimal.NewFromInt(30000).Equal(tvl.Markets[1].Total))
+	require.True(t, decimal.NewFromInt(3300).Equal(tvl.Markets[2].Total))
+	require.True(t, decimal.NewFromInt(93300).Equal(tvl.Total))
+
+	require.Len(t, tvl.Providers, 2)
+	require.Equal(t, "provider-a", tvl.Providers[0].ProviderName)
+	require.True(t, decimal.NewFromInt(60000).Equal(tvl.Providers[0].Total))
+	require.Equal(t, "provider-b", tvl.Providers[1].ProviderName)
+	require.True(t, decimal.NewFromInt(33300).Equal(tvl.Providers[1].Total))
+
+	require.Len(t, tvl.Assets, 3)
+	for _, v := range tvl.Assets {
+		switch v.Asset {
+		case "LBTC":
+			require.True(t, decimal.NewFromFloat(1.6).Equal(v.Amount))
+			require.True(t, decimal.NewFromInt(48000).Equal(v.Value))
+		case "USDT":
+			require.True(t, decimal.NewFromInt(45000).Equal(v.Amount))
+			require.True(t, decimal.NewFromInt(45000).Equal(v.Value))
+		case "UNKNOWN":
+			require.True(t, decimal.NewFromInt(10).Equal(v.Amount))
+			require.True(t, decimal.NewFromInt(300).Equal(v.Value))
+		}
+	}
+}
+
+func TestCalculateTotalValueLockedAtRecordedRates(t *testing.T) {
+	// ConvertCurrency is not mocked, current rates must not be used
+	raterMock := new(port.MockRateService)
+	raterMock.On("GetAssetCurrency", "LBTC").Return("LBTC", nil)
+
+	now := time.Now()
+	rates := newAssetRates(
+		raterMock,
+		newRateHistory(rateRepositoryStub{
+			{"LBTC", "EUR"}: {
+				{Value: decimal.NewFromInt(20000), Time: now.Add(-2 * time.Hour)},
+				{Value: decimal.NewFromInt(30000), Time: now},
+			},
+		}, raterMock, now.Add(-2*time.Hour), now),
+		"EUR",
+	)
+
+	marketsMap := map[string]domain.Market{
+		"1": {ID: 1, ProviderName: "provider-a", BaseAsset: "LBTC", QuoteAsset: "LBTC"},
+	}
+	balances := map[string]domain.MarketBalance{
+		"1": {MarketID: "1", BaseBalance: decimal.NewFromInt(100000000)},
+	}
+
+	for _, tt := range []struct {
+		at            time.Time
+		expectedTotal decimal.Decimal
+	}{
+		{now.Add(-2 * time.Hour), decimal.NewFromInt(20000)},
+		{now, decimal.NewFromInt(30000)},
+	} {
+		tvl := calculateTotalValueLocked(
+			context.Background(), rates, map[string]int{"LBTC": 8},
+			marketsMap, balances, nil, tt.at,
+		)
+		require.True(t, tt.expectedTotal.Equal(tvl.Total), tvl.Total.String())
+	}
+}
+
+func TestCalculateTotalValueLockedAssetPrecision(t *testing.T) {
+	raterMock := new(port.MockRateService)
+	raterMock.On("GetAssetCurrency", "LBTC").Return("LBTC", nil)
+	raterMock.On("GetAssetCurrency", "LCAD").Return("CAD", nil)
+	raterMock.On("ConvertCurrency", mock.Anything, "LBTC", "EUR").Return(decimal.NewFromInt(30000), nil)
+	raterMock.On("ConvertCurrency", mock.Anything, "CAD", "EUR").Return(decimal.NewFromFloat(0.5), nil)
+	now := time.Now()
+
+	marketsMap := map[string]domain.Market{
+		"1": {ID: 1, ProviderName: "provider-a", BaseAsset: "LBTC", QuoteAsset: "LCAD"},
+	}
+	balances := map[string]domain.MarketBalance{
+		"1": {MarketID: "1", BaseBalance: decimal.NewFromInt(100000000), QuoteBalance: decimal.NewFromInt(4000000)},
+		// balances of markets not valued are skipped
+		"2": {MarketID: "2", BaseBalance: decimal.NewFromInt(100000000)},
+	}
+
+	tvl := calculateTotalValueLocked(
+		context.Background(),
+		newAssetRates(raterMock, newRateHistory(nil, raterMock, now, now), "EUR"),
+		map[string]int{"LBTC": 8, "LCAD": 2},
+		marketsMap,
+		balances,
+		nil,
+		now,
+	)
+
+	// 1 LBTC + 40000 LCAD
+	require.Len(t, tvl.Markets, 1)
+	require.True(t, decimal.NewFromInt(40000).Equal(tvl.Markets[0].QuoteAmount))
+	require.True(t, decimal.NewFromInt(50000).Equal(tvl.Total), tvl.Total.String())
+}
+
+func TestMarketsWithKnownPrecision(t *testing.T) {
+	markets := []domain.Market{
+		{ID: 1, BaseAsset: "LBTC", QuoteAsset: "USDT"},
+		{ID: 2, BaseAsset: "LBTC", QuoteAsset: "UNKNOWN"},
+		{ID: 3, BaseAsset: "UNKNOWN", QuoteAsset: "USDT"},
+	}
+
+	got := marketsWithKnownPrecision(markets, map[string]int{"LBTC": 8, "USDT": 8})
+	require.Equal(t, markets[:1], got)
+}
+
+func TestLastPriceAt(t *testing.T) {
+	now := time.Now()
+	prices := []domain.MarketPrice{
+		{QuotePrice: decimal.NewFromInt(1), Time: now.Add(-2 * time.Hour)},
+		{QuotePrice: decimal.NewFromInt(2), Time: now.Add(-time.Hour)},
+		{QuotePrice: decimal.NewFromInt(3), Time: now.Add(time.Hour)},
+	}
+
+	price, ok := lastPriceAt(prices, now)
+	require.True(t, ok)
+	require.True(t, decimal.NewFromInt(2).Equal(price.QuotePrice))
+
+	_, ok = lastPriceAt(prices, now.Add(-3*time.Hour))
+	require.False(t, ok)
+}
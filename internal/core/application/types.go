@@ -9,6 +9,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
 	"github.com/tdex-network/tdex-analytics/pkg/hexerr"
+	"github.com/tdex-network/tdex-analytics/pkg/webhook"
 	"time"
 )
 
@@ -43,6 +44,8 @@ type MarketBalance struct {
 	QuoteBalance decimal.Decimal
 	QuoteAsset   string
 	Time         time.Time
+	// SnapshotID is set if fetched together with the market price
+	SnapshotID string
 }
 
 func (m *MarketBalance) validate() error {
@@ -65,6 +68,7 @@ func (m *MarketBalance) toDomain() (*domain.MarketBalance, error) {
 		QuoteBalance: m.QuoteBalance,
 		QuoteAsset:   m.QuoteAsset,
 		Time:         m.Time,
+		SnapshotID:   m.SnapshotID,
 	}, nil
 }
 
@@ -75,6 +79,8 @@ type MarketPrice struct {
 	QuotePrice decimal.Decimal
 	QuoteAsset string
 	Time       time.Time
+	// SnapshotID is set if fetched together with the market balance
+	SnapshotID string
 }
 
 func (m *MarketPrice) validate() error {
@@ -97,6 +103,7 @@ func (m *MarketPrice) toDomain() (*domain.MarketPrice, error) {
 		QuotePrice: m.QuotePrice,
 		QuoteAsset: m.QuoteAsset,
 		Time:       m.Time,
+		SnapshotID: m.SnapshotID,
 	}, nil
 }
 
@@ -134,6 +141,14 @@ func validateTimeFormat(t interface{}) error {
 	return nil
 }
 
+// MarketUpdate is either a price or a balance of a market recorded by fetch
+// jobs
+type MarketUpdate struct {
+	MarketID string
+	Price    *MarketPrice
+	Balance  *MarketBalance
+}
+
 type MarketsBalances struct {
 	//market_id and its Balances
 	MarketsBalances map[string][]Balance
@@ -163,6 +178,234 @@ type Price struct {
 	Time               time.Time
 }
 
+type MarketsCandles struct {
+	//market_id and its Candles
+	MarketsCandles map[string][]Candle
+}
+
+type Candle struct {
+	BaseAsset     string
+	QuoteAsset    string
+	Open          decimal.Decimal
+	High          decimal.Decimal
+	Low           decimal.Decimal
+	Close         decimal.Decimal
+	ReferentOpen  decimal.Decimal
+	ReferentHigh  decimal.Decimal
+	ReferentLow   decimal.Decimal
+	ReferentClose decimal.Decimal
+	Time          time.Time
+}
+
+type TotalValueLocked struct {
+	ReferenceCurrency string
+	Total             decimal.Decimal
+	Markets           []MarketValueLocked
+	Providers         []ProviderValueLocked
+	Assets            []AssetValueLocked
+	Time              time.Time
+}
+
+type MarketValueLocked struct {
+	MarketID     string
+	ProviderName string
+	BaseAsset    string
+	BaseAmount   decimal.Decimal
+	BaseValue    decimal.Decimal
+	QuoteAsset   string
+	QuoteAmount  decimal.Decimal
+	QuoteValue   decimal.Decimal
+	Total        decimal.Decimal
+}
+
+type ProviderValueLocked struct {
+	ProviderName string
+	Total        decimal.Decimal
+}
+
+type AssetValueLocked struct {
+	Asset  string
+	Amount decimal.Decimal
+	Value  decimal.Decimal
+}
+
+type MarketsVolumes struct {
+	//market_id and its Volumes
+	MarketsVolumes map[string][]Volume
+}
+
+type Volume struct {
+	BaseVolume  decimal.Decimal
+	BaseAsset   string
+	QuoteVolume decimal.Decimal
+	QuoteAsset  string
+	TradeCount  int
+	Vwap        decimal.Decimal
+	Time        time.Time
+}
+
+type PairSpreads struct {
+	BaseAsset  string
+	QuoteAsset string
+	MarketIDs  []string
+	Spreads    []Spread
+}
+
+type Spread struct {
+	BestBid         decimal.Decimal
+	BestBidMarketID string
+	BestAsk         decimal.Decimal
+	BestAskMarketID string
+	Spread          decimal.Decimal
+	SpreadBps       decimal.Decimal
+	Arbitrage       bool
+	Time            time.Time
+}
+
+type MarketsDeviations struct {
+	//market_id and its Deviations
+	MarketsDeviations map[string]MarketDeviations
+}
+
+type MarketDeviations struct {
+	BaseAsset                  string
+	QuoteAsset                 string
+	Deviations                 []Deviation
+	MeanBps                    decimal.Decimal
+	MaxBps                     decimal.Decimal
+	OutsideTolerancePercentage decimal.Decimal
+}
+
+type Deviation struct {
+	Price          decimal.Decimal
+	ReferencePrice decimal.Decimal
+	DeviationBps   decimal.Decimal
+	Time           time.Time
+}
+
+type MarketsFees struct {
+	//market_id and its Fees
+	MarketsFees map[string][]Fee
+}
+
+type Fee struct {
+	BaseAsset          string
+	QuoteAsset         string
+	BasePercentageFee  decimal.Decimal
+	QuotePercentageFee decimal.Decimal
+	BaseFixedFee       decimal.Decimal
+	QuoteFixedFee      decimal.Decimal
+	PreviewFeeAmount   decimal.Decimal
+	PreviewFeeAsset    string
+	Time               time.Time
+}
+
+type MarketsUptime struct {
+	//market_id and its Uptime
+	MarketsUptime map[string]MarketUptime
+	//provider url and its Uptime
+	ProvidersUptime map[string]ProviderUptime
+}
+
+type MarketUptime struct {
+	ProviderName string
+	Url          string
+	BaseAsset    string
+	QuoteAsset   string
+	Uptime
+}
+
+type ProviderUptime struct {
+	ProviderName string
+	Uptime
+}
+
+// Uptime is the availability in a time range, UptimePercentage and Outages
+// are measured from the later of the start of the range and FirstSeen
+type Uptime struct {
+	UptimePercentage decimal.Decimal
+	Outages          []Outage
+	// FirstSeen is zero if the market was known before lifecycle events were
+	//recorded
+	FirstSeen time.Time
+	// LastSeen is the end of the range if the market was active then
+	LastSeen time.Time
+}
+
+type Outage struct {
+	Start time.Time
+	End   time.Time
+}
+
+type MarketsStatus struct {
+	MarketsStatus map[string]MarketStatus
+	// ProvidersCircuitBreaker are, by provider url, the circuit breakers of
+	//providers of the markets that failed since they last answered
+	ProvidersCircuitBreaker map[string]CircuitBreaker
+}
+
+type MarketStatus struct {
+	ProviderName string
+	Url          string
+	BaseAsset    string
+	QuoteAsset   string
+	Active       bool
+	// FetchStatuses has an entry for every job that ran for the market
+	FetchStatuses []FetchStatus
+}
+
+type FetchStatus struct {
+	Job         string
+	LastAttempt time.Time
+	LastSuccess time.Time
+	LastError   string
+	// LastErrorType is tls if the provider certificate failed verification
+	LastErrorType       string
+	LastErrorTime       time.Time
+	ConsecutiveFailures int
+	// FailingSince is zero if the last attempt succeeded
+	FailingSince time.Time
+	// Protocol is the protocol path used by the last successful fetch
+	Protocol string
+}
+
+type CircuitBreaker struct {
+	State               string
+	ConsecutiveFailures int
+	LastError           string
+	// RetryAt is when the provider is probed again, zero if the breaker is
+	//not open
+	RetryAt time.Time
+}
+
+type MarketsDepth struct {
+	//market_id and its depth curves
+	MarketsDepth map[string]MarketDepth
+}
+
+type MarketDepth struct {
+	BaseAsset  string
+	QuoteAsset string
+	Curves     []DepthCurve
+}
+
+type DepthCurve struct {
+	// MidPrice is the mean of buy and sell prices of the smallest amount,
+	//slippage of every level is relative to it
+	MidPrice decimal.Decimal
+	Levels   []DepthLevel
+	Time     time.Time
+}
+
+type DepthLevel struct {
+	// Amount of base asset bought or sold
+	Amount          decimal.Decimal
+	BuyPrice        decimal.Decimal
+	BuySlippageBps  decimal.Decimal
+	SellPrice       decimal.Decimal
+	SellSlippageBps decimal.Decimal
+}
+
 type AveragePriceInfo struct {
 	MarketIDs            []string
 	AveragePrice         decimal.Decimal
@@ -344,6 +587,27 @@ func (t *TimeFrame) toMinutes() int {
 	}
 }
 
+// bucketStart returns the start of the timeFrame bucket the given time
+// belongs to
+func (t *TimeFrame) bucketStart(tm time.Time) time.Time {
+	tm = tm.UTC()
+	switch *t {
+	case TimeFrameHour:
+		return tm.Truncate(time.Hour)
+	case TimeFrameFourHours:
+		return tm.Truncate(4 * time.Hour)
+	case TimeFrameDay:
+		return time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, time.UTC)
+	case TimeFrameWeek:
+		day := time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case TimeFrameMonth:
+		return time.Date(tm.Year(), tm.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return tm
+	}
+}
+
 func (t *TimeFrame) toFluxDuration() string {
 	switch *t {
 	case TimeFrameHour:
@@ -360,3 +624,146 @@ func (t *TimeFrame) toFluxDuration() string {
 		return ""
 	}
 }
+
+const (
+	AlertRuleBalanceBelow   = domain.AlertRuleBalanceBelow
+	AlertRulePriceChange    = domain.AlertRulePriceChange
+	AlertRuleMarketInactive = domain.AlertRuleMarketInactive
+	AlertAssetBase          = domain.AlertAssetBase
+	AlertAssetQuote         = domain.AlertAssetQuote
+)
+
+type AlertRule struct {
+	ID            int
+	MarketID      string
+	Type          string
+	Asset         string
+	Threshold     decimal.Decimal
+	WindowMinutes int
+	WebhookUrl    string
+	Secret        string
+	Triggered     bool
+	CreatedAt     time.Time
+}
+
+func (a *AlertRule) validate() error {
+	if err := validation.ValidateStruct(
+		a,
+		validation.Field(&a.MarketID, validation.Required, is.Int),
+		validation.Field(
+			&a.Type,
+			validation.Required,
+			validation.In(
+				domain.AlertRuleBalanceBelow,
+				domain.AlertRulePriceChange,
+				domain.AlertRuleMarketInactive,
+			),
+		),
+		validation.Field(
+			&a.Asset,
+			validation.When(
+				a.Type == domain.AlertRuleBalanceBelow,
+				validation.Required,
+				validation.In(domain.AlertAssetBase, domain.AlertAssetQuote),
+			),
+		),
+		validation.Field(
+			&a.WindowMinutes,
+			validation.When(
+				a.Type == domain.AlertRulePriceChange,
+				validation.Required,
+				validation.Min(1),
+			),
+		),
+		validation.Field(
+			&a.WebhookUrl,
+			validation.Required,
+			is.URL,
+			validation.By(validateWebhookUrl),
+		),
+		validation.Field(&a.Secret, validation.Required),
+	); err != nil {
+		return hexerr.NewApplicationLayerError(hexerr.InvalidRequest, err.Error())
+	}
+
+	switch a.Type {
+	case domain.AlertRuleBalanceBelow:
+		if a.Threshold.IsNegative() {
+			return hexerr.NewApplicationLayerError(
+				hexerr.InvalidRequest,
+				ErrInvalidAlertThreshold.Error(),
+			)
+		}
+	case domain.AlertRulePriceChange:
+		if !a.Threshold.IsPositive() {
+			return hexerr.NewApplicationLayerError(
+				hexerr.InvalidRequest,
+				ErrInvalidAlertThreshold.Error(),
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateWebhookUrl(rawUrl interface{}) error {
+	u, ok := rawUrl.(string)
+	if !ok {
+		return errors.New("must be a valid url string")
+	}
+
+	return webhook.ValidateUrl(u)
+}
+
+func (a *AlertRule) toDomain() domain.AlertRule {
+	return domain.AlertRule{
+		ID:            a.ID,
+		MarketID:      a.MarketID,
+		Type:          a.Type,
+		Asset:         a.Asset,
+		Threshold:     a.Threshold,
+		WindowMinutes: a.WindowMinutes,
+		WebhookUrl:    a.WebhookUrl,
+		Secret:        a.Secret,
+		Triggered:     a.Triggered,
+		CreatedAt:     a.CreatedAt,
+	}
+}
+
+func alertRuleFromDomain(rule domain.AlertRule) AlertRule {
+	return AlertRule{
+		ID:            rule.ID,
+		MarketID:      rule.MarketID,
+		Type:          rule.Type,
+		Asset:         rule.Asset,
+		Threshold:     rule.Threshold,
+		WindowMinutes: rule.WindowMinutes,
+		WebhookUrl:    rule.WebhookUrl,
+		Secret:        rule.Secret,
+		Triggered:     rule.Triggered,
+		CreatedAt:     rule.CreatedAt,
+	}
+}
+
+type AlertDelivery struct {
+	ID         int
+	RuleID     int
+	Payload    string
+	Attempts   int
+	StatusCode int
+	Error      string
+	Delivered  bool
+	Time       time.Time
+}
+
+type LeaderStatus struct {
+	// NodeID is the id of the node answering
+	NodeID   string
+	IsLeader bool
+	// LeaderNodeID is empty if no node holds the leadership
+	LeaderNodeID    string
+	LeaderElectedAt time.Time
+	// LeaderRenewedAt is the last time the leader confirmed it holds the
+	//leadership
+	LeaderRenewedAt time.Time
+}
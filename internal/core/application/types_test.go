@@ -269,3 +269,46 @@ func TestMarketRequest_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeFrame_bucketStart(t *testing.T) {
+	// Thursday
+	tm := time.Date(2023, 6, 15, 13, 45, 10, 0, time.UTC)
+	tests := []struct {
+		name      string
+		timeFrame TimeFrame
+		want      time.Time
+	}{
+		{
+			name:      "hour",
+			timeFrame: TimeFrameHour,
+			want:      time.Date(2023, 6, 15, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "four hours",
+			timeFrame: TimeFrameFourHours,
+			want:      time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "day",
+			timeFrame: TimeFrameDay,
+			want:      time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "week",
+			timeFrame: TimeFrameWeek,
+			want:      time.Date(2023, 6, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "month",
+			timeFrame: TimeFrameMonth,
+			want:      time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.timeFrame.bucketStart(tm); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bucketStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	// AlertRuleBalanceBelow triggers when Asset balance of the market drops
+	//below Threshold satoshis
+	AlertRuleBalanceBelow = "balance_below"
+	// AlertRulePriceChange triggers when quote price of the market moves more
+	//than Threshold percent in WindowMinutes
+	AlertRulePriceChange = "price_change"
+	// AlertRuleMarketInactive triggers when the market becomes inactive
+	AlertRuleMarketInactive = "market_inactive"
+
+	AlertAssetBase  = "base"
+	AlertAssetQuote = "quote"
+)
+
+// AlertRule is a condition over market data notified with a webhook, signed
+// with Secret, every time it starts being met
+type AlertRule struct {
+	ID            int
+	MarketID      string
+	Type          string
+	Asset         string
+	Threshold     decimal.Decimal
+	WindowMinutes int
+	WebhookUrl    string
+	Secret        string
+	// Triggered is whether the condition was met last time the rule was
+	//evaluated, so that it is notified only once until it stops being met
+	Triggered bool
+	CreatedAt time.Time
+}
+
+// AlertDelivery is the outcome of the delivery of an alert webhook
+type AlertDelivery struct {
+	ID         int
+	RuleID     int
+	Payload    string
+	Attempts   int
+	StatusCode int
+	Error      string
+	Delivered  bool
+	Time       time.Time
+}
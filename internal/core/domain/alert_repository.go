@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+)
+
+type AlertRepository interface {
+	// InsertAlertRule stores rule and returns its id
+	InsertAlertRule(ctx context.Context, rule AlertRule) (int, error)
+	// UpdateAlertRule replaces the rule with the same id, ErrAlertRuleNotFound
+	//is returned if it doesn't exist
+	UpdateAlertRule(ctx context.Context, rule AlertRule) error
+	// UpdateAlertRuleTriggered updates only the triggered state of a rule
+	UpdateAlertRuleTriggered(ctx context.Context, id int, triggered bool) error
+	// DeleteAlertRule deletes a rule together with its deliveries,
+	//ErrAlertRuleNotFound is returned if it doesn't exist
+	DeleteAlertRule(ctx context.Context, id int) error
+	GetAlertRule(ctx context.Context, id int) (*AlertRule, error)
+	// GetAlertRules returns rules sorted by id, of the markets with the passed
+	//ids or of all markets if marketIDs is empty
+	GetAlertRules(ctx context.Context, marketIDs ...string) ([]AlertRule, error)
+	InsertAlertDelivery(ctx context.Context, delivery AlertDelivery) error
+	// GetAlertDeliveries returns deliveries of the rule sorted by time DESC
+	GetAlertDeliveries(
+		ctx context.Context,
+		ruleID int,
+		page Page,
+	) ([]AlertDelivery, error)
+}
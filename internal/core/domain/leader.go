@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Leader is the replica running the jobs ingesting markets data, only one
+// replica at a time is the leader while all of them serve read rpcs
+type Leader struct {
+	NodeID    string
+	ElectedAt time.Time
+	// RenewedAt is the last time the leader confirmed it holds the leadership
+	RenewedAt time.Time
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type LeaderRepository interface {
+	// TryAcquireLeadership makes nodeID the leader unless another node is, it
+	//returns true if nodeID holds the leadership, the leader calls it
+	//periodically to confirm it did not lose it
+	TryAcquireLeadership(ctx context.Context, nodeID string) (bool, error)
+	// ReleaseLeadership gives up the leadership, if held by nodeID
+	ReleaseLeadership(ctx context.Context, nodeID string) error
+	// GetLeader returns the current leader, nil if no node holds the
+	//leadership or the leader did not renew it since the given time
+	GetLeader(ctx context.Context, renewedSince time.Time) (*Leader, error)
+}
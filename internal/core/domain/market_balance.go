@@ -12,4 +12,7 @@ type MarketBalance struct {
 	QuoteBalance decimal.Decimal
 	QuoteAsset   string
 	Time         time.Time
+	// SnapshotID is set if the balance was fetched together with the price
+	//of the market, see MarketSnapshot
+	SnapshotID string
 }
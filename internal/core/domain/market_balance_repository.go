@@ -15,4 +15,9 @@ type MarketBalanceRepository interface {
 		groupBy string,
 		marketIDs ...string,
 	) (map[string][]MarketBalance, error)
+	// GetLatestBalances returns the most recent balance stored for each market
+	GetLatestBalances(
+		ctx context.Context,
+		marketIDs ...string,
+	) (map[string]MarketBalance, error)
 }
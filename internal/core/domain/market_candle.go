@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type MarketCandle struct {
+	MarketID string
+	Open     decimal.Decimal
+	High     decimal.Decimal
+	Low      decimal.Decimal
+	Close    decimal.Decimal
+	Time     time.Time
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	TradeTypeBuy  = "buy"
+	TradeTypeSell = "sell"
+)
+
+// MarketDepth is the effective price, fees included, of buying or selling
+// Amount satoshis of market base asset
+type MarketDepth struct {
+	MarketID  string
+	TradeType string
+	Amount    decimal.Decimal
+	Price     decimal.Decimal
+	Time      time.Time
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type MarketDepthRepository interface {
+	InsertDepth(ctx context.Context, depth []MarketDepth) error
+	// GetDepthForMarkets returns depth levels of each market, if groupBy is
+	//passed prices of the same trade type and amount are averaged in windows
+	//of groupBy duration
+	GetDepthForMarkets(
+		ctx context.Context,
+		startTime time.Time,
+		endTime time.Time,
+		groupBy string,
+		marketIDs ...string,
+	) (map[string][]MarketDepth, error)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// MarketEventDiscovered is recorded when a market is found in the registry
+	//for the first time, markets are active once discovered
+	MarketEventDiscovered = "discovered"
+	// MarketEventActivated is recorded when an inactive market is found again
+	//in the registry
+	MarketEventActivated = "activated"
+	// MarketEventDeactivated is recorded when an active market is not found in
+	//the registry anymore
+	MarketEventDeactivated = "deactivated"
+)
+
+// MarketEvent is a change of the lifecycle of a market noticed by the markets
+// loader
+type MarketEvent struct {
+	MarketID int
+	Type     string
+	Time     time.Time
+}
+
+// IsActivation returns whether the market is active after the event
+func (m MarketEvent) IsActivation() bool {
+	return m.Type == MarketEventDiscovered || m.Type == MarketEventActivated
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type MarketEventRepository interface {
+	InsertMarketEvent(ctx context.Context, event MarketEvent) error
+	// GetMarketEvents returns events recorded before endTime sorted by time
+	//ASC, of the markets with the passed ids or of all markets if marketIDs is
+	//empty
+	GetMarketEvents(
+		ctx context.Context,
+		endTime time.Time,
+		marketIDs ...int,
+	) ([]MarketEvent, error)
+}
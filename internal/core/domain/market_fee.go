@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// MarketFee is the fee charged by a market, percentage fees are expressed in
+// basis points and fixed fees in satoshis of the respective asset
+type MarketFee struct {
+	MarketID           string
+	BasePercentageFee  decimal.Decimal
+	QuotePercentageFee decimal.Decimal
+	BaseFixedFee       decimal.Decimal
+	QuoteFixedFee      decimal.Decimal
+	PreviewFeeAmount   decimal.Decimal
+	PreviewFeeAsset    string
+	Time               time.Time
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type MarketFeeRepository interface {
+	InsertFee(ctx context.Context, fee MarketFee) error
+	GetFeesForMarkets(
+		ctx context.Context,
+		startTime time.Time,
+		endTime time.Time,
+		page Page,
+		groupBy string,
+		marketIDs ...string,
+	) (map[string][]MarketFee, error)
+}
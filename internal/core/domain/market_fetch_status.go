@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	FetchJobPrice   = "price"
+	FetchJobBalance = "balance"
+	// FetchJobSnapshot fetches price and balance together, its outcome is
+	//recorded as the one of both FetchJobPrice and FetchJobBalance
+	FetchJobSnapshot = "snapshot"
+
+	// FetchErrorTLS is the type of the errors due to the provider certificate
+	//failing verification
+	FetchErrorTLS = "tls"
+	// FetchErrorOther is the type of any other error
+	FetchErrorOther = "other"
+)
+
+// MarketFetchStatus is the outcome of the last runs of a fetch job for a
+// market
+type MarketFetchStatus struct {
+	MarketID    int
+	Job         string
+	LastAttempt time.Time
+	LastSuccess time.Time
+	LastError   string
+	// LastErrorType is one of FetchErrorTLS or FetchErrorOther
+	LastErrorType       string
+	LastErrorTime       time.Time
+	ConsecutiveFailures int
+	// FailingSince is the time of the first of the consecutive failures, zero
+	//if the last attempt succeeded
+	FailingSince time.Time
+	// Protocol is the protocol path the last successful fetch used
+	Protocol string
+}
+
+// RecordSuccess updates the status after a successful fetch
+func (m *MarketFetchStatus) RecordSuccess(protocol string, t time.Time) {
+	m.LastAttempt = t
+	m.LastSuccess = t
+	m.ConsecutiveFailures = 0
+	m.FailingSince = time.Time{}
+	if protocol != "" {
+		m.Protocol = protocol
+	}
+}
+
+// RecordFailure updates the status after a failed fetch
+func (m *MarketFetchStatus) RecordFailure(errType, err string, t time.Time) {
+	if m.ConsecutiveFailures == 0 {
+		m.FailingSince = t
+	}
+	m.LastAttempt = t
+	m.LastError = err
+	m.LastErrorType = errType
+	m.LastErrorTime = t
+	m.ConsecutiveFailures++
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+)
+
+type MarketFetchStatusRepository interface {
+	// GetFetchStatus returns the status of the job for the market, a status
+	//with only MarketID and Job set is returned if the job never ran
+	GetFetchStatus(
+		ctx context.Context,
+		marketID int,
+		job string,
+	) (*MarketFetchStatus, error)
+	// UpsertFetchStatus stores status replacing the one of the same market
+	//and job
+	UpsertFetchStatus(ctx context.Context, status MarketFetchStatus) error
+	// GetFetchStatuses returns statuses sorted by market id and job, of the
+	//markets with the passed ids or of all markets if marketIDs is empty
+	GetFetchStatuses(
+		ctx context.Context,
+		marketIDs ...int,
+	) ([]MarketFetchStatus, error)
+}
@@ -12,4 +12,7 @@ type MarketPrice struct {
 	QuotePrice decimal.Decimal
 	QuoteAsset string
 	Time       time.Time
+	// SnapshotID is set if the price was fetched together with the balance
+	//of the market, see MarketSnapshot
+	SnapshotID string
 }
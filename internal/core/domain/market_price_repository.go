@@ -16,6 +16,22 @@ type MarketPriceRepository interface {
 		groupBy string,
 		marketIDs ...string,
 	) (map[string][]MarketPrice, error)
+	GetCandlesForMarkets(
+		ctx context.Context,
+		startTime time.Time,
+		endTime time.Time,
+		page Page,
+		groupBy string,
+		marketIDs ...string,
+	) (map[string][]MarketCandle, error)
+	// GetLatestPrices returns the most recent price stored for each market
+	GetLatestPrices(
+		ctx context.Context,
+		marketIDs ...string,
+	) (map[string]MarketPrice, error)
+	// CalculateVWAP returns the quote price of the markets weighted by their
+	//base balance, prices and balances are paired by snapshot, the ones
+	//fetched separately by previous versions by window of averageWindow
 	CalculateVWAP(
 		ctx context.Context,
 		averageWindow string,
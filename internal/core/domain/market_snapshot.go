@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+var (
+	ErrSnapshotMarketMismatch = errors.New(
+		"snapshot price and balance must be of the same market",
+	)
+)
+
+// MarketSnapshot is the price and balance of a market fetched in one pass,
+// both stored with the same time and snapshot id so that they can be joined
+// exactly
+type MarketSnapshot struct {
+	ID      string
+	Price   MarketPrice
+	Balance MarketBalance
+}
+
+// NewMarketSnapshot returns a snapshot with a random id, price and balance are
+// stamped with its id and time t
+func NewMarketSnapshot(
+	price MarketPrice,
+	balance MarketBalance,
+	t time.Time,
+) (*MarketSnapshot, error) {
+	if price.MarketID != balance.MarketID {
+		return nil, ErrSnapshotMarketMismatch
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	id := hex.EncodeToString(buf)
+
+	price.Time, price.SnapshotID = t, id
+	balance.Time, balance.SnapshotID = t, id
+
+	return &MarketSnapshot{
+		ID:      id,
+		Price:   price,
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"context"
+)
+
+type MarketSnapshotRepository interface {
+	// InsertSnapshot stores price and balance of the snapshot atomically,
+	//either both or none of them are stored
+	InsertSnapshot(ctx context.Context, snapshot MarketSnapshot) error
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// Rate is the amount of Target currency one unit of Source currency was worth
+// at Time
+type Rate struct {
+	Source string
+	Target string
+	Value  decimal.Decimal
+	Time   time.Time
+}
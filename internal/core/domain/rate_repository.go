@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type RateRepository interface {
+	InsertRate(ctx context.Context, rate Rate) error
+	// GetRates returns rates of source currency to target currency recorded in
+	//the given time range, sorted by time ASC
+	GetRates(
+		ctx context.Context,
+		source string,
+		target string,
+		startTime time.Time,
+		endTime time.Time,
+	) ([]Rate, error)
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Worker is a replica fetching data of the markets assigned to it, markets
+// are partitioned among the workers heartbeating
+type Worker struct {
+	NodeID      string
+	StartedAt   time.Time
+	HeartbeatAt time.Time
+	// CircuitBreakers are, by provider url, the circuit breakers of the
+	//providers that failed since they last answered the worker
+	CircuitBreakers map[string]CircuitBreaker
+}
+
+// CircuitBreaker is the health of a provider as seen by a worker
+type CircuitBreaker struct {
+	State               string
+	ConsecutiveFailures int
+	LastError           string
+	// RetryAt is when the provider is probed again, zero if the breaker is
+	//not open
+	RetryAt time.Time
+}
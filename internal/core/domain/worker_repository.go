@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type WorkerRepository interface {
+	// Heartbeat inserts the worker, or updates its heartbeat time
+	Heartbeat(ctx context.Context, worker Worker) error
+	// GetWorkers returns the workers that heartbeated since the given time,
+	//sorted by node id
+	GetWorkers(ctx context.Context, aliveSince time.Time) ([]Worker, error)
+	// DeleteWorker removes the worker, so that its markets are assigned to
+	//the others without waiting for it to be considered dead
+	DeleteWorker(ctx context.Context, nodeID string) error
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+)
+
+type MarketUpdatesBroker interface {
+	// Publish delivers payload to the listeners of every replica, this one
+	//included
+	Publish(ctx context.Context, payload []byte) error
+	// Listen returns a channel receiving the payloads published by every
+	//replica, it is closed once ctx is done
+	Listen(ctx context.Context) (<-chan []byte, error)
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+)
+
+type WebhookService interface {
+	// Send posts payload to url signed with secret and returns the status code
+	//of the response, an error is returned also if the status is not 2xx
+	Send(
+		ctx context.Context,
+		url string,
+		secret string,
+		payload []byte,
+	) (int, error)
+}
@@ -0,0 +1,213 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertAlertRule(
+	ctx context.Context,
+	rule domain.AlertRule,
+) (int, error) {
+	if err := b.db.Update(func(tx *bolt.Tx) error {
+		rules := tx.Bucket(alertRuleBucket)
+		id, err := rules.NextSequence()
+		if err != nil {
+			return err
+		}
+		rule.ID = int(id)
+
+		return putAlertRule(rules, rule)
+	}); err != nil {
+		return 0, err
+	}
+
+	return rule.ID, nil
+}
+
+func (b *boltDbService) UpdateAlertRule(
+	ctx context.Context,
+	rule domain.AlertRule,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		rules := tx.Bucket(alertRuleBucket)
+		current, err := getAlertRule(rules, rule.ID)
+		if err != nil {
+			return err
+		}
+		rule.CreatedAt = current.CreatedAt
+
+		return putAlertRule(rules, rule)
+	})
+}
+
+func (b *boltDbService) UpdateAlertRuleTriggered(
+	ctx context.Context,
+	id int,
+	triggered bool,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		rules := tx.Bucket(alertRuleBucket)
+		rule, err := getAlertRule(rules, id)
+		if err != nil {
+			if err == domain.ErrAlertRuleNotFound {
+				return nil
+			}
+			return err
+		}
+		rule.Triggered = triggered
+
+		return putAlertRule(rules, *rule)
+	})
+}
+
+// DeleteAlertRule deletes the rule together with its deliveries
+func (b *boltDbService) DeleteAlertRule(ctx context.Context, id int) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		rules := tx.Bucket(alertRuleBucket)
+		if rules.Get(idKey(id)) == nil {
+			return domain.ErrAlertRuleNotFound
+		}
+		if err := rules.Delete(idKey(id)); err != nil {
+			return err
+		}
+
+		deliveries := tx.Bucket(alertDeliveryBucket)
+		if deliveries.Bucket(ruleSeries(id)) == nil {
+			return nil
+		}
+		return deliveries.DeleteBucket(ruleSeries(id))
+	})
+}
+
+func (b *boltDbService) GetAlertRule(
+	ctx context.Context,
+	id int,
+) (*domain.AlertRule, error) {
+	var rule *domain.AlertRule
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		var err error
+		rule, err = getAlertRule(tx.Bucket(alertRuleBucket), id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (b *boltDbService) GetAlertRules(
+	ctx context.Context,
+	marketIDs ...string,
+) ([]domain.AlertRule, error) {
+	rules := make([]domain.AlertRule, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(alertRuleBucket).ForEach(func(k, v []byte) error {
+			var rule domain.AlertRule
+			if err := json.Unmarshal(v, &rule); err != nil {
+				return err
+			}
+
+			if timeseries.MatchMarket(rule.MarketID, marketIDs) {
+				rules = append(rules, rule)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (b *boltDbService) InsertAlertDelivery(
+	ctx context.Context,
+	delivery domain.AlertDelivery,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		deliveries, err := tx.Bucket(alertDeliveryBucket).
+			CreateBucketIfNotExists(ruleSeries(delivery.RuleID))
+		if err != nil {
+			return err
+		}
+
+		id, err := deliveries.NextSequence()
+		if err != nil {
+			return err
+		}
+		delivery.ID = int(id)
+
+		buf, err := json.Marshal(delivery)
+		if err != nil {
+			return err
+		}
+
+		// deliveries recorded at the same time are told apart by their id
+		key := append(timeKey(delivery.Time), idKey(delivery.ID)...)
+		return deliveries.Put(key, buf)
+	})
+}
+
+// GetAlertDeliveries returns the deliveries of the rule sorted by time DESC
+func (b *boltDbService) GetAlertDeliveries(
+	ctx context.Context,
+	ruleID int,
+	page domain.Page,
+) ([]domain.AlertDelivery, error) {
+	deliveries := make([]domain.AlertDelivery, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertDeliveryBucket).Bucket(ruleSeries(ruleID))
+		if bucket == nil {
+			return nil
+		}
+
+		c := bucket.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var delivery domain.AlertDelivery
+			if err := json.Unmarshal(v, &delivery); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	from, to := timeseries.PageBounds(len(deliveries), page)
+	return deliveries[from:to], nil
+}
+
+func getAlertRule(bucket *bolt.Bucket, id int) (*domain.AlertRule, error) {
+	buf := bucket.Get(idKey(id))
+	if buf == nil {
+		return nil, domain.ErrAlertRuleNotFound
+	}
+
+	var rule domain.AlertRule
+	if err := json.Unmarshal(buf, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func putAlertRule(bucket *bolt.Bucket, rule domain.AlertRule) error {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put(idKey(rule.ID), buf)
+}
+
+// ruleSeries returns the name of the bucket nested in alert_delivery storing
+// the deliveries of the rule with the given id
+func ruleSeries(id int) []byte {
+	return []byte(strconv.Itoa(id))
+}
@@ -0,0 +1,195 @@
+package dbbolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	marketBucket        = []byte("market")
+	marketKeyBucket     = []byte("market_key")
+	marketPriceBucket   = []byte("market_price")
+	marketBalanceBucket = []byte("market_balance")
+	rateBucket          = []byte("rate")
+	marketFeeBucket     = []byte("market_fee")
+	marketDepthBucket   = []byte("market_depth")
+	alertRuleBucket     = []byte("alert_rule")
+	alertDeliveryBucket = []byte("alert_delivery")
+	marketEventBucket   = []byte("market_event")
+	fetchStatusBucket   = []byte("market_fetch_status")
+
+	buckets = [][]byte{
+		marketBucket,
+		marketKeyBucket,
+		marketPriceBucket,
+		marketBalanceBucket,
+		rateBucket,
+		marketFeeBucket,
+		marketDepthBucket,
+		alertRuleBucket,
+		alertDeliveryBucket,
+		marketEventBucket,
+		fetchStatusBucket,
+	}
+)
+
+type Config struct {
+	// Path of the db file, created with its directory if not existing
+	Path string
+}
+
+// Service stores markets and their time series in a single local file, so
+// that tdexad can run without any external db
+type Service interface {
+	domain.MarketRepository
+	domain.MarketBalanceRepository
+	domain.MarketPriceRepository
+	domain.MarketSnapshotRepository
+	domain.RateRepository
+	domain.MarketFeeRepository
+	domain.MarketDepthRepository
+	domain.AlertRepository
+	domain.MarketEventRepository
+	domain.MarketFetchStatusRepository
+	domain.LeaderRepository
+	domain.WorkerRepository
+	port.MarketUpdatesBroker
+	Close() error
+}
+
+type boltDbService struct {
+	db         *bolt.DB
+	leaderLock sync.Mutex
+	leader     *domain.Leader
+	workerLock sync.Mutex
+	workers    map[string]domain.Worker
+
+	listenersLock sync.Mutex
+	listeners     map[chan []byte]struct{}
+}
+
+func New(config Config) (Service, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(config.Path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, v := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &boltDbService{
+		db: db,
+	}, nil
+}
+
+func (b *boltDbService) Close() error {
+	return b.db.Close()
+}
+
+// timeKey returns the key of a point recorded at t, keys are big endian so
+// that points are sorted by time
+func timeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+	return key
+}
+
+// putPoint stores value in the series bucket nested in table
+func putPoint(
+	tx *bolt.Tx,
+	table []byte,
+	series string,
+	key []byte,
+	value interface{},
+) error {
+	bucket, err := tx.Bucket(table).CreateBucketIfNotExists([]byte(series))
+	if err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put(key, buf)
+}
+
+// forEachPoint calls fn with the points of the series bucket nested in table
+// recorded in time range [startTime, endTime), sorted by time ASC
+func forEachPoint(
+	tx *bolt.Tx,
+	table []byte,
+	series string,
+	startTime time.Time,
+	endTime time.Time,
+	fn func(value []byte) error,
+) error {
+	bucket := tx.Bucket(table).Bucket([]byte(series))
+	if bucket == nil {
+		return nil
+	}
+
+	stop := timeKey(endTime)
+	c := bucket.Cursor()
+	for k, v := c.Seek(timeKey(startTime)); k != nil; k, v = c.Next() {
+		if bytes.Compare(k[:len(stop)], stop) >= 0 {
+			break
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lastPoint returns the most recent point of the series bucket nested in
+// table, or nil if there is none
+func lastPoint(tx *bolt.Tx, table []byte, series string) []byte {
+	bucket := tx.Bucket(table).Bucket([]byte(series))
+	if bucket == nil {
+		return nil
+	}
+
+	_, v := bucket.Cursor().Last()
+	return v
+}
+
+// marketSeries returns the ids of the markets with a series in table among
+// marketIDs, or all of them if marketIDs is empty
+func marketSeries(tx *bolt.Tx, table []byte, marketIDs []string) []string {
+	series := make([]string, 0)
+	tx.Bucket(table).ForEach(func(k, v []byte) error {
+		// nested buckets have nil value
+		if v == nil && timeseries.MatchMarket(string(k), marketIDs) {
+			series = append(series, string(k))
+		}
+		return nil
+	})
+
+	return series
+}
@@ -0,0 +1,402 @@
+package dbbolt
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func newTestService(t *testing.T) Service {
+	svc, err := New(Config{Path: filepath.Join(t.TempDir(), "tdexa.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { svc.Close() })
+
+	return svc
+}
+
+func TestMarketRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	markets := []domain.Market{
+		{ProviderName: "p1", Url: "url1", BaseAsset: "ba", QuoteAsset: "qa"},
+		{ProviderName: "p2", Url: "url2", BaseAsset: "ba", QuoteAsset: "qa"},
+		// duplicate is ignored
+		{ProviderName: "p1", Url: "url1", BaseAsset: "ba", QuoteAsset: "qa"},
+	}
+	for _, v := range markets {
+		require.NoError(t, svc.InsertMarket(ctx, v))
+	}
+
+	all, err := svc.GetAllMarkets(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, 1, all[0].ID)
+	require.Equal(t, 2, all[1].ID)
+
+	require.NoError(t, svc.ActivateMarket(ctx, 2))
+	active, err := svc.GetMarketsForActiveIndicator(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, "url2", active[0].Url)
+
+	filtered, err := svc.GetAllMarketsForFilter(
+		ctx,
+		[]domain.Filter{{Url: "url1", BaseAsset: "ba", QuoteAsset: "qa"}},
+		domain.NewPage(1, 10),
+	)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, 1, filtered[0].ID)
+
+	page, err := svc.GetAllMarketsForFilter(ctx, nil, domain.NewPage(1, 1))
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, 2, page[0].ID)
+}
+
+func TestMarketPriceAndBalanceRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		tm := start.Add(time.Duration(i) * 30 * time.Minute)
+		require.NoError(t, svc.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   "1",
+			BasePrice:  decimal.NewFromInt(int64(i + 1)),
+			QuotePrice: decimal.NewFromInt(int64(10 * (i + 1))),
+			Time:       tm,
+		}))
+		require.NoError(t, svc.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(int64(1000 * (i + 1))),
+			Time:         tm,
+		}))
+	}
+	require.ErrorIs(t, svc.InsertBalance(ctx, domain.MarketBalance{
+		MarketID:     "1",
+		BaseBalance:  decimal.NewFromFloat(0.5),
+		QuoteBalance: decimal.Zero,
+		Time:         start,
+	}), ErrInvalidBalance)
+
+	end := start.Add(2 * time.Hour)
+	prices, err := svc.GetPricesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "", "1",
+	)
+	require.NoError(t, err)
+	require.Len(t, prices["1"], 4)
+	require.True(t, start.Equal(prices["1"][0].Time))
+
+	prices, err = svc.GetPricesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "1h",
+	)
+	require.NoError(t, err)
+	require.Len(t, prices["1"], 2)
+	require.True(t, decimal.NewFromInt(15).Equal(prices["1"][0].QuotePrice))
+
+	candles, err := svc.GetCandlesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "1h", "1",
+	)
+	require.NoError(t, err)
+	require.Len(t, candles["1"], 2)
+	require.True(t, decimal.NewFromInt(30).Equal(candles["1"][1].Open))
+	require.True(t, decimal.NewFromInt(40).Equal(candles["1"][1].Close))
+
+	latestPrices, err := svc.GetLatestPrices(ctx)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(40).Equal(latestPrices["1"].QuotePrice))
+
+	balances, err := svc.GetBalancesForMarkets(
+		ctx, start, end, domain.NewPage(2, 3), "", "1",
+	)
+	require.NoError(t, err)
+	require.Len(t, balances["1"], 1)
+	require.True(t, decimal.NewFromInt(4000).Equal(balances["1"][0].QuoteBalance))
+
+	latestBalances, err := svc.GetLatestBalances(ctx, "2")
+	require.NoError(t, err)
+	require.Empty(t, latestBalances)
+
+	vwap, err := svc.CalculateVWAP(ctx, "1h", start, end, "1")
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(25).Equal(vwap))
+}
+
+func TestMarketSnapshotRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	snapshot, err := domain.NewMarketSnapshot(
+		domain.MarketPrice{
+			MarketID:   "1",
+			BasePrice:  decimal.NewFromInt(2),
+			QuotePrice: decimal.NewFromInt(20),
+		},
+		domain.MarketBalance{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(2000),
+		},
+		now,
+	)
+	require.NoError(t, err)
+	require.NoError(t, svc.InsertSnapshot(ctx, *snapshot))
+
+	prices, err := svc.GetLatestPrices(ctx, "1")
+	require.NoError(t, err)
+	balances, err := svc.GetLatestBalances(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, snapshot.ID, prices["1"].SnapshotID)
+	require.Equal(t, snapshot.ID, balances["1"].SnapshotID)
+	require.True(t, now.Equal(prices["1"].Time))
+	require.True(t, now.Equal(balances["1"].Time))
+
+	// none of price and balance is stored if one is invalid
+	snapshot, err = domain.NewMarketSnapshot(
+		domain.MarketPrice{MarketID: "2", QuotePrice: decimal.NewFromInt(20)},
+		domain.MarketBalance{MarketID: "2", BaseBalance: decimal.NewFromFloat(0.5)},
+		now,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, svc.InsertSnapshot(ctx, *snapshot), ErrInvalidBalance)
+	prices, err = svc.GetLatestPrices(ctx, "2")
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	_, err = domain.NewMarketSnapshot(
+		domain.MarketPrice{MarketID: "1"}, domain.MarketBalance{MarketID: "2"}, now,
+	)
+	require.ErrorIs(t, err, domain.ErrSnapshotMarketMismatch)
+}
+
+func TestAlertRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	rule := domain.AlertRule{
+		MarketID:   "1",
+		Type:       domain.AlertRuleBalanceBelow,
+		Asset:      domain.AlertAssetQuote,
+		Threshold:  decimal.NewFromInt(1000),
+		WebhookUrl: "https://example.com/hook",
+		Secret:     "secret",
+		CreatedAt:  time.Now(),
+	}
+	id, err := svc.InsertAlertRule(ctx, rule)
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+
+	rule.MarketID = "2"
+	_, err = svc.InsertAlertRule(ctx, rule)
+	require.NoError(t, err)
+
+	rules, err := svc.GetAlertRules(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.True(t, decimal.NewFromInt(1000).Equal(rules[0].Threshold))
+
+	require.NoError(t, svc.UpdateAlertRuleTriggered(ctx, id, true))
+	stored, err := svc.GetAlertRule(ctx, id)
+	require.NoError(t, err)
+	require.True(t, stored.Triggered)
+
+	rule.ID = 3
+	require.ErrorIs(t, svc.UpdateAlertRule(ctx, rule), domain.ErrAlertRuleNotFound)
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, svc.InsertAlertDelivery(ctx, domain.AlertDelivery{
+			RuleID:    id,
+			Attempts:  i + 1,
+			Delivered: true,
+			Time:      start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	deliveries, err := svc.GetAlertDeliveries(ctx, id, domain.NewPage(1, 2))
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, 3, deliveries[0].Attempts)
+
+	require.NoError(t, svc.DeleteAlertRule(ctx, id))
+	require.ErrorIs(t, svc.DeleteAlertRule(ctx, id), domain.ErrAlertRuleNotFound)
+	deliveries, err = svc.GetAlertDeliveries(ctx, id, domain.NewPage(1, 2))
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func TestMarketEventRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.MarketEvent{
+		{MarketID: 2, Type: domain.MarketEventDiscovered, Time: start.Add(time.Hour)},
+		{MarketID: 1, Type: domain.MarketEventDiscovered, Time: start},
+		{MarketID: 1, Type: domain.MarketEventDeactivated, Time: start.Add(2 * time.Hour)},
+		{MarketID: 1, Type: domain.MarketEventActivated, Time: start.Add(3 * time.Hour)},
+	}
+	for _, v := range events {
+		require.NoError(t, svc.InsertMarketEvent(ctx, v))
+	}
+
+	all, err := svc.GetMarketEvents(ctx, start.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, 1, all[0].MarketID)
+	require.Equal(t, 2, all[1].MarketID)
+	require.Equal(t, domain.MarketEventDeactivated, all[2].Type)
+
+	market, err := svc.GetMarketEvents(ctx, start.Add(4*time.Hour), 2)
+	require.NoError(t, err)
+	require.Len(t, market, 1)
+}
+
+func TestMarketFetchStatusRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	status, err := svc.GetFetchStatus(ctx, 1, domain.FetchJobPrice)
+	require.NoError(t, err)
+	require.Equal(t, 1, status.MarketID)
+	require.Zero(t, status.ConsecutiveFailures)
+
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	status.RecordFailure(domain.FetchErrorTLS, "unavailable", now)
+	require.NoError(t, svc.UpsertFetchStatus(ctx, *status))
+	require.NoError(t, svc.UpsertFetchStatus(ctx, domain.MarketFetchStatus{
+		MarketID: 2,
+		Job:      domain.FetchJobBalance,
+	}))
+	require.NoError(t, svc.UpsertFetchStatus(ctx, domain.MarketFetchStatus{
+		MarketID: 1,
+		Job:      domain.FetchJobBalance,
+	}))
+
+	status, err = svc.GetFetchStatus(ctx, 1, domain.FetchJobPrice)
+	require.NoError(t, err)
+	require.Equal(t, 1, status.ConsecutiveFailures)
+	require.Equal(t, "unavailable", status.LastError)
+	require.Equal(t, domain.FetchErrorTLS, status.LastErrorType)
+	require.True(t, now.Equal(status.FailingSince))
+
+	all, err := svc.GetFetchStatuses(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, domain.FetchJobBalance, all[0].Job)
+	require.Equal(t, 2, all[2].MarketID)
+
+	market, err := svc.GetFetchStatuses(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, market, 1)
+}
+
+func TestLeaderRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+
+	leader, err := svc.GetLeader(ctx, time.Time{})
+	require.NoError(t, err)
+	require.Nil(t, leader)
+
+	isLeader, err := svc.TryAcquireLeadership(ctx, "node1")
+	require.NoError(t, err)
+	require.True(t, isLeader)
+	isLeader, err = svc.TryAcquireLeadership(ctx, "node2")
+	require.NoError(t, err)
+	require.False(t, isLeader)
+
+	// leadership is renewed by the leader
+	isLeader, err = svc.TryAcquireLeadership(ctx, "node1")
+	require.NoError(t, err)
+	require.True(t, isLeader)
+	leader, err = svc.GetLeader(ctx, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "node1", leader.NodeID)
+	require.False(t, leader.RenewedAt.Before(leader.ElectedAt))
+
+	// leadership not renewed since the given time is expired
+	leader, err = svc.GetLeader(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Nil(t, leader)
+
+	// only the leader can release the leadership
+	require.NoError(t, svc.ReleaseLeadership(ctx, "node2"))
+	leader, err = svc.GetLeader(ctx, time.Time{})
+	require.NoError(t, err)
+	require.NotNil(t, leader)
+	require.NoError(t, svc.ReleaseLeadership(ctx, "node1"))
+	leader, err = svc.GetLeader(ctx, time.Time{})
+	require.NoError(t, err)
+	require.Nil(t, leader)
+
+	isLeader, err = svc.TryAcquireLeadership(ctx, "node2")
+	require.NoError(t, err)
+	require.True(t, isLeader)
+}
+
+func TestWorkerRepository(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	now := time.Now()
+
+	workers, err := svc.GetWorkers(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, workers)
+
+	require.NoError(t, svc.Heartbeat(ctx, domain.Worker{
+		NodeID: "node2", StartedAt: now, HeartbeatAt: now,
+	}))
+	require.NoError(t, svc.Heartbeat(ctx, domain.Worker{
+		NodeID: "node1", StartedAt: now, HeartbeatAt: now.Add(-time.Hour),
+	}))
+
+	// dead workers are not returned
+	workers, err = svc.GetWorkers(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+	require.Equal(t, "node2", workers[0].NodeID)
+
+	require.NoError(t, svc.Heartbeat(ctx, domain.Worker{
+		NodeID: "node1", StartedAt: now, HeartbeatAt: now,
+	}))
+	workers, err = svc.GetWorkers(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, workers, 2)
+	require.Equal(t, "node1", workers[0].NodeID)
+
+	require.NoError(t, svc.DeleteWorker(ctx, "node1"))
+	workers, err = svc.GetWorkers(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+}
+
+func TestMarketUpdatesBroker(t *testing.T) {
+	svc := newTestService(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listener1, err := svc.Listen(ctx)
+	require.NoError(t, err)
+	listener2, err := svc.Listen(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Publish(context.Background(), []byte("update")))
+	require.Equal(t, []byte("update"), <-listener1)
+	require.Equal(t, []byte("update"), <-listener2)
+
+	// listeners are closed once their ctx is done
+	cancel()
+	_, ok := <-listener1
+	require.False(t, ok)
+	require.NoError(t, svc.Publish(context.Background(), []byte("update")))
+	require.Equal(t, []byte("update"), <-listener2)
+}
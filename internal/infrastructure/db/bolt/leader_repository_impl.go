@@ -0,0 +1,57 @@
+package dbbolt
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+// the db file is locked by the process that opened it, so there are no other
+// replicas to elect a leader among, the leadership is only tracked in memory
+
+func (b *boltDbService) TryAcquireLeadership(
+	ctx context.Context,
+	nodeID string,
+) (bool, error) {
+	b.leaderLock.Lock()
+	defer b.leaderLock.Unlock()
+
+	now := time.Now()
+	if b.leader == nil {
+		b.leader = &domain.Leader{NodeID: nodeID, ElectedAt: now}
+	}
+	if b.leader.NodeID != nodeID {
+		return false, nil
+	}
+
+	b.leader.RenewedAt = now
+	return true, nil
+}
+
+func (b *boltDbService) ReleaseLeadership(
+	ctx context.Context,
+	nodeID string,
+) error {
+	b.leaderLock.Lock()
+	defer b.leaderLock.Unlock()
+
+	if b.leader != nil && b.leader.NodeID == nodeID {
+		b.leader = nil
+	}
+	return nil
+}
+
+func (b *boltDbService) GetLeader(
+	ctx context.Context,
+	renewedSince time.Time,
+) (*domain.Leader, error) {
+	b.leaderLock.Lock()
+	defer b.leaderLock.Unlock()
+
+	if b.leader == nil || b.leader.RenewedAt.Before(renewedSince) {
+		return nil, nil
+	}
+	leader := *b.leader
+	return &leader, nil
+}
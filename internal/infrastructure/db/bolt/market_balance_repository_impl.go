@@ -0,0 +1,133 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	ErrInvalidBalance = errors.New(
+		"balance must be a non negative integer amount of satoshis",
+	)
+)
+
+func (b *boltDbService) InsertBalance(
+	ctx context.Context,
+	balance domain.MarketBalance,
+) error {
+	if err := validateBalance(balance); err != nil {
+		return err
+	}
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return putPoint(
+			tx, marketBalanceBucket, balance.MarketID, timeKey(balance.Time), balance,
+		)
+	})
+}
+
+func (b *boltDbService) GetBalancesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketBalance, error) {
+	balances, err := b.getBalances(startTime, endTime, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketBalance)
+	for marketID, v := range balances {
+		if groupBy != "" {
+			v, err = timeseries.MeanBalances(v, groupBy, endTime)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		from, to := timeseries.PageBounds(len(v), page)
+		if from < to {
+			response[marketID] = v[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+func (b *boltDbService) GetLatestBalances(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketBalance, error) {
+	response := make(map[string]domain.MarketBalance)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketBalanceBucket, marketIDs) {
+			buf := lastPoint(tx, marketBalanceBucket, marketID)
+			if buf == nil {
+				continue
+			}
+
+			var balance domain.MarketBalance
+			if err := json.Unmarshal(buf, &balance); err != nil {
+				return err
+			}
+			response[marketID] = balance
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// getBalances returns the balances of every market recorded in the given
+// time range, sorted by time ASC
+func (b *boltDbService) getBalances(
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs []string,
+) (map[string][]domain.MarketBalance, error) {
+	balances := make(map[string][]domain.MarketBalance)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketBalanceBucket, marketIDs) {
+			if err := forEachPoint(
+				tx, marketBalanceBucket, marketID, startTime, endTime,
+				func(value []byte) error {
+					var balance domain.MarketBalance
+					if err := json.Unmarshal(value, &balance); err != nil {
+						return err
+					}
+					balances[marketID] = append(balances[marketID], balance)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// validateBalance checks that balances are in satoshis
+func validateBalance(balance domain.MarketBalance) error {
+	for _, v := range []decimal.Decimal{balance.BaseBalance, balance.QuoteBalance} {
+		if v.IsNegative() || !v.Equal(v.Truncate(0)) {
+			return ErrInvalidBalance
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertDepth(
+	ctx context.Context,
+	depth []domain.MarketDepth,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		for _, v := range depth {
+			if err := putPoint(
+				tx, marketDepthBucket, v.MarketID, depthKey(v), v,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *boltDbService) GetDepthForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketDepth, error) {
+	depth := make(map[string][]domain.MarketDepth)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketDepthBucket, marketIDs) {
+			if err := forEachPoint(
+				tx, marketDepthBucket, marketID, startTime, endTime,
+				func(value []byte) error {
+					var level domain.MarketDepth
+					if err := json.Unmarshal(value, &level); err != nil {
+						return err
+					}
+					depth[marketID] = append(depth[marketID], level)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if groupBy == "" {
+		return depth, nil
+	}
+
+	response := make(map[string][]domain.MarketDepth)
+	for marketID, v := range depth {
+		levels, err := timeseries.MeanDepth(v, groupBy, endTime)
+		if err != nil {
+			return nil, err
+		}
+		response[marketID] = levels
+	}
+
+	return response, nil
+}
+
+// depthKey returns the key of a depth level, made of its time followed by
+// trade type and amount so that levels of the same sample are kept apart
+func depthKey(depth domain.MarketDepth) []byte {
+	key := timeKey(depth.Time)
+	key = append(key, []byte(depth.TradeType)...)
+	return append(key, []byte("/"+depth.Amount.String())...)
+}
@@ -0,0 +1,78 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertMarketEvent(
+	ctx context.Context,
+	event domain.MarketEvent,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		events, err := tx.Bucket(marketEventBucket).
+			CreateBucketIfNotExists([]byte(strconv.Itoa(event.MarketID)))
+		if err != nil {
+			return err
+		}
+
+		seq, err := events.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		buf, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		// events recorded at the same time are kept in insertion order
+		key := append(timeKey(event.Time), idKey(int(seq))...)
+		return events.Put(key, buf)
+	})
+}
+
+func (b *boltDbService) GetMarketEvents(
+	ctx context.Context,
+	endTime time.Time,
+	marketIDs ...int,
+) ([]domain.MarketEvent, error) {
+	ids := make([]string, 0, len(marketIDs))
+	for _, v := range marketIDs {
+		ids = append(ids, strconv.Itoa(v))
+	}
+
+	events := make([]domain.MarketEvent, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, series := range marketSeries(tx, marketEventBucket, ids) {
+			if err := forEachPoint(
+				tx, marketEventBucket, series, time.Unix(0, 0), endTime,
+				func(value []byte) error {
+					var event domain.MarketEvent
+					if err := json.Unmarshal(value, &event); err != nil {
+						return err
+					}
+					events = append(events, event)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	return events, nil
+}
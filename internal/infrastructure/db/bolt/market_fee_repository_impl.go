@@ -0,0 +1,70 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertFee(
+	ctx context.Context,
+	fee domain.MarketFee,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return putPoint(tx, marketFeeBucket, fee.MarketID, timeKey(fee.Time), fee)
+	})
+}
+
+func (b *boltDbService) GetFeesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketFee, error) {
+	fees := make(map[string][]domain.MarketFee)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketFeeBucket, marketIDs) {
+			if err := forEachPoint(
+				tx, marketFeeBucket, marketID, startTime, endTime,
+				func(value []byte) error {
+					var fee domain.MarketFee
+					if err := json.Unmarshal(value, &fee); err != nil {
+						return err
+					}
+					fees[marketID] = append(fees[marketID], fee)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketFee)
+	for marketID, v := range fees {
+		// the last fee of every window is returned
+		if groupBy != "" {
+			var err error
+			v, err = timeseries.LastFees(v, groupBy, endTime)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		from, to := timeseries.PageBounds(len(v), page)
+		if from < to {
+			response[marketID] = v[from:to]
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,81 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) GetFetchStatus(
+	ctx context.Context,
+	marketID int,
+	job string,
+) (*domain.MarketFetchStatus, error) {
+	status := &domain.MarketFetchStatus{
+		MarketID: marketID,
+		Job:      job,
+	}
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		buf := tx.Bucket(fetchStatusBucket).Get(fetchStatusKey(marketID, job))
+		if buf == nil {
+			return nil
+		}
+		return json.Unmarshal(buf, status)
+	}); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (b *boltDbService) UpsertFetchStatus(
+	ctx context.Context,
+	status domain.MarketFetchStatus,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		buf, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(fetchStatusBucket).Put(
+			fetchStatusKey(status.MarketID, status.Job), buf,
+		)
+	})
+}
+
+func (b *boltDbService) GetFetchStatuses(
+	ctx context.Context,
+	marketIDs ...int,
+) ([]domain.MarketFetchStatus, error) {
+	wanted := make(map[int]bool)
+	for _, v := range marketIDs {
+		wanted[v] = true
+	}
+
+	statuses := make([]domain.MarketFetchStatus, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(fetchStatusBucket).ForEach(func(k, v []byte) error {
+			var status domain.MarketFetchStatus
+			if err := json.Unmarshal(v, &status); err != nil {
+				return err
+			}
+
+			if len(wanted) == 0 || wanted[status.MarketID] {
+				statuses = append(statuses, status)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// fetchStatusKey sorts statuses by market id and job
+func fetchStatusKey(marketID int, job string) []byte {
+	return append(idKey(marketID), []byte(job)...)
+}
@@ -0,0 +1,178 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertPrice(
+	ctx context.Context,
+	price domain.MarketPrice,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return putPoint(
+			tx, marketPriceBucket, price.MarketID, timeKey(price.Time), price,
+		)
+	})
+}
+
+func (b *boltDbService) GetPricesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketPrice, error) {
+	prices, err := b.getPrices(startTime, endTime, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketPrice)
+	for marketID, v := range prices {
+		if groupBy != "" {
+			v, err = timeseries.MeanPrices(v, groupBy, endTime)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		from, to := timeseries.PageBounds(len(v), page)
+		if from < to {
+			response[marketID] = v[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+func (b *boltDbService) GetLatestPrices(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketPrice, error) {
+	response := make(map[string]domain.MarketPrice)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketPriceBucket, marketIDs) {
+			buf := lastPoint(tx, marketPriceBucket, marketID)
+			if buf == nil {
+				continue
+			}
+
+			var price domain.MarketPrice
+			if err := json.Unmarshal(buf, &price); err != nil {
+				return err
+			}
+			response[marketID] = price
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetCandlesForMarkets returns open, high, low and close quote prices for
+// each market, grouped in windows of groupBy duration
+func (b *boltDbService) GetCandlesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketCandle, error) {
+	if _, err := timeseries.NewWindow(groupBy); err != nil {
+		return nil, err
+	}
+
+	prices, err := b.getPrices(startTime, endTime, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketCandle)
+	for marketID, v := range prices {
+		candles, err := timeseries.Candles(v, groupBy, endTime)
+		if err != nil {
+			return nil, err
+		}
+
+		from, to := timeseries.PageBounds(len(candles), page)
+		if from < to {
+			response[marketID] = candles[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+// CalculateVWAP calculates the Volume Weighted Average Price (VWAP) for the
+// given market IDs within the specified time range, as sum of products of
+// quote price and base balance of the same snapshot, or of the same window
+// for the ones fetched separately, over sum of base balances
+func (b *boltDbService) CalculateVWAP(
+	ctx context.Context,
+	averageWindow string,
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs ...string,
+) (decimal.Decimal, error) {
+	if _, err := timeseries.NewWindow(averageWindow); err != nil {
+		return decimal.Zero, err
+	}
+	// unlike the other queries, no market means no VWAP
+	if len(marketIDs) == 0 {
+		return decimal.Zero, nil
+	}
+
+	prices, err := b.getPrices(startTime, endTime, marketIDs)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	balances, err := b.getBalances(startTime, endTime, marketIDs)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return timeseries.VWAP(prices, balances, averageWindow, endTime)
+}
+
+// getPrices returns the prices of every market recorded in the given time
+// range, sorted by time ASC
+func (b *boltDbService) getPrices(
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs []string,
+) (map[string][]domain.MarketPrice, error) {
+	prices := make(map[string][]domain.MarketPrice)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		for _, marketID := range marketSeries(tx, marketPriceBucket, marketIDs) {
+			if err := forEachPoint(
+				tx, marketPriceBucket, marketID, startTime, endTime,
+				func(value []byte) error {
+					var price domain.MarketPrice
+					if err := json.Unmarshal(value, &price); err != nil {
+						return err
+					}
+					prices[marketID] = append(prices[marketID], price)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,150 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertMarket(
+	ctx context.Context,
+	market domain.Market,
+) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		keys := tx.Bucket(marketKeyBucket)
+		// like the unique constraint in postgres, inserting an existing market
+		//is a no-op
+		key := []byte(market.Key())
+		if keys.Get(key) != nil {
+			return nil
+		}
+
+		markets := tx.Bucket(marketBucket)
+		id, err := markets.NextSequence()
+		if err != nil {
+			return err
+		}
+		market.ID = int(id)
+
+		if err := keys.Put(key, idKey(market.ID)); err != nil {
+			return err
+		}
+
+		return putMarket(markets, market)
+	})
+}
+
+func (b *boltDbService) GetAllMarkets(
+	ctx context.Context,
+) ([]domain.Market, error) {
+	return b.getMarkets(func(domain.Market) bool { return true })
+}
+
+func (b *boltDbService) GetMarketsForActiveIndicator(
+	ctx context.Context,
+	active bool,
+) ([]domain.Market, error) {
+	return b.getMarkets(func(m domain.Market) bool { return m.Active == active })
+}
+
+// GetAllMarketsForFilter returns markets sorted by id DESC, all markets are
+// returned if filter is empty
+func (b *boltDbService) GetAllMarketsForFilter(
+	ctx context.Context,
+	filter []domain.Filter,
+	page domain.Page,
+) ([]domain.Market, error) {
+	markets, err := b.getMarkets(func(m domain.Market) bool {
+		if len(filter) == 0 {
+			return true
+		}
+		for _, v := range filter {
+			if m.Url == v.Url && m.BaseAsset == v.BaseAsset &&
+				m.QuoteAsset == v.QuoteAsset {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(markets)-1; i < j; i, j = i+1, j-1 {
+		markets[i], markets[j] = markets[j], markets[i]
+	}
+
+	from, to := timeseries.PageBounds(len(markets), page)
+	return markets[from:to], nil
+}
+
+func (b *boltDbService) ActivateMarket(ctx context.Context, marketID int) error {
+	return b.updateActive(marketID, true)
+}
+
+func (b *boltDbService) InactivateMarket(ctx context.Context, marketID int) error {
+	return b.updateActive(marketID, false)
+}
+
+// getMarkets returns the markets matching filter sorted by id ASC
+func (b *boltDbService) getMarkets(
+	filter func(domain.Market) bool,
+) ([]domain.Market, error) {
+	markets := make([]domain.Market, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(marketBucket).ForEach(func(k, v []byte) error {
+			var market domain.Market
+			if err := json.Unmarshal(v, &market); err != nil {
+				return err
+			}
+
+			if filter(market) {
+				markets = append(markets, market)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return markets, nil
+}
+
+func (b *boltDbService) updateActive(marketID int, active bool) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		markets := tx.Bucket(marketBucket)
+		buf := markets.Get(idKey(marketID))
+		if buf == nil {
+			return nil
+		}
+
+		var market domain.Market
+		if err := json.Unmarshal(buf, &market); err != nil {
+			return err
+		}
+		market.Active = active
+
+		return putMarket(markets, market)
+	})
+}
+
+func putMarket(bucket *bolt.Bucket, market domain.Market) error {
+	buf, err := json.Marshal(market)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put(idKey(market.ID), buf)
+}
+
+// idKey returns the key of the market with the given id, keys are big endian
+// so that markets are sorted by id
+func idKey(id int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return key
+}
@@ -0,0 +1,30 @@
+package dbbolt
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertSnapshot(
+	ctx context.Context,
+	snapshot domain.MarketSnapshot,
+) error {
+	if err := validateBalance(snapshot.Balance); err != nil {
+		return err
+	}
+
+	price, balance := snapshot.Price, snapshot.Balance
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if err := putPoint(
+			tx, marketPriceBucket, price.MarketID, timeKey(price.Time), price,
+		); err != nil {
+			return err
+		}
+
+		return putPoint(
+			tx, marketBalanceBucket, balance.MarketID, timeKey(balance.Time), balance,
+		)
+	})
+}
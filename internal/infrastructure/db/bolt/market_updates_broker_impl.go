@@ -0,0 +1,47 @@
+package dbbolt
+
+import (
+	"context"
+)
+
+// listenerBufferSize is the number of payloads buffered for every listener,
+// payloads are dropped if a listener falls that much behind
+const listenerBufferSize = 100
+
+// like workers, market updates are delivered in memory since the db file
+// can't be shared among replicas
+
+func (b *boltDbService) Publish(ctx context.Context, payload []byte) error {
+	b.listenersLock.Lock()
+	defer b.listenersLock.Unlock()
+
+	for listener := range b.listeners {
+		select {
+		case listener <- payload:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *boltDbService) Listen(ctx context.Context) (<-chan []byte, error) {
+	listener := make(chan []byte, listenerBufferSize)
+
+	b.listenersLock.Lock()
+	if b.listeners == nil {
+		b.listeners = make(map[chan []byte]struct{})
+	}
+	b.listeners[listener] = struct{}{}
+	b.listenersLock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.listenersLock.Lock()
+		delete(b.listeners, listener)
+		close(listener)
+		b.listenersLock.Unlock()
+	}()
+
+	return listener, nil
+}
@@ -0,0 +1,63 @@
+package dbbolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (b *boltDbService) InsertRate(
+	ctx context.Context,
+	rate domain.Rate,
+) error {
+	rate.Source = strings.ToLower(rate.Source)
+	rate.Target = strings.ToLower(rate.Target)
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return putPoint(
+			tx, rateBucket, rateSeries(rate.Source, rate.Target), timeKey(rate.Time),
+			rate,
+		)
+	})
+}
+
+func (b *boltDbService) GetRates(
+	ctx context.Context,
+	source string,
+	target string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]domain.Rate, error) {
+	rates := make([]domain.Rate, 0)
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return forEachPoint(
+			tx, rateBucket, rateSeries(source, target), startTime, endTime,
+			func(value []byte) error {
+				var rate domain.Rate
+				if err := json.Unmarshal(value, &rate); err != nil {
+					return err
+				}
+
+				rate.Source = source
+				rate.Target = target
+				rates = append(rates, rate)
+				return nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// rateSeries returns the name of the series of source to target rates,
+// currencies are case insensitive
+func rateSeries(source, target string) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(source), strings.ToLower(target))
+}
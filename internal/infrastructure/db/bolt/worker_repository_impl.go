@@ -0,0 +1,54 @@
+package dbbolt
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+// like the leadership, workers are only tracked in memory since the db file
+// can't be shared among replicas
+
+func (b *boltDbService) Heartbeat(
+	ctx context.Context,
+	worker domain.Worker,
+) error {
+	b.workerLock.Lock()
+	defer b.workerLock.Unlock()
+
+	if b.workers == nil {
+		b.workers = make(map[string]domain.Worker)
+	}
+	b.workers[worker.NodeID] = worker
+	return nil
+}
+
+func (b *boltDbService) GetWorkers(
+	ctx context.Context,
+	aliveSince time.Time,
+) ([]domain.Worker, error) {
+	b.workerLock.Lock()
+	defer b.workerLock.Unlock()
+
+	workers := make([]domain.Worker, 0, len(b.workers))
+	for _, v := range b.workers {
+		if !v.HeartbeatAt.Before(aliveSince) {
+			workers = append(workers, v)
+		}
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].NodeID < workers[j].NodeID
+	})
+
+	return workers, nil
+}
+
+func (b *boltDbService) DeleteWorker(ctx context.Context, nodeID string) error {
+	b.workerLock.Lock()
+	defer b.workerLock.Unlock()
+
+	delete(b.workers, nodeID)
+	return nil
+}
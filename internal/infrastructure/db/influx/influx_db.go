@@ -1,6 +1,7 @@
 package dbinflux
 
 import (
+	"context"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
 )
@@ -11,10 +12,33 @@ const (
 	MarketBalanceTable = "market_balance"
 	baseAsset          = "base_asset"
 	baseBalance        = "base_balance"
+	baseBalanceSats    = "base_balance_sats"
 	basePrice          = "base_price"
 	quoteAsset         = "quote_asset"
 	quoteBalance       = "quote_balance"
+	quoteBalanceSats   = "quote_balance_sats"
 	quotePrice         = "quote_price"
+	candleOpen         = "open"
+	candleHigh         = "high"
+	candleLow          = "low"
+	candleClose        = "close"
+	RateTable          = "rate"
+	MarketFeeTable     = "market_fee"
+	basePercentageFee  = "base_percentage_fee"
+	quotePercentageFee = "quote_percentage_fee"
+	baseFixedFee       = "base_fixed_fee"
+	quoteFixedFee      = "quote_fixed_fee"
+	previewFeeAmount   = "preview_fee_amount"
+	previewFeeAsset    = "preview_fee_asset"
+	MarketDepthTable   = "market_depth"
+	tradeTypeTag       = "trade_type"
+	amountTag          = "amount"
+	depthPrice         = "price"
+	rateSourceTag      = "source"
+	rateTargetTag      = "target"
+	rateValue          = "rate"
+	// snapshotID is a field, not a tag, since every snapshot has its own
+	snapshotID = "snapshot_id"
 )
 
 type Config struct {
@@ -27,6 +51,13 @@ type Config struct {
 type Service interface {
 	domain.MarketBalanceRepository
 	domain.MarketPriceRepository
+	domain.MarketSnapshotRepository
+	domain.RateRepository
+	domain.MarketFeeRepository
+	domain.MarketDepthRepository
+	// MigrateBalances copies balances stored as float fields by previous
+	//versions into the integer fields, rounding them to the closest satoshi
+	MigrateBalances(ctx context.Context) error
 	Close()
 }
 
@@ -2,27 +2,37 @@ package dbinflux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"strings"
 	"time"
 )
 
+const (
+	sumSuffix   = "_sum"
+	countSuffix = "_count"
+)
+
+var (
+	ErrInvalidBalance = errors.New(
+		"balance must be a non negative integer amount of satoshis",
+	)
+)
+
 func (i *influxDbService) InsertBalance(
 	ctx context.Context,
 	balance domain.MarketBalance,
 ) error {
-	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
-
-	bBalance, _ := balance.BaseBalance.Float64()
-	qBalance, _ := balance.QuoteBalance.Float64()
+	p, err := balancePoint(balance)
+	if err != nil {
+		return err
+	}
 
-	p := influxdb2.NewPointWithMeasurement(MarketBalanceTable).
-		AddTag(marketTag, balance.MarketID).
-		AddField(baseBalance, bBalance).
-		AddField(quoteBalance, qBalance).
-		SetTime(balance.Time)
+	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
 
 	writeAPI.WritePoint(p)
 
@@ -31,6 +41,28 @@ func (i *influxDbService) InsertBalance(
 	return nil
 }
 
+func balancePoint(balance domain.MarketBalance) (*write.Point, error) {
+	bBalance, err := balanceToSats(balance.BaseBalance)
+	if err != nil {
+		return nil, err
+	}
+	qBalance, err := balanceToSats(balance.QuoteBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	p := influxdb2.NewPointWithMeasurement(MarketBalanceTable).
+		AddTag(marketTag, balance.MarketID).
+		AddField(baseBalanceSats, bBalance).
+		AddField(quoteBalanceSats, qBalance).
+		SetTime(balance.Time)
+	if balance.SnapshotID != "" {
+		p.AddField(snapshotID, balance.SnapshotID)
+	}
+
+	return p, nil
+}
+
 func (i *influxDbService) GetBalancesForMarkets(
 	ctx context.Context,
 	startTime time.Time,
@@ -44,22 +76,33 @@ func (i *influxDbService) GetBalancesForMarkets(
 	pagination := fmt.Sprintf("|> limit(n: %v, offset: %v)", limit, offset)
 	marketIDsFilter := createMarkedIDsFluxQueryFilter(marketIDs, MarketBalanceTable)
 	queryAPI := i.client.QueryAPI(i.org)
+	aggregate := "data |> schema.fieldsAsCols()"
+	// balances are stored as integers, to keep means exact they are
+	//calculated from sum and count of every window, sums are exact as long
+	//as they fit an uint64
 	if groupBy != "" {
-		groupBy = fmt.Sprintf("|> aggregateWindow(every: %s, fn: mean)", groupBy)
+		aggregate = fmt.Sprintf(
+			"union(tables: ["+
+				"data |> aggregateWindow(every: %s, fn: sum) |> set(key: \"aggregate\", value: \"sum\"),"+
+				"data |> aggregateWindow(every: %s, fn: count) |> set(key: \"aggregate\", value: \"count\")"+
+				"])"+
+				"|> pivot(rowKey: [\"_time\"], columnKey: [\"_field\", \"aggregate\"], valueColumn: \"_value\")",
+			groupBy,
+			groupBy,
+		)
 	}
 	query := fmt.Sprintf(
-		"import \"influxdata/influxdb/schema\" from(bucket:\"%s\")"+
+		"import \"influxdata/influxdb/schema\" data = from(bucket:\"%s\")"+
 			"|> range(start: %s, stop: %s)"+
 			"|> filter(fn: (r) => %s)"+
-			"%s"+
-			"|> schema.fieldsAsCols()"+
+			" %s"+
 			"%s"+
 			"|> sort()",
 		i.analyticsBucket,
 		startTime.Format(time.RFC3339),
 		endTime.Format(time.RFC3339),
 		marketIDsFilter,
-		groupBy,
+		aggregate,
 		pagination,
 	)
 	result, err := queryAPI.Query(
@@ -72,21 +115,24 @@ func (i *influxDbService) GetBalancesForMarkets(
 
 	response := make(map[string][]domain.MarketBalance)
 	for result.Next() {
-		marketID := result.Record().ValueByKey(marketTag).(string)
-		bBalance := decimal.NewFromFloat(0)
-		if result.Record().ValueByKey(baseBalance) != nil {
-			bBalance = decimal.NewFromFloat(result.Record().ValueByKey(baseBalance).(float64))
-		}
-		qBalance := decimal.NewFromFloat(0)
-		if result.Record().ValueByKey(quoteBalance) != nil {
-			qBalance = decimal.NewFromFloat(result.Record().ValueByKey(quoteBalance).(float64))
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+		values := record.Values()
+
+		var bBalance, qBalance decimal.Decimal
+		if groupBy != "" {
+			bBalance = meanBalanceByKey(values, baseBalanceSats, baseBalance)
+			qBalance = meanBalanceByKey(values, quoteBalanceSats, quoteBalance)
+		} else {
+			bBalance = balanceByKey(values, baseBalanceSats, baseBalance)
+			qBalance = balanceByKey(values, quoteBalanceSats, quoteBalance)
 		}
 
 		marketBalance := domain.MarketBalance{
 			MarketID:     marketID,
 			BaseBalance:  bBalance,
 			QuoteBalance: qBalance,
-			Time:         result.Record().Time(),
+			Time:         record.Time(),
 		}
 		val, ok := response[marketID]
 		if !ok {
@@ -102,12 +148,143 @@ func (i *influxDbService) GetBalancesForMarkets(
 	return response, nil
 }
 
+func (i *influxDbService) GetLatestBalances(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketBalance, error) {
+	marketIDsFilter := createMarkedIDsFluxQueryFilter(marketIDs, MarketBalanceTable)
+	// the last points of integer and legacy float fields can have different
+	//times, only the most recent row of every market is kept once pivoted
+	query := fmt.Sprintf(
+		"import \"influxdata/influxdb/schema\" from(bucket:\"%s\")"+
+			"|> range(start: 0)"+
+			"|> filter(fn: (r) => %s)"+
+			"|> last()"+
+			"|> schema.fieldsAsCols()"+
+			"|> group(columns: [\"%s\"])"+
+			"|> sort(columns: [\"_time\"])"+
+			"|> last(column: \"_time\")",
+		i.analyticsBucket,
+		marketIDsFilter,
+		marketTag,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string]domain.MarketBalance)
+	for result.Next() {
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+
+		response[marketID] = domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  balanceByKey(record.Values(), baseBalanceSats, baseBalance),
+			QuoteBalance: balanceByKey(record.Values(), quoteBalanceSats, quoteBalance),
+			Time:         record.Time(),
+		}
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return response, nil
+}
+
+func (i *influxDbService) MigrateBalances(ctx context.Context) error {
+	query := fmt.Sprintf(
+		"import \"math\" from(bucket:\"%s\")"+
+			"|> range(start: 0)"+
+			"|> filter(fn: (r) => %s)"+
+			"|> map(fn: (r) => ({r with _field: r._field + \"_sats\", _value: uint(v: math.round(x: r._value))}))"+
+			"|> to(bucket: \"%s\", org: \"%s\")",
+		i.analyticsBucket,
+		createMarkedIDsAndFieldsFluxQueryFilter(
+			nil, MarketBalanceTable, baseBalance, quoteBalance,
+		),
+		i.analyticsBucket,
+		i.org,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	// rows written by to() are returned and must be consumed for the query
+	//to complete
+	for result.Next() {
+	}
+
+	return result.Err()
+}
+
+// balanceToSats returns balance as the integer value stored in db
+func balanceToSats(balance decimal.Decimal) (uint64, error) {
+	if balance.IsNegative() || !balance.Equal(balance.Truncate(0)) {
+		return 0, ErrInvalidBalance
+	}
+
+	sats := balance.BigInt()
+	if !sats.IsUint64() {
+		return 0, ErrInvalidBalance
+	}
+
+	return sats.Uint64(), nil
+}
+
+// balanceByKey returns the integer balance of the record falling back to the
+// float one, rounded to the closest satoshi, for data not yet migrated
+func balanceByKey(
+	values map[string]interface{},
+	key, legacyKey string,
+) decimal.Decimal {
+	if value := values[key]; value != nil {
+		return decimalValueByKey(value)
+	}
+
+	return decimalValueByKey(values[legacyKey]).Round(0)
+}
+
+// meanBalanceByKey returns the mean balance of an aggregation window rounded
+// to the closest satoshi, the float balance is used if no integer one exists
+// in window
+func meanBalanceByKey(
+	values map[string]interface{},
+	key, legacyKey string,
+) decimal.Decimal {
+	for _, k := range []string{key, legacyKey} {
+		count := decimalValueByKey(values[k+countSuffix])
+		if count.IsPositive() {
+			sum := decimalValueByKey(values[k+sumSuffix])
+			return sum.Div(count).Round(0)
+		}
+	}
+
+	return decimal.Zero
+}
+
 func createMarkedIDsFluxQueryFilter(marketIDs []string, table string) string {
-	query := fmt.Sprintf("(r._measurement == \"%v\"", table)
-	fieldsFilter := "and (r._field == \"base_price\" or r._field == \"quote_price\")"
+	fields := []string{basePrice, quotePrice}
 	if table == MarketBalanceTable {
-		fieldsFilter = "and (r._field == \"base_balance\" or r._field == \"quote_balance\")"
+		fields = []string{baseBalance, quoteBalance, baseBalanceSats, quoteBalanceSats}
 	}
+
+	return createMarkedIDsAndFieldsFluxQueryFilter(marketIDs, table, fields...)
+}
+
+func createMarkedIDsAndFieldsFluxQueryFilter(
+	marketIDs []string,
+	table string,
+	fields ...string,
+) string {
+	query := fmt.Sprintf("(r._measurement == \"%v\"", table)
+
+	fieldsConditions := make([]string, 0, len(fields))
+	for _, v := range fields {
+		fieldsConditions = append(fieldsConditions, fmt.Sprintf("r._field == \"%v\"", v))
+	}
+	fieldsFilter := fmt.Sprintf("and (%v)", strings.Join(fieldsConditions, " or "))
+
 	for i, v := range marketIDs {
 		if i == 0 {
 			query = fmt.Sprintf("%v and r.market_id==\"%v\" %v)", query, v, fieldsFilter)
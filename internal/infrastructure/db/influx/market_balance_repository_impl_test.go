@@ -1,6 +1,9 @@
 package dbinflux
 
-import "testing"
+import (
+	"github.com/shopspring/decimal"
+	"testing"
+)
 
 func Test_createMarkedIDsFluxQueryFilter(t *testing.T) {
 	type args struct {
@@ -26,7 +29,7 @@ func Test_createMarkedIDsFluxQueryFilter(t *testing.T) {
 				marketIDs: []string{"2"},
 				table:     "market_balance",
 			},
-			want: "(r._measurement == \"market_balance\" and r.market_id==\"2\" and (r._field == \"base_balance\" or r._field == \"quote_balance\"))",
+			want: "(r._measurement == \"market_balance\" and r.market_id==\"2\" and (r._field == \"base_balance\" or r._field == \"quote_balance\" or r._field == \"base_balance_sats\" or r._field == \"quote_balance_sats\"))",
 		},
 		{
 			name: "multiple market_ids",
@@ -34,7 +37,7 @@ func Test_createMarkedIDsFluxQueryFilter(t *testing.T) {
 				marketIDs: []string{"2", "3", "4"},
 				table:     "market_balance",
 			},
-			want: "(r._measurement == \"market_balance\" and r.market_id==\"2\" and (r._field == \"base_balance\" or r._field == \"quote_balance\")) or (r._measurement == \"market_balance\" and r.market_id==\"3\" and (r._field == \"base_balance\" or r._field == \"quote_balance\")) or (r._measurement == \"market_balance\" and r.market_id==\"4\" and (r._field == \"base_balance\" or r._field == \"quote_balance\"))",
+			want: "(r._measurement == \"market_balance\" and r.market_id==\"2\" and (r._field == \"base_balance\" or r._field == \"quote_balance\" or r._field == \"base_balance_sats\" or r._field == \"quote_balance_sats\")) or (r._measurement == \"market_balance\" and r.market_id==\"3\" and (r._field == \"base_balance\" or r._field == \"quote_balance\" or r._field == \"base_balance_sats\" or r._field == \"quote_balance_sats\")) or (r._measurement == \"market_balance\" and r.market_id==\"4\" and (r._field == \"base_balance\" or r._field == \"quote_balance\" or r._field == \"base_balance_sats\" or r._field == \"quote_balance_sats\"))",
 		},
 		{
 			name: "multiple market_ids, price table",
@@ -53,3 +56,180 @@ func Test_createMarkedIDsFluxQueryFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_createMarkedIDsAndFieldsFluxQueryFilter(t *testing.T) {
+	type args struct {
+		marketIDs []string
+		table     string
+		fields    []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no market_id, single field",
+			args: args{
+				marketIDs: []string{},
+				table:     "market_price",
+				fields:    []string{"quote_price"},
+			},
+			want: "(r._measurement == \"market_price\" and (r._field == \"quote_price\"))",
+		},
+		{
+			name: "multiple market_ids, single field",
+			args: args{
+				marketIDs: []string{"2", "3"},
+				table:     "market_price",
+				fields:    []string{"quote_price"},
+			},
+			want: "(r._measurement == \"market_price\" and r.market_id==\"2\" and (r._field == \"quote_price\")) or (r._measurement == \"market_price\" and r.market_id==\"3\" and (r._field == \"quote_price\"))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createMarkedIDsAndFieldsFluxQueryFilter(tt.args.marketIDs, tt.args.table, tt.args.fields...); got != tt.want {
+				t.Errorf("createMarkedIDsAndFieldsFluxQueryFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_balanceToSats(t *testing.T) {
+	maxUint64, _ := decimal.NewFromString("18446744073709551615")
+	tests := []struct {
+		name    string
+		balance decimal.Decimal
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name:    "zero",
+			balance: decimal.Zero,
+			want:    0,
+		},
+		{
+			name:    "max uint64",
+			balance: maxUint64,
+			want:    18446744073709551615,
+		},
+		{
+			name:    "above float64 precision",
+			balance: decimal.NewFromInt(9007199254740993),
+			want:    9007199254740993,
+		},
+		{
+			name:    "overflow",
+			balance: maxUint64.Add(decimal.NewFromInt(1)),
+			wantErr: true,
+		},
+		{
+			name:    "negative",
+			balance: decimal.NewFromInt(-1),
+			wantErr: true,
+		},
+		{
+			name:    "fractional",
+			balance: decimal.NewFromFloat(1.5),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := balanceToSats(tt.balance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("balanceToSats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("balanceToSats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_balanceByKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   string
+	}{
+		{
+			name: "integer balance",
+			values: map[string]interface{}{
+				"base_balance_sats": uint64(18446744073709551615),
+			},
+			want: "18446744073709551615",
+		},
+		{
+			name: "legacy float balance",
+			values: map[string]interface{}{
+				"base_balance": 100000000.4,
+			},
+			want: "100000000",
+		},
+		{
+			name:   "missing balance",
+			values: map[string]interface{}{},
+			want:   "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := balanceByKey(tt.values, "base_balance_sats", "base_balance")
+			if got.String() != tt.want {
+				t.Errorf("balanceByKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_meanBalanceByKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   string
+	}{
+		{
+			name: "integer balance",
+			values: map[string]interface{}{
+				"base_balance_sats_sum":   uint64(18446744073709551614),
+				"base_balance_sats_count": int64(2),
+			},
+			want: "9223372036854775807",
+		},
+		{
+			name: "rounded to closest satoshi",
+			values: map[string]interface{}{
+				"base_balance_sats_sum":   uint64(5),
+				"base_balance_sats_count": int64(3),
+			},
+			want: "2",
+		},
+		{
+			name: "legacy float balance",
+			values: map[string]interface{}{
+				"base_balance_sats_count": int64(0),
+				"base_balance_sum":        float64(300),
+				"base_balance_count":      int64(2),
+			},
+			want: "150",
+		},
+		{
+			name: "empty window",
+			values: map[string]interface{}{
+				"base_balance_sats_count": int64(0),
+			},
+			want: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := meanBalanceByKey(tt.values, "base_balance_sats", "base_balance")
+			if got.String() != tt.want {
+				t.Errorf("meanBalanceByKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
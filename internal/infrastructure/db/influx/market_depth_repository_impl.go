@@ -0,0 +1,96 @@
+package dbinflux
+
+import (
+	"context"
+	"fmt"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (i *influxDbService) InsertDepth(
+	ctx context.Context,
+	depth []domain.MarketDepth,
+) error {
+	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
+
+	for _, v := range depth {
+		priceF, _ := v.Price.Float64()
+
+		p := influxdb2.NewPointWithMeasurement(MarketDepthTable).
+			AddTag(marketTag, v.MarketID).
+			AddTag(tradeTypeTag, v.TradeType).
+			AddTag(amountTag, v.Amount.String()).
+			AddField(depthPrice, priceF).
+			SetTime(v.Time)
+
+		writeAPI.WritePoint(p)
+	}
+
+	writeAPI.Flush()
+
+	return nil
+}
+
+func (i *influxDbService) GetDepthForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketDepth, error) {
+	marketIDsFilter := createMarkedIDsAndFieldsFluxQueryFilter(
+		marketIDs,
+		MarketDepthTable,
+		depthPrice,
+	)
+	if groupBy != "" {
+		groupBy = fmt.Sprintf(
+			"|> aggregateWindow(every: %s, fn: mean, createEmpty: false)", groupBy,
+		)
+	}
+	query := fmt.Sprintf(
+		"from(bucket:\"%s\")"+
+			"|> range(start: %s, stop: %s)"+
+			"|> filter(fn: (r) => %s)"+
+			"%v"+
+			"|> group(columns: [\"%s\"])"+
+			"|> sort(columns: [\"_time\"])",
+		i.analyticsBucket,
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+		marketIDsFilter,
+		groupBy,
+		marketTag,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketDepth)
+	for result.Next() {
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+		tradeType, _ := record.ValueByKey(tradeTypeTag).(string)
+		amountStr, _ := record.ValueByKey(amountTag).(string)
+		amount, err := decimal.NewFromString(amountStr)
+		if err != nil {
+			return nil, err
+		}
+
+		response[marketID] = append(response[marketID], domain.MarketDepth{
+			MarketID:  marketID,
+			TradeType: tradeType,
+			Amount:    amount,
+			Price:     decimalValueByKey(record.Value()),
+			Time:      record.Time(),
+		})
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return response, nil
+}
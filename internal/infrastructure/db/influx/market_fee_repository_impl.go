@@ -0,0 +1,110 @@
+package dbinflux
+
+import (
+	"context"
+	"fmt"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (i *influxDbService) InsertFee(
+	ctx context.Context,
+	fee domain.MarketFee,
+) error {
+	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
+
+	basePercentageFeeF, _ := fee.BasePercentageFee.Float64()
+	quotePercentageFeeF, _ := fee.QuotePercentageFee.Float64()
+	baseFixedFeeF, _ := fee.BaseFixedFee.Float64()
+	quoteFixedFeeF, _ := fee.QuoteFixedFee.Float64()
+	previewFeeAmountF, _ := fee.PreviewFeeAmount.Float64()
+
+	p := influxdb2.NewPointWithMeasurement(MarketFeeTable).
+		AddTag(marketTag, fee.MarketID).
+		AddField(basePercentageFee, basePercentageFeeF).
+		AddField(quotePercentageFee, quotePercentageFeeF).
+		AddField(baseFixedFee, baseFixedFeeF).
+		AddField(quoteFixedFee, quoteFixedFeeF).
+		AddField(previewFeeAmount, previewFeeAmountF).
+		AddField(previewFeeAsset, fee.PreviewFeeAsset).
+		SetTime(fee.Time)
+
+	writeAPI.WritePoint(p)
+
+	writeAPI.Flush()
+
+	return nil
+}
+
+// GetFeesForMarkets returns fees of each market, if groupBy is passed the
+// last fee of every window of groupBy duration is returned
+func (i *influxDbService) GetFeesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketFee, error) {
+	limit := page.Size
+	offset := page.Number*page.Size - page.Size
+	pagination := fmt.Sprintf("|> limit(n: %v, offset: %v)", limit, offset)
+	marketIDsFilter := createMarkedIDsAndFieldsFluxQueryFilter(
+		marketIDs,
+		MarketFeeTable,
+		basePercentageFee,
+		quotePercentageFee,
+		baseFixedFee,
+		quoteFixedFee,
+		previewFeeAmount,
+		previewFeeAsset,
+	)
+	if groupBy != "" {
+		groupBy = fmt.Sprintf(
+			"|> aggregateWindow(every: %s, fn: last, createEmpty: false)", groupBy,
+		)
+	}
+	query := fmt.Sprintf(
+		"import \"influxdata/influxdb/schema\" from(bucket:\"%s\")"+
+			"|> range(start: %s, stop: %s)"+
+			"|> filter(fn: (r) => %s)"+
+			"%v"+
+			"|> schema.fieldsAsCols()"+
+			"%s"+
+			"|> sort()",
+		i.analyticsBucket,
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+		marketIDsFilter,
+		groupBy,
+		pagination,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketFee)
+	for result.Next() {
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+
+		feeAsset, _ := record.ValueByKey(previewFeeAsset).(string)
+		response[marketID] = append(response[marketID], domain.MarketFee{
+			MarketID:           marketID,
+			BasePercentageFee:  decimalValueByKey(record.ValueByKey(basePercentageFee)),
+			QuotePercentageFee: decimalValueByKey(record.ValueByKey(quotePercentageFee)),
+			BaseFixedFee:       decimalValueByKey(record.ValueByKey(baseFixedFee)),
+			QuoteFixedFee:      decimalValueByKey(record.ValueByKey(quoteFixedFee)),
+			PreviewFeeAmount:   decimalValueByKey(record.ValueByKey(previewFeeAmount)),
+			PreviewFeeAsset:    feeAsset,
+			Time:               record.Time(),
+		})
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return response, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"math/big"
 	"strings"
 	"time"
 )
@@ -16,6 +18,14 @@ func (i *influxDbService) InsertPrice(
 ) error {
 	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
 
+	writeAPI.WritePoint(pricePoint(price))
+
+	writeAPI.Flush()
+
+	return nil
+}
+
+func pricePoint(price domain.MarketPrice) *write.Point {
 	basePriceF, _ := price.BasePrice.BigFloat().Float64()
 	quotePriceF, _ := price.QuotePrice.BigFloat().Float64()
 
@@ -24,12 +34,11 @@ func (i *influxDbService) InsertPrice(
 		AddField(basePrice, basePriceF).
 		AddField(quotePrice, quotePriceF).
 		SetTime(price.Time)
+	if price.SnapshotID != "" {
+		p.AddField(snapshotID, price.SnapshotID)
+	}
 
-	writeAPI.WritePoint(p)
-
-	writeAPI.Flush()
-
-	return nil
+	return p
 }
 
 func (i *influxDbService) GetPricesForMarkets(
@@ -103,10 +112,156 @@ func (i *influxDbService) GetPricesForMarkets(
 	return response, nil
 }
 
-// CalculateVWAP calculates the Volume Weighted Average Price (VWAP) for the given market IDs within the specified time range.
+func (i *influxDbService) GetLatestPrices(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketPrice, error) {
+	marketIDsFilter := createMarkedIDsFluxQueryFilter(marketIDs, MarketPriceTable)
+	query := fmt.Sprintf(
+		"import \"influxdata/influxdb/schema\" from(bucket:\"%s\")"+
+			"|> range(start: 0)"+
+			"|> filter(fn: (r) => %s)"+
+			"|> last()"+
+			"|> schema.fieldsAsCols()",
+		i.analyticsBucket,
+		marketIDsFilter,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string]domain.MarketPrice)
+	for result.Next() {
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+
+		response[marketID] = domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimalValueByKey(record.ValueByKey(basePrice)),
+			QuotePrice: decimalValueByKey(record.ValueByKey(quotePrice)),
+			Time:       record.Time(),
+		}
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return response, nil
+}
+
+// GetCandlesForMarkets returns open, high, low and close quote prices for
+// each market, grouped in windows of groupBy duration
+func (i *influxDbService) GetCandlesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketCandle, error) {
+	limit := page.Size
+	offset := page.Number*page.Size - page.Size
+	marketIDsFilter := createMarkedIDsAndFieldsFluxQueryFilter(
+		marketIDs,
+		MarketPriceTable,
+		quotePrice,
+	)
+
+	// every candle is built from the quote price of each window by merging
+	//first, max, min and last values into a single row
+	candlesTemplate := `
+	data = from(bucket: "%s")
+	|> range(start: %s, stop: %s)
+	|> filter(fn: (r) => %s)
+
+	open = data
+	|> aggregateWindow(every: %s, fn: first, createEmpty: false)
+	|> set(key: "_field", value: "%s")
+
+	high = data
+	|> aggregateWindow(every: %s, fn: max, createEmpty: false)
+	|> set(key: "_field", value: "%s")
+
+	low = data
+	|> aggregateWindow(every: %s, fn: min, createEmpty: false)
+	|> set(key: "_field", value: "%s")
+
+	close = data
+	|> aggregateWindow(every: %s, fn: last, createEmpty: false)
+	|> set(key: "_field", value: "%s")
+
+	union(tables: [open, high, low, close])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> group(columns: ["market_id"])
+	|> sort(columns: ["_time"])
+	|> limit(n: %v, offset: %v)`
+
+	query := fmt.Sprintf(
+		candlesTemplate,
+		i.analyticsBucket,
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+		marketIDsFilter,
+		groupBy,
+		candleOpen,
+		groupBy,
+		candleHigh,
+		groupBy,
+		candleLow,
+		groupBy,
+		candleClose,
+		limit,
+		offset,
+	)
+
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketCandle)
+	for result.Next() {
+		record := result.Record()
+		marketID := record.ValueByKey(marketTag).(string)
+
+		marketCandle := domain.MarketCandle{
+			MarketID: marketID,
+			Open:     decimalValueByKey(record.ValueByKey(candleOpen)),
+			High:     decimalValueByKey(record.ValueByKey(candleHigh)),
+			Low:      decimalValueByKey(record.ValueByKey(candleLow)),
+			Close:    decimalValueByKey(record.ValueByKey(candleClose)),
+			Time:     record.Time(),
+		}
+		response[marketID] = append(response[marketID], marketCandle)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return response, nil
+}
+
+func decimalValueByKey(value interface{}) decimal.Decimal {
+	switch v := value.(type) {
+	case float64:
+		return decimal.NewFromFloat(v)
+	case int64:
+		return decimal.NewFromInt(v)
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(v), 0)
+	}
+
+	return decimal.Zero
+}
+
+// CalculateVWAP calculates the Volume Weighted Average Price (VWAP) for the
+// given market IDs within the specified time range, as sum of products of
+// quote price and base balance of the same snapshot, or of the same window
+// for the ones fetched separately, over sum of base balances
 func (i *influxDbService) CalculateVWAP(
 	ctx context.Context,
-	aggregationWindow string,
+	averageWindow string,
 	startTime time.Time,
 	endTime time.Time,
 	marketIDs ...string,
@@ -115,88 +270,96 @@ func (i *influxDbService) CalculateVWAP(
 		`["%s"]`, strings.Join(marketIDs, `","`),
 	)
 
-	// The FluxDB query below calculates the sum of products of price and
-	//balance for each market and each time window
-	priceBalanceProductSumTemplate := `
-	market_ids = %s
+	// The FluxDB query below pairs prices and balances of the same snapshot
+	//by snapshot id, and the ones fetched separately by previous versions by
+	//the mean of every window, then sums the products of price and balance
+	//and the balances of every pair. Balances stored both as integers and as
+	//floats by previous versions are pivoted in one row, preferring integers
+	vwapTemplate := `
+	market_ids = %[1]s
 
-	balanceStream = from(bucket: "analytics")
-	|> range(start: %s, stop: %s)
+	balanceStream = from(bucket: "%[2]s")
+	|> range(start: %[3]s, stop: %[4]s)
 	|> filter(fn: (r) =>
 		r._measurement == "market_balance" and
-		r._field == "base_balance" and
+		(r._field == "base_balance" or r._field == "base_balance_sats" or r._field == "snapshot_id") and
 		contains(value: r.market_id, set: market_ids)
 	)
-	|> aggregateWindow(every: %s, fn: mean, createEmpty: false)
-	|> sort(columns: ["_time"])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> map(fn: (r) => ({r with
+		_value: if exists r.base_balance_sats then float(v: r.base_balance_sats) else float(v: r.base_balance),
+		snapshot_id: if exists r.snapshot_id then r.snapshot_id else ""
+	}))
+	|> group(columns: ["market_id"])
 
-	priceStream = from(bucket: "analytics")
-	|> range(start: %s, stop: %s)
+	priceStream = from(bucket: "%[2]s")
+	|> range(start: %[3]s, stop: %[4]s)
 	|> filter(fn: (r) =>
 		r._measurement == "market_price" and
-		r._field == "quote_price" and
+		(r._field == "quote_price" or r._field == "snapshot_id") and
 		contains(value: r.market_id, set: market_ids)
 	)
-	|> aggregateWindow(every: %s, fn: mean, createEmpty: false)
-	|> sort(columns: ["_time"])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> map(fn: (r) => ({r with
+		_value: float(v: r.quote_price),
+		snapshot_id: if exists r.snapshot_id then r.snapshot_id else ""
+	}))
+	|> group(columns: ["market_id"])
 
-	join(tables: {balance: balanceStream, price: priceStream}, on: ["market_id", "_time"])
+	snapshotPairs = join(
+		tables: {
+			balance: balanceStream |> filter(fn: (r) => r.snapshot_id != ""),
+			price: priceStream |> filter(fn: (r) => r.snapshot_id != "")
+		},
+		on: ["market_id", "snapshot_id"]
+	)
 	|> map(fn: (r) => ({
-		_time: r._time,
 		market_id: r.market_id,
-		VWAP: r._value_price * r._value_balance
-	})
-	)
-	|> group()
-	|> sum(column: "VWAP")`
+		vwap: r._value_price * r._value_balance,
+		balance: r._value_balance
+	}))
 
-	priceBalanceProductSumQuery := fmt.Sprintf(
-		priceBalanceProductSumTemplate,
-		marketIdsFiler,
-		startTime.Format(time.RFC3339),
-		endTime.Format(time.RFC3339),
-		aggregationWindow,
-		startTime.Format(time.RFC3339),
-		endTime.Format(time.RFC3339),
-		aggregationWindow,
+	windowPairs = join(
+		tables: {
+			balance: balanceStream
+			|> filter(fn: (r) => r.snapshot_id == "")
+			|> keep(columns: ["_start", "_stop", "_time", "_value", "market_id"])
+			|> sort(columns: ["_time"])
+			|> aggregateWindow(every: %[5]s, fn: mean, createEmpty: false),
+			price: priceStream
+			|> filter(fn: (r) => r.snapshot_id == "")
+			|> keep(columns: ["_start", "_stop", "_time", "_value", "market_id"])
+			|> sort(columns: ["_time"])
+			|> aggregateWindow(every: %[5]s, fn: mean, createEmpty: false)
+		},
+		on: ["market_id", "_time"]
 	)
+	|> map(fn: (r) => ({
+		market_id: r.market_id,
+		vwap: r._value_price * r._value_balance,
+		balance: r._value_balance
+	}))
+
+	union(tables: [snapshotPairs, windowPairs])
+	|> group()
+	|> reduce(
+		identity: {vwap_sum: 0.0, balance_sum: 0.0},
+		fn: (r, accumulator) => ({
+			vwap_sum: accumulator.vwap_sum + r.vwap,
+			balance_sum: accumulator.balance_sum + r.balance
+		})
+	)`
 
-	// The FluxDB query below calculates the total balance for each market
-	balanceSumTemplate := `
-	market_ids = %s
-
-	totalBalanceStream = from(bucket: "analytics")
-    |> range(start: %s, stop: %s)
-    |> filter(fn: (r) =>
-    	r._measurement == "market_balance" and
-    	r._field == "base_balance" and
-    	contains(value: r.market_id, set: market_ids)
-  	)
-	|> aggregateWindow(every: %s, fn: mean, createEmpty: false)
-  	|> group()
-	|> sum()
-	|> yield()`
-
-	balanceSumQuery := fmt.Sprintf(
-		balanceSumTemplate,
+	vwapQuery := fmt.Sprintf(
+		vwapTemplate,
 		marketIdsFiler,
+		i.analyticsBucket,
 		startTime.Format(time.RFC3339),
 		endTime.Format(time.RFC3339),
-		aggregationWindow,
+		averageWindow,
 	)
 
-	priceBalanceProductSumResult, err := i.client.QueryAPI(i.org).Query(
-		ctx,
-		priceBalanceProductSumQuery,
-	)
-	if err != nil {
-		return decimal.Zero, err
-	}
-
-	balanceSumResult, err := i.client.QueryAPI(i.org).Query(
-		ctx,
-		balanceSumQuery,
-	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, vwapQuery)
 	if err != nil {
 		return decimal.Zero, err
 	}
@@ -204,11 +367,12 @@ func (i *influxDbService) CalculateVWAP(
 	var vwapSum decimal.Decimal
 	var balanceSum decimal.Decimal
 
-	for priceBalanceProductSumResult.Next() {
-		vwapSum = decimal.NewFromFloat(priceBalanceProductSumResult.Record().ValueByKey("VWAP").(float64))
+	for result.Next() {
+		vwapSum = decimalValueByKey(result.Record().ValueByKey("vwap_sum"))
+		balanceSum = decimalValueByKey(result.Record().ValueByKey("balance_sum"))
 	}
-	for balanceSumResult.Next() {
-		balanceSum = decimal.NewFromFloat(balanceSumResult.Record().Value().(float64))
+	if result.Err() != nil {
+		return decimal.Zero, result.Err()
 	}
 
 	if vwapSum.IsZero() || balanceSum.IsZero() {
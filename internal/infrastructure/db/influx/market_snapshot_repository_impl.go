@@ -0,0 +1,23 @@
+package dbinflux
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+// InsertSnapshot writes price and balance in a single blocking request, so
+// that either both or none of them are stored
+func (i *influxDbService) InsertSnapshot(
+	ctx context.Context,
+	snapshot domain.MarketSnapshot,
+) error {
+	balance, err := balancePoint(snapshot.Balance)
+	if err != nil {
+		return err
+	}
+
+	writeAPI := i.client.WriteAPIBlocking(i.org, i.analyticsBucket)
+
+	return writeAPI.WritePoint(ctx, pricePoint(snapshot.Price), balance)
+}
@@ -0,0 +1,75 @@
+package dbinflux
+
+import (
+	"context"
+	"fmt"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"strings"
+	"time"
+)
+
+func (i *influxDbService) InsertRate(
+	ctx context.Context,
+	rate domain.Rate,
+) error {
+	writeAPI := i.client.WriteAPI(i.org, i.analyticsBucket)
+
+	rateF, _ := rate.Value.BigFloat().Float64()
+
+	p := influxdb2.NewPointWithMeasurement(RateTable).
+		AddTag(rateSourceTag, strings.ToLower(rate.Source)).
+		AddTag(rateTargetTag, strings.ToLower(rate.Target)).
+		AddField(rateValue, rateF).
+		SetTime(rate.Time)
+
+	writeAPI.WritePoint(p)
+
+	writeAPI.Flush()
+
+	return nil
+}
+
+func (i *influxDbService) GetRates(
+	ctx context.Context,
+	source string,
+	target string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]domain.Rate, error) {
+	query := fmt.Sprintf(
+		"from(bucket:\"%s\")"+
+			"|> range(start: %s, stop: %s)"+
+			"|> filter(fn: (r) => r._measurement == \"%s\" and "+
+			"r.%s == \"%s\" and r.%s == \"%s\" and r._field == \"%s\")"+
+			"|> sort(columns: [\"_time\"])",
+		i.analyticsBucket,
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+		RateTable,
+		rateSourceTag,
+		strings.ToLower(source),
+		rateTargetTag,
+		strings.ToLower(target),
+		rateValue,
+	)
+	result, err := i.client.QueryAPI(i.org).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]domain.Rate, 0)
+	for result.Next() {
+		rates = append(rates, domain.Rate{
+			Source: source,
+			Target: target,
+			Value:  decimalValueByKey(result.Record().Value()),
+			Time:   result.Record().Time(),
+		})
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return rates, nil
+}
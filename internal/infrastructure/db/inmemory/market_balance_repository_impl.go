@@ -0,0 +1,138 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+)
+
+var (
+	ErrInvalidBalance = errors.New(
+		"balance must be a non negative integer amount of satoshis",
+	)
+)
+
+type inMemoryMarketBalanceRepository struct {
+	mtx      *sync.RWMutex
+	balances map[string][]domain.MarketBalance
+}
+
+func NewMarketBalanceRepository() domain.MarketBalanceRepository {
+	return &inMemoryMarketBalanceRepository{
+		mtx:      &sync.RWMutex{},
+		balances: make(map[string][]domain.MarketBalance),
+	}
+}
+
+func (m *inMemoryMarketBalanceRepository) InsertBalance(
+	ctx context.Context,
+	balance domain.MarketBalance,
+) error {
+	for _, v := range []decimal.Decimal{balance.BaseBalance, balance.QuoteBalance} {
+		if v.IsNegative() || !v.Equal(v.Truncate(0)) {
+			return ErrInvalidBalance
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	balances := m.balances[balance.MarketID]
+	i := sort.Search(len(balances), func(i int) bool {
+		return !balances[i].Time.Before(balance.Time)
+	})
+	// like the other dbs, a balance recorded at the same time is overwritten
+	if i < len(balances) && balances[i].Time.Equal(balance.Time) {
+		balances[i] = balance
+		return nil
+	}
+
+	balances = append(balances, domain.MarketBalance{})
+	copy(balances[i+1:], balances[i:])
+	balances[i] = balance
+	m.balances[balance.MarketID] = balances
+
+	return nil
+}
+
+func (m *inMemoryMarketBalanceRepository) GetBalancesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketBalance, error) {
+	balances := m.getBalances(startTime, endTime, marketIDs)
+
+	response := make(map[string][]domain.MarketBalance)
+	for marketID, v := range balances {
+		if groupBy != "" {
+			var err error
+			v, err = timeseries.MeanBalances(v, groupBy, endTime)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		from, to := timeseries.PageBounds(len(v), page)
+		if from < to {
+			response[marketID] = v[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+func (m *inMemoryMarketBalanceRepository) GetLatestBalances(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketBalance, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	response := make(map[string]domain.MarketBalance)
+	for marketID, v := range m.balances {
+		if timeseries.MatchMarket(marketID, marketIDs) && len(v) > 0 {
+			response[marketID] = v[len(v)-1]
+		}
+	}
+
+	return response, nil
+}
+
+// getBalances returns the balances of every market recorded in the given
+// time range, sorted by time ASC
+func (m *inMemoryMarketBalanceRepository) getBalances(
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs []string,
+) map[string][]domain.MarketBalance {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	balances := make(map[string][]domain.MarketBalance)
+	for marketID, v := range m.balances {
+		if !timeseries.MatchMarket(marketID, marketIDs) {
+			continue
+		}
+
+		from := sort.Search(len(v), func(i int) bool {
+			return !v[i].Time.Before(startTime)
+		})
+		to := sort.Search(len(v), func(i int) bool {
+			return !v[i].Time.Before(endTime)
+		})
+		if from < to {
+			balances[marketID] = append([]domain.MarketBalance{}, v[from:to]...)
+		}
+	}
+
+	return balances
+}
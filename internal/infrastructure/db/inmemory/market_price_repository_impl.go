@@ -0,0 +1,197 @@
+package inmemory
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+)
+
+// allPoints is the page including all points of a time series
+var allPoints = domain.Page{Number: 1, Size: math.MaxInt32}
+
+type inMemoryMarketPriceRepository struct {
+	mtx    *sync.RWMutex
+	prices map[string][]domain.MarketPrice
+	// balanceRepository provides the balances used to calculate VWAP
+	balanceRepository domain.MarketBalanceRepository
+}
+
+// NewMarketPriceRepository returns a price repository that calculates VWAP
+// with the balances stored in balanceRepository
+func NewMarketPriceRepository(
+	balanceRepository domain.MarketBalanceRepository,
+) domain.MarketPriceRepository {
+	return &inMemoryMarketPriceRepository{
+		mtx:               &sync.RWMutex{},
+		prices:            make(map[string][]domain.MarketPrice),
+		balanceRepository: balanceRepository,
+	}
+}
+
+func (m *inMemoryMarketPriceRepository) InsertPrice(
+	ctx context.Context,
+	price domain.MarketPrice,
+) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	prices := m.prices[price.MarketID]
+	i := sort.Search(len(prices), func(i int) bool {
+		return !prices[i].Time.Before(price.Time)
+	})
+	// like the other dbs, a price recorded at the same time is overwritten
+	if i < len(prices) && prices[i].Time.Equal(price.Time) {
+		prices[i] = price
+		return nil
+	}
+
+	prices = append(prices, domain.MarketPrice{})
+	copy(prices[i+1:], prices[i:])
+	prices[i] = price
+	m.prices[price.MarketID] = prices
+
+	return nil
+}
+
+func (m *inMemoryMarketPriceRepository) GetPricesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketPrice, error) {
+	prices := m.getPrices(startTime, endTime, marketIDs)
+
+	response := make(map[string][]domain.MarketPrice)
+	for marketID, v := range prices {
+		if groupBy != "" {
+			var err error
+			v, err = timeseries.MeanPrices(v, groupBy, endTime)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		from, to := timeseries.PageBounds(len(v), page)
+		if from < to {
+			response[marketID] = v[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+// GetCandlesForMarkets returns open, high, low and close quote prices for
+// each market, grouped in windows of groupBy duration
+func (m *inMemoryMarketPriceRepository) GetCandlesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketCandle, error) {
+	if _, err := timeseries.NewWindow(groupBy); err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]domain.MarketCandle)
+	for marketID, v := range m.getPrices(startTime, endTime, marketIDs) {
+		candles, err := timeseries.Candles(v, groupBy, endTime)
+		if err != nil {
+			return nil, err
+		}
+
+		from, to := timeseries.PageBounds(len(candles), page)
+		if from < to {
+			response[marketID] = candles[from:to]
+		}
+	}
+
+	return response, nil
+}
+
+func (m *inMemoryMarketPriceRepository) GetLatestPrices(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketPrice, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	response := make(map[string]domain.MarketPrice)
+	for marketID, v := range m.prices {
+		if timeseries.MatchMarket(marketID, marketIDs) && len(v) > 0 {
+			response[marketID] = v[len(v)-1]
+		}
+	}
+
+	return response, nil
+}
+
+// CalculateVWAP calculates the Volume Weighted Average Price (VWAP) for the
+// given market IDs within the specified time range, as sum of products of
+// quote price and base balance of the same snapshot, or of the same window
+// for the ones fetched separately, over sum of base balances
+func (m *inMemoryMarketPriceRepository) CalculateVWAP(
+	ctx context.Context,
+	averageWindow string,
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs ...string,
+) (decimal.Decimal, error) {
+	if _, err := timeseries.NewWindow(averageWindow); err != nil {
+		return decimal.Zero, err
+	}
+	// unlike the other queries, no market means no VWAP
+	if len(marketIDs) == 0 {
+		return decimal.Zero, nil
+	}
+
+	balances, err := m.balanceRepository.GetBalancesForMarkets(
+		ctx, startTime, endTime, allPoints, "", marketIDs...,
+	)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return timeseries.VWAP(
+		m.getPrices(startTime, endTime, marketIDs), balances, averageWindow, endTime,
+	)
+}
+
+// getPrices returns the prices of every market recorded in the given time
+// range, sorted by time ASC
+func (m *inMemoryMarketPriceRepository) getPrices(
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs []string,
+) map[string][]domain.MarketPrice {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	prices := make(map[string][]domain.MarketPrice)
+	for marketID, v := range m.prices {
+		if !timeseries.MatchMarket(marketID, marketIDs) {
+			continue
+		}
+
+		from := sort.Search(len(v), func(i int) bool {
+			return !v[i].Time.Before(startTime)
+		})
+		to := sort.Search(len(v), func(i int) bool {
+			return !v[i].Time.Before(endTime)
+		})
+		if from < to {
+			prices[marketID] = append([]domain.MarketPrice{}, v[from:to]...)
+		}
+	}
+
+	return prices
+}
@@ -0,0 +1,80 @@
+package inmemory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestMarketPriceRepository(t *testing.T) {
+	ctx := context.Background()
+	balanceRepository := NewMarketBalanceRepository()
+	priceRepository := NewMarketPriceRepository(balanceRepository)
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	// prices and balances are inserted out of order on purpose
+	for _, i := range []int{3, 0, 2, 1} {
+		tm := start.Add(time.Duration(i) * 30 * time.Minute)
+		require.NoError(t, priceRepository.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   "1",
+			BasePrice:  decimal.NewFromInt(int64(i + 1)),
+			QuotePrice: decimal.NewFromInt(int64(10 * (i + 1))),
+			Time:       tm,
+		}))
+		require.NoError(t, balanceRepository.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(int64(1000 * (i + 1))),
+			Time:         tm,
+		}))
+	}
+
+	end := start.Add(90 * time.Minute)
+	prices, err := priceRepository.GetPricesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "",
+	)
+	require.NoError(t, err)
+	require.Len(t, prices["1"], 3)
+	for i, v := range prices["1"] {
+		require.True(t, start.Add(time.Duration(i)*30*time.Minute).Equal(v.Time))
+	}
+
+	prices, err = priceRepository.GetPricesForMarkets(
+		ctx, start, end, domain.NewPage(2, 1), "1h", "1",
+	)
+	require.NoError(t, err)
+	require.Len(t, prices["1"], 1)
+	require.True(t, decimal.NewFromInt(30).Equal(prices["1"][0].QuotePrice))
+	require.True(t, end.Equal(prices["1"][0].Time))
+
+	candles, err := priceRepository.GetCandlesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "1h", "1",
+	)
+	require.NoError(t, err)
+	require.Len(t, candles["1"], 2)
+	require.True(t, decimal.NewFromInt(10).Equal(candles["1"][0].Open))
+	require.True(t, decimal.NewFromInt(20).Equal(candles["1"][0].Close))
+
+	_, err = priceRepository.GetCandlesForMarkets(
+		ctx, start, end, domain.NewPage(1, 10), "1y", "1",
+	)
+	require.Error(t, err)
+
+	latest, err := priceRepository.GetLatestPrices(ctx, "1", "2")
+	require.NoError(t, err)
+	require.Len(t, latest, 1)
+	require.True(t, decimal.NewFromInt(40).Equal(latest["1"].QuotePrice))
+
+	vwap, err := priceRepository.CalculateVWAP(ctx, "1h", start, end, "1")
+	require.NoError(t, err)
+	// (15 * 100 + 30 * 100) / 200
+	require.True(t, decimal.NewFromFloat(22.5).Equal(vwap), vwap.String())
+
+	vwap, err = priceRepository.CalculateVWAP(ctx, "1h", start, end)
+	require.NoError(t, err)
+	require.True(t, vwap.IsZero())
+}
@@ -0,0 +1,77 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/timeseries"
+)
+
+type inMemoryRateRepository struct {
+	mtx   *sync.RWMutex
+	rates map[string][]domain.Rate
+}
+
+func NewRateRepository() domain.RateRepository {
+	return &inMemoryRateRepository{
+		mtx:   &sync.RWMutex{},
+		rates: make(map[string][]domain.Rate),
+	}
+}
+
+func (m *inMemoryRateRepository) InsertRate(
+	ctx context.Context,
+	rate domain.Rate,
+) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	key := rateKey(rate.Source, rate.Target)
+	rates := m.rates[key]
+	i := sort.Search(len(rates), func(i int) bool {
+		return !rates[i].Time.Before(rate.Time)
+	})
+	if i < len(rates) && rates[i].Time.Equal(rate.Time) {
+		rates[i] = rate
+		return nil
+	}
+
+	rates = append(rates, domain.Rate{})
+	copy(rates[i+1:], rates[i:])
+	rates[i] = rate
+	m.rates[key] = rates
+
+	return nil
+}
+
+func (m *inMemoryRateRepository) GetRates(
+	ctx context.Context,
+	source string,
+	target string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]domain.Rate, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	rates := make([]domain.Rate, 0)
+	for _, v := range m.rates[rateKey(source, target)] {
+		if timeseries.InRange(v.Time, startTime, endTime) {
+			v.Source = source
+			v.Target = target
+			rates = append(rates, v)
+		}
+	}
+
+	return rates, nil
+}
+
+// rateKey returns the key of source to target rates, currencies are case
+// insensitive
+func rateKey(source, target string) string {
+	return strings.ToLower(source) + "/" + strings.ToLower(target)
+}
@@ -0,0 +1,187 @@
+package dbpg
+
+import (
+	"context"
+	"database/sql"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+func (p *postgresDbService) InsertAlertRule(
+	ctx context.Context,
+	rule domain.AlertRule,
+) (int, error) {
+	id, err := p.querier.InsertAlertRule(ctx, queries.InsertAlertRuleParams{
+		MarketID:      rule.MarketID,
+		Type:          rule.Type,
+		Asset:         rule.Asset,
+		Threshold:     rule.Threshold.String(),
+		WindowMinutes: int32(rule.WindowMinutes),
+		WebhookUrl:    rule.WebhookUrl,
+		Secret:        rule.Secret,
+		Triggered:     rule.Triggered,
+		CreatedAt:     rule.CreatedAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+func (p *postgresDbService) UpdateAlertRule(
+	ctx context.Context,
+	rule domain.AlertRule,
+) error {
+	rows, err := p.querier.UpdateAlertRule(ctx, queries.UpdateAlertRuleParams{
+		ID:            int32(rule.ID),
+		MarketID:      rule.MarketID,
+		Type:          rule.Type,
+		Asset:         rule.Asset,
+		Threshold:     rule.Threshold.String(),
+		WindowMinutes: int32(rule.WindowMinutes),
+		WebhookUrl:    rule.WebhookUrl,
+		Secret:        rule.Secret,
+		Triggered:     rule.Triggered,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrAlertRuleNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresDbService) UpdateAlertRuleTriggered(
+	ctx context.Context,
+	id int,
+	triggered bool,
+) error {
+	return p.querier.UpdateAlertRuleTriggered(
+		ctx,
+		queries.UpdateAlertRuleTriggeredParams{
+			ID:        int32(id),
+			Triggered: triggered,
+		},
+	)
+}
+
+func (p *postgresDbService) DeleteAlertRule(ctx context.Context, id int) error {
+	rows, err := p.querier.DeleteAlertRule(ctx, int32(id))
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrAlertRuleNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresDbService) GetAlertRule(
+	ctx context.Context,
+	id int,
+) (*domain.AlertRule, error) {
+	rule, err := p.querier.GetAlertRule(ctx, int32(id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAlertRuleNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainAlertRule(rule)
+}
+
+func (p *postgresDbService) GetAlertRules(
+	ctx context.Context,
+	marketIDs ...string,
+) ([]domain.AlertRule, error) {
+	rules, err := p.querier.GetAlertRules(ctx, marketIDsArg(marketIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.AlertRule, 0, len(rules))
+	for _, v := range rules {
+		rule, err := toDomainAlertRule(v)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *rule)
+	}
+
+	return res, nil
+}
+
+func (p *postgresDbService) InsertAlertDelivery(
+	ctx context.Context,
+	delivery domain.AlertDelivery,
+) error {
+	return p.querier.InsertAlertDelivery(ctx, queries.InsertAlertDeliveryParams{
+		RuleID:     int32(delivery.RuleID),
+		Payload:    delivery.Payload,
+		Attempts:   int32(delivery.Attempts),
+		StatusCode: int32(delivery.StatusCode),
+		Error:      delivery.Error,
+		Delivered:  delivery.Delivered,
+		Time:       delivery.Time,
+	})
+}
+
+func (p *postgresDbService) GetAlertDeliveries(
+	ctx context.Context,
+	ruleID int,
+	page domain.Page,
+) ([]domain.AlertDelivery, error) {
+	deliveries, err := p.querier.GetAlertDeliveries(
+		ctx,
+		queries.GetAlertDeliveriesParams{
+			RuleID: int32(ruleID),
+			Limit:  int32(page.Size),
+			Offset: int32(page.Number*page.Size - page.Size),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.AlertDelivery, 0, len(deliveries))
+	for _, v := range deliveries {
+		res = append(res, domain.AlertDelivery{
+			ID:         int(v.ID),
+			RuleID:     int(v.RuleID),
+			Payload:    v.Payload,
+			Attempts:   int(v.Attempts),
+			StatusCode: int(v.StatusCode),
+			Error:      v.Error,
+			Delivered:  v.Delivered,
+			Time:       v.Time,
+		})
+	}
+
+	return res, nil
+}
+
+func toDomainAlertRule(rule queries.AlertRule) (*domain.AlertRule, error) {
+	threshold, err := decimal.NewFromString(rule.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AlertRule{
+		ID:            int(rule.ID),
+		MarketID:      rule.MarketID,
+		Type:          rule.Type,
+		Asset:         rule.Asset,
+		Threshold:     threshold,
+		WindowMinutes: int(rule.WindowMinutes),
+		WebhookUrl:    rule.WebhookUrl,
+		Secret:        rule.Secret,
+		Triggered:     rule.Triggered,
+		CreatedAt:     rule.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package dbpg
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+const (
+	// leaderLockID is the key of the session advisory lock held by the leader,
+	//"tdex" in ascii
+	leaderLockID = int64(0x74646578)
+)
+
+// leaderSession is the db session holding the leader advisory lock, the lock
+// is released by postgres as soon as the session ends, so that a crashed
+// leader is replaced without waiting for any lease to expire
+type leaderSession struct {
+	lock      sync.Mutex
+	conn      *sql.Conn
+	electedAt time.Time
+}
+
+func (p *postgresDbService) TryAcquireLeadership(
+	ctx context.Context,
+	nodeID string,
+) (bool, error) {
+	p.leader.lock.Lock()
+	defer p.leader.lock.Unlock()
+
+	if p.leader.conn == nil {
+		conn, err := p.db.Conn(ctx)
+		if err != nil {
+			return false, err
+		}
+		p.leader.conn = conn
+	}
+	querier := queries.New(p.leader.conn)
+
+	// advisory locks are reentrant, the leader checks its session still holds
+	//the lock instead of acquiring it again
+	var isLeader bool
+	var err error
+	if p.leader.electedAt.IsZero() {
+		isLeader, err = querier.TryAdvisoryLock(ctx, leaderLockID)
+	} else {
+		isLeader, err = querier.HoldsAdvisoryLock(ctx, leaderLockID)
+	}
+	if err != nil {
+		p.closeLeaderSession()
+		return false, err
+	}
+	if !isLeader {
+		if !p.leader.electedAt.IsZero() {
+			p.closeLeaderSession()
+		}
+		return false, nil
+	}
+
+	now := time.Now()
+	if p.leader.electedAt.IsZero() {
+		p.leader.electedAt = now
+	}
+
+	if err := querier.UpsertLeader(ctx, queries.UpsertLeaderParams{
+		LockID:    leaderLockID,
+		NodeID:    nodeID,
+		ElectedAt: p.leader.electedAt,
+		RenewedAt: now,
+	}); err != nil {
+		p.closeLeaderSession()
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p *postgresDbService) ReleaseLeadership(
+	ctx context.Context,
+	nodeID string,
+) error {
+	p.leader.lock.Lock()
+	defer p.leader.lock.Unlock()
+
+	p.closeLeaderSession()
+	return nil
+}
+
+func (p *postgresDbService) GetLeader(
+	ctx context.Context,
+	renewedSince time.Time,
+) (*domain.Leader, error) {
+	leader, err := p.querier.GetLeader(ctx, queries.GetLeaderParams{
+		LockID:       leaderLockID,
+		RenewedSince: renewedSince,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.Leader{
+		NodeID:    leader.NodeID,
+		ElectedAt: leader.ElectedAt,
+		RenewedAt: leader.RenewedAt,
+	}, nil
+}
+
+// closeLeaderSession ends the session holding the leader lock, if any, the
+// connection is discarded instead of being returned to the pool so that the
+// lock is released together with the session
+func (p *postgresDbService) closeLeaderSession() {
+	if p.leader.conn == nil {
+		return
+	}
+
+	// the returned driver.ErrBadConn is what makes the pool discard the conn
+	p.leader.conn.Raw(func(driverConn interface{}) error {
+		return driver.ErrBadConn
+	})
+	p.leader.conn.Close()
+	p.leader.conn, p.leader.electedAt = nil, time.Time{}
+}
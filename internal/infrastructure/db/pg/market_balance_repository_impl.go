@@ -0,0 +1,148 @@
+package dbpg
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+	"time"
+)
+
+func (p *postgresDbService) InsertBalance(
+	ctx context.Context,
+	balance domain.MarketBalance,
+) error {
+	if err := validateBalance(balance); err != nil {
+		return err
+	}
+
+	return p.querier.InsertMarketBalance(ctx, toInsertMarketBalanceParams(balance))
+}
+
+func (p *postgresDbService) GetBalancesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketBalance, error) {
+	query := "SELECT market_id, time, base_balance, quote_balance FROM market_balance " +
+		"WHERE time >= $1 AND time < $2 AND " + marketIDsCondition("$3")
+	// means are rounded to the closest satoshi to keep balances integers
+	if groupBy != "" {
+		windowStop, err := windowStopExpression(groupBy, "$2")
+		if err != nil {
+			return nil, err
+		}
+		query = fmt.Sprintf(
+			"SELECT market_id, %s AS time, round(avg(base_balance)) AS base_balance, "+
+				"round(avg(quote_balance)) AS quote_balance FROM market_balance "+
+				"WHERE time >= $1 AND time < $2 AND %s GROUP BY 1, 2",
+			windowStop,
+			marketIDsCondition("$3"),
+		)
+	}
+	query = paginateByMarket(
+		query, "market_id, time, base_balance, quote_balance", page.Number, page.Size,
+	)
+
+	rows, err := p.db.QueryContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string][]domain.MarketBalance)
+	for rows.Next() {
+		var marketID, bBalance, qBalance string
+		var tm time.Time
+		if err := rows.Scan(&marketID, &tm, &bBalance, &qBalance); err != nil {
+			return nil, err
+		}
+
+		balance, err := toDomainBalance(marketID, bBalance, qBalance, tm)
+		if err != nil {
+			return nil, err
+		}
+
+		response[marketID] = append(response[marketID], *balance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (p *postgresDbService) GetLatestBalances(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketBalance, error) {
+	balances, err := p.querier.GetLatestMarketBalances(ctx, marketIDsArg(marketIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string]domain.MarketBalance)
+	for _, v := range balances {
+		balance, err := toDomainBalance(
+			v.MarketID, v.BaseBalance, v.QuoteBalance, v.Time,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		balance.SnapshotID = v.SnapshotID
+		response[v.MarketID] = *balance
+	}
+
+	return response, nil
+}
+
+func toDomainBalance(
+	marketID, baseBalance, quoteBalance string,
+	tm time.Time,
+) (*domain.MarketBalance, error) {
+	bBalance, err := decimal.NewFromString(baseBalance)
+	if err != nil {
+		return nil, err
+	}
+	qBalance, err := decimal.NewFromString(quoteBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.MarketBalance{
+		MarketID:     marketID,
+		BaseBalance:  bBalance,
+		QuoteBalance: qBalance,
+		Time:         tm,
+	}, nil
+}
+
+// validateBalance checks that balances are in satoshis
+func validateBalance(balance domain.MarketBalance) error {
+	for _, v := range []decimal.Decimal{balance.BaseBalance, balance.QuoteBalance} {
+		if v.IsNegative() || !v.Equal(v.Truncate(0)) {
+			return ErrInvalidBalance
+		}
+	}
+	return nil
+}
+
+func toInsertMarketBalanceParams(
+	balance domain.MarketBalance,
+) queries.InsertMarketBalanceParams {
+	return queries.InsertMarketBalanceParams{
+		MarketID:     balance.MarketID,
+		BaseBalance:  balance.BaseBalance.String(),
+		QuoteBalance: balance.QuoteBalance.String(),
+		Time:         balance.Time,
+		SnapshotID:   balance.SnapshotID,
+	}
+}
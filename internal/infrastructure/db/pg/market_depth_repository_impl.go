@@ -0,0 +1,103 @@
+package dbpg
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+	"time"
+)
+
+func (p *postgresDbService) InsertDepth(
+	ctx context.Context,
+	depth []domain.MarketDepth,
+) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querier := p.querier.WithTx(tx)
+	for _, v := range depth {
+		if err := querier.InsertMarketDepth(ctx, queries.InsertMarketDepthParams{
+			MarketID:  v.MarketID,
+			TradeType: v.TradeType,
+			Amount:    v.Amount.String(),
+			Price:     v.Price.String(),
+			Time:      v.Time,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *postgresDbService) GetDepthForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketDepth, error) {
+	query := "SELECT market_id, trade_type, amount, price, time FROM market_depth " +
+		"WHERE time >= $1 AND time < $2 AND " + marketIDsCondition("$3")
+	if groupBy != "" {
+		windowStop, err := windowStopExpression(groupBy, "$2")
+		if err != nil {
+			return nil, err
+		}
+		query = fmt.Sprintf(
+			"SELECT market_id, trade_type, amount, avg(price) AS price, %s AS time "+
+				"FROM market_depth WHERE time >= $1 AND time < $2 AND %s "+
+				"GROUP BY 1, 2, 3, 5",
+			windowStop,
+			marketIDsCondition("$3"),
+		)
+	}
+	query = fmt.Sprintf("%s ORDER BY market_id, time", query)
+
+	rows, err := p.db.QueryContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string][]domain.MarketDepth)
+	for rows.Next() {
+		var marketID, tradeType, amountStr, priceStr string
+		var tm time.Time
+		if err := rows.Scan(
+			&marketID, &tradeType, &amountStr, &priceStr, &tm,
+		); err != nil {
+			return nil, err
+		}
+
+		amount, err := decimal.NewFromString(amountStr)
+		if err != nil {
+			return nil, err
+		}
+		price, err := decimal.NewFromString(priceStr)
+		if err != nil {
+			return nil, err
+		}
+
+		response[marketID] = append(response[marketID], domain.MarketDepth{
+			MarketID:  marketID,
+			TradeType: tradeType,
+			Amount:    amount,
+			Price:     price,
+			Time:      tm,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
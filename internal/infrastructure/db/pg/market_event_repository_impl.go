@@ -0,0 +1,50 @@
+package dbpg
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+func (p *postgresDbService) InsertMarketEvent(
+	ctx context.Context,
+	event domain.MarketEvent,
+) error {
+	return p.querier.InsertMarketEvent(ctx, queries.InsertMarketEventParams{
+		MarketID: int32(event.MarketID),
+		Type:     event.Type,
+		Time:     event.Time,
+	})
+}
+
+func (p *postgresDbService) GetMarketEvents(
+	ctx context.Context,
+	endTime time.Time,
+	marketIDs ...int,
+) ([]domain.MarketEvent, error) {
+	ids := make([]int32, 0, len(marketIDs))
+	for _, v := range marketIDs {
+		ids = append(ids, int32(v))
+	}
+
+	events, err := p.querier.GetMarketEvents(ctx, queries.GetMarketEventsParams{
+		EndTime:   endTime,
+		MarketIds: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.MarketEvent, 0, len(events))
+	for _, v := range events {
+		res = append(res, domain.MarketEvent{
+			MarketID: int(v.MarketID),
+			Type:     v.Type,
+			Time:     v.Time,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package dbpg
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+	"time"
+)
+
+var (
+	feeColumns = []string{
+		"base_percentage_fee",
+		"quote_percentage_fee",
+		"base_fixed_fee",
+		"quote_fixed_fee",
+		"preview_fee_amount",
+		"preview_fee_asset",
+	}
+)
+
+func (p *postgresDbService) InsertFee(
+	ctx context.Context,
+	fee domain.MarketFee,
+) error {
+	return p.querier.InsertMarketFee(ctx, queries.InsertMarketFeeParams{
+		MarketID:           fee.MarketID,
+		BasePercentageFee:  fee.BasePercentageFee.String(),
+		QuotePercentageFee: fee.QuotePercentageFee.String(),
+		BaseFixedFee:       fee.BaseFixedFee.String(),
+		QuoteFixedFee:      fee.QuoteFixedFee.String(),
+		PreviewFeeAmount:   fee.PreviewFeeAmount.String(),
+		PreviewFeeAsset:    fee.PreviewFeeAsset,
+		Time:               fee.Time,
+	})
+}
+
+func (p *postgresDbService) GetFeesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketFee, error) {
+	columns := "market_id, time"
+	for _, v := range feeColumns {
+		columns = fmt.Sprintf("%s, %s", columns, v)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM market_fee WHERE time >= $1 AND time < $2 AND %s",
+		columns,
+		marketIDsCondition("$3"),
+	)
+	// the last fee of every window is returned
+	if groupBy != "" {
+		windowStop, err := windowStopExpression(groupBy, "$2")
+		if err != nil {
+			return nil, err
+		}
+
+		lastValues := ""
+		for _, v := range feeColumns {
+			lastValues = fmt.Sprintf(
+				"%s, (array_agg(%[2]s ORDER BY time DESC))[1] AS %[2]s", lastValues, v,
+			)
+		}
+		query = fmt.Sprintf(
+			"SELECT market_id, %s AS time%s FROM market_fee "+
+				"WHERE time >= $1 AND time < $2 AND %s GROUP BY 1, 2",
+			windowStop,
+			lastValues,
+			marketIDsCondition("$3"),
+		)
+	}
+	query = paginateByMarket(query, columns, page.Number, page.Size)
+
+	rows, err := p.db.QueryContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string][]domain.MarketFee)
+	for rows.Next() {
+		var marketID, feeAsset string
+		var tm time.Time
+		values := make([]string, 5)
+		if err := rows.Scan(
+			&marketID, &tm, &values[0], &values[1], &values[2], &values[3],
+			&values[4], &feeAsset,
+		); err != nil {
+			return nil, err
+		}
+
+		fees := make([]decimal.Decimal, 0, len(values))
+		for _, v := range values {
+			fee, err := decimal.NewFromString(v)
+			if err != nil {
+				return nil, err
+			}
+			fees = append(fees, fee)
+		}
+
+		response[marketID] = append(response[marketID], domain.MarketFee{
+			MarketID:           marketID,
+			BasePercentageFee:  fees[0],
+			QuotePercentageFee: fees[1],
+			BaseFixedFee:       fees[2],
+			QuoteFixedFee:      fees[3],
+			PreviewFeeAmount:   fees[4],
+			PreviewFeeAsset:    feeAsset,
+			Time:               tm,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,104 @@
+package dbpg
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+func (p *postgresDbService) GetFetchStatus(
+	ctx context.Context,
+	marketID int,
+	job string,
+) (*domain.MarketFetchStatus, error) {
+	status, err := p.querier.GetMarketFetchStatus(
+		ctx,
+		queries.GetMarketFetchStatusParams{
+			MarketID: int32(marketID),
+			Job:      job,
+		},
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &domain.MarketFetchStatus{
+				MarketID: marketID,
+				Job:      job,
+			}, nil
+		}
+		return nil, err
+	}
+
+	res := toDomainFetchStatus(status)
+	return &res, nil
+}
+
+func (p *postgresDbService) UpsertFetchStatus(
+	ctx context.Context,
+	status domain.MarketFetchStatus,
+) error {
+	return p.querier.UpsertMarketFetchStatus(
+		ctx,
+		queries.UpsertMarketFetchStatusParams{
+			MarketID:            int32(status.MarketID),
+			Job:                 status.Job,
+			LastAttempt:         status.LastAttempt,
+			LastSuccess:         toNullTime(status.LastSuccess),
+			LastError:           status.LastError,
+			LastErrorTime:       toNullTime(status.LastErrorTime),
+			ConsecutiveFailures: int32(status.ConsecutiveFailures),
+			FailingSince:        toNullTime(status.FailingSince),
+			Protocol:            status.Protocol,
+			LastErrorType:       status.LastErrorType,
+		},
+	)
+}
+
+func (p *postgresDbService) GetFetchStatuses(
+	ctx context.Context,
+	marketIDs ...int,
+) ([]domain.MarketFetchStatus, error) {
+	ids := make([]int32, 0, len(marketIDs))
+	for _, v := range marketIDs {
+		ids = append(ids, int32(v))
+	}
+
+	statuses, err := p.querier.GetMarketFetchStatuses(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.MarketFetchStatus, 0, len(statuses))
+	for _, v := range statuses {
+		res = append(res, toDomainFetchStatus(v))
+	}
+
+	return res, nil
+}
+
+func toDomainFetchStatus(
+	status queries.MarketFetchStatus,
+) domain.MarketFetchStatus {
+	return domain.MarketFetchStatus{
+		MarketID:            int(status.MarketID),
+		Job:                 status.Job,
+		LastAttempt:         status.LastAttempt,
+		LastSuccess:         status.LastSuccess.Time,
+		LastError:           status.LastError,
+		LastErrorType:       status.LastErrorType,
+		LastErrorTime:       status.LastErrorTime.Time,
+		ConsecutiveFailures: int(status.ConsecutiveFailures),
+		FailingSince:        status.FailingSince.Time,
+		Protocol:            status.Protocol,
+	}
+}
+
+// toNullTime maps the zero time to NULL
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  t,
+		Valid: !t.IsZero(),
+	}
+}
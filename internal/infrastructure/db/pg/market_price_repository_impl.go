@@ -0,0 +1,271 @@
+package dbpg
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+	"time"
+)
+
+func (p *postgresDbService) InsertPrice(
+	ctx context.Context,
+	price domain.MarketPrice,
+) error {
+	return p.querier.InsertMarketPrice(ctx, toInsertMarketPriceParams(price))
+}
+
+func (p *postgresDbService) GetPricesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketPrice, error) {
+	query := "SELECT market_id, time, base_price, quote_price FROM market_price " +
+		"WHERE time >= $1 AND time < $2 AND " + marketIDsCondition("$3")
+	if groupBy != "" {
+		windowStop, err := windowStopExpression(groupBy, "$2")
+		if err != nil {
+			return nil, err
+		}
+		query = fmt.Sprintf(
+			"SELECT market_id, %s AS time, avg(base_price) AS base_price, "+
+				"avg(quote_price) AS quote_price FROM market_price "+
+				"WHERE time >= $1 AND time < $2 AND %s GROUP BY 1, 2",
+			windowStop,
+			marketIDsCondition("$3"),
+		)
+	}
+	query = paginateByMarket(
+		query, "market_id, time, base_price, quote_price", page.Number, page.Size,
+	)
+
+	rows, err := p.db.QueryContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string][]domain.MarketPrice)
+	for rows.Next() {
+		var marketID, bPrice, qPrice string
+		var tm time.Time
+		if err := rows.Scan(&marketID, &tm, &bPrice, &qPrice); err != nil {
+			return nil, err
+		}
+
+		basePrice, err := decimal.NewFromString(bPrice)
+		if err != nil {
+			return nil, err
+		}
+		quotePrice, err := decimal.NewFromString(qPrice)
+		if err != nil {
+			return nil, err
+		}
+
+		response[marketID] = append(response[marketID], domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  basePrice,
+			QuotePrice: quotePrice,
+			Time:       tm,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (p *postgresDbService) GetLatestPrices(
+	ctx context.Context,
+	marketIDs ...string,
+) (map[string]domain.MarketPrice, error) {
+	prices, err := p.querier.GetLatestMarketPrices(ctx, marketIDsArg(marketIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string]domain.MarketPrice)
+	for _, v := range prices {
+		basePrice, err := decimal.NewFromString(v.BasePrice)
+		if err != nil {
+			return nil, err
+		}
+		quotePrice, err := decimal.NewFromString(v.QuotePrice)
+		if err != nil {
+			return nil, err
+		}
+
+		response[v.MarketID] = domain.MarketPrice{
+			MarketID:   v.MarketID,
+			BasePrice:  basePrice,
+			QuotePrice: quotePrice,
+			Time:       v.Time,
+			SnapshotID: v.SnapshotID,
+		}
+	}
+
+	return response, nil
+}
+
+// GetCandlesForMarkets returns open, high, low and close quote prices for
+// each market, grouped in windows of groupBy duration
+func (p *postgresDbService) GetCandlesForMarkets(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	page domain.Page,
+	groupBy string,
+	marketIDs ...string,
+) (map[string][]domain.MarketCandle, error) {
+	windowStop, err := windowStopExpression(groupBy, "$2")
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(
+		"SELECT market_id, %s AS time, "+
+			"(array_agg(quote_price ORDER BY time))[1] AS open, "+
+			"max(quote_price) AS high, "+
+			"min(quote_price) AS low, "+
+			"(array_agg(quote_price ORDER BY time DESC))[1] AS close "+
+			"FROM market_price "+
+			"WHERE time >= $1 AND time < $2 AND %s GROUP BY 1, 2",
+		windowStop,
+		marketIDsCondition("$3"),
+	)
+	query = paginateByMarket(
+		query, "market_id, time, open, high, low, close", page.Number, page.Size,
+	)
+
+	rows, err := p.db.QueryContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string][]domain.MarketCandle)
+	for rows.Next() {
+		var marketID string
+		var tm time.Time
+		values := make([]string, 4)
+		if err := rows.Scan(
+			&marketID, &tm, &values[0], &values[1], &values[2], &values[3],
+		); err != nil {
+			return nil, err
+		}
+
+		prices := make([]decimal.Decimal, 0, len(values))
+		for _, v := range values {
+			price, err := decimal.NewFromString(v)
+			if err != nil {
+				return nil, err
+			}
+			prices = append(prices, price)
+		}
+
+		response[marketID] = append(response[marketID], domain.MarketCandle{
+			MarketID: marketID,
+			Open:     prices[0],
+			High:     prices[1],
+			Low:      prices[2],
+			Close:    prices[3],
+			Time:     tm,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CalculateVWAP calculates the Volume Weighted Average Price (VWAP) for the
+// given market IDs within the specified time range, as sum of products of
+// quote price and base balance of the same snapshot, or of the same window
+// for the ones fetched separately, over sum of base balances
+func (p *postgresDbService) CalculateVWAP(
+	ctx context.Context,
+	averageWindow string,
+	startTime time.Time,
+	endTime time.Time,
+	marketIDs ...string,
+) (decimal.Decimal, error) {
+	windowStop, err := windowStopExpression(averageWindow, "$2")
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// rows of the same snapshot are paired by snapshot id, the ones fetched
+	//separately by previous versions, without snapshot id, by the mean of
+	//every window
+	query := fmt.Sprintf(
+		"WITH balances AS ("+
+			"SELECT market_id, %[1]s AS time, avg(base_balance) AS balance "+
+			"FROM market_balance "+
+			"WHERE time >= $1 AND time < $2 AND market_id = ANY($3::varchar[]) "+
+			"AND snapshot_id = '' "+
+			"GROUP BY 1, 2"+
+			"), prices AS ("+
+			"SELECT market_id, %[1]s AS time, avg(quote_price) AS price "+
+			"FROM market_price "+
+			"WHERE time >= $1 AND time < $2 AND market_id = ANY($3::varchar[]) "+
+			"AND snapshot_id = '' "+
+			"GROUP BY 1, 2"+
+			"), pairs AS ("+
+			"SELECT b.balance, p.price FROM balances b "+
+			"JOIN prices p USING (market_id, time) "+
+			"UNION ALL "+
+			"SELECT b.base_balance, p.quote_price FROM market_balance b "+
+			"JOIN market_price p USING (market_id, snapshot_id) "+
+			"WHERE b.time >= $1 AND b.time < $2 "+
+			"AND b.market_id = ANY($3::varchar[]) AND b.snapshot_id <> ''"+
+			") SELECT "+
+			"COALESCE(sum(balance * price), 0), COALESCE(sum(balance), 0) "+
+			"FROM pairs",
+		windowStop,
+	)
+
+	var vwapSumStr, balanceSumStr string
+	if err := p.db.QueryRowContext(
+		ctx, query, startTime, endTime, pq.Array(marketIDsArg(marketIDs)),
+	).Scan(&vwapSumStr, &balanceSumStr); err != nil {
+		return decimal.Zero, err
+	}
+
+	vwapSum, err := decimal.NewFromString(vwapSumStr)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	balanceSum, err := decimal.NewFromString(balanceSumStr)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if vwapSum.IsZero() || balanceSum.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return vwapSum.Div(balanceSum), nil
+}
+
+func toInsertMarketPriceParams(
+	price domain.MarketPrice,
+) queries.InsertMarketPriceParams {
+	return queries.InsertMarketPriceParams{
+		MarketID:   price.MarketID,
+		BasePrice:  price.BasePrice.String(),
+		QuotePrice: price.QuotePrice.String(),
+		Time:       price.Time,
+		SnapshotID: price.SnapshotID,
+	}
+}
@@ -0,0 +1,36 @@
+package dbpg
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (p *postgresDbService) InsertSnapshot(
+	ctx context.Context,
+	snapshot domain.MarketSnapshot,
+) error {
+	if err := validateBalance(snapshot.Balance); err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	querier := p.querier.WithTx(tx)
+	if err := querier.InsertMarketPrice(
+		ctx, toInsertMarketPriceParams(snapshot.Price),
+	); err != nil {
+		return err
+	}
+	if err := querier.InsertMarketBalance(
+		ctx, toInsertMarketBalanceParams(snapshot.Balance),
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
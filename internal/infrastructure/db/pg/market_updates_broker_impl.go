@@ -0,0 +1,67 @@
+package dbpg
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+const (
+	// marketUpdatesChannel is the channel market updates are notified on to
+	//the listeners of every replica
+	marketUpdatesChannel = "market_updates"
+
+	listenerMinReconnectInterval = time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
+
+func (p *postgresDbService) Publish(ctx context.Context, payload []byte) error {
+	return p.querier.NotifyChannel(ctx, queries.NotifyChannelParams{
+		Channel: marketUpdatesChannel,
+		Payload: string(payload),
+	})
+}
+
+// Listen opens a dedicated session listening to the channel, it reconnects
+// on its own if the session drops, notifications sent meanwhile are lost
+func (p *postgresDbService) Listen(ctx context.Context) (<-chan []byte, error) {
+	dataSource, err := getDataSource(p.dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := pq.NewListener(
+		dataSource, listenerMinReconnectInterval, listenerMaxReconnectInterval, nil,
+	)
+	if err := listener.Listen(marketUpdatesChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	payloads := make(chan []byte)
+	go func() {
+		defer close(payloads)
+		defer listener.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// nil is sent once reconnected
+				if n == nil {
+					continue
+				}
+				select {
+				case payloads <- []byte(n.Extra):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return payloads, nil
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS market_price;
\ No newline at end of file
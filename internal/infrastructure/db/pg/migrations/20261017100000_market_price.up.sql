@@ -0,0 +1,7 @@
+CREATE TABLE market_price (
+    market_id   varchar(264) NOT NULL,
+    base_price  numeric NOT NULL,
+    quote_price numeric NOT NULL,
+    time        timestamptz NOT NULL,
+    PRIMARY KEY(market_id, time)
+);
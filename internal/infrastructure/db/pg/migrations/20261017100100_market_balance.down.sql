@@ -0,0 +1 @@
+DROP TABLE IF EXISTS market_balance;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE market_balance (
+    market_id     varchar(264) NOT NULL,
+    base_balance  numeric(20, 0) NOT NULL,
+    quote_balance numeric(20, 0) NOT NULL,
+    time          timestamptz NOT NULL,
+    PRIMARY KEY(market_id, time)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS rate;
\ No newline at end of file
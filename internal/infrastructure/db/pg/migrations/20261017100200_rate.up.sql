@@ -0,0 +1,7 @@
+CREATE TABLE rate (
+    source varchar(264) NOT NULL,
+    target varchar(264) NOT NULL,
+    value  numeric NOT NULL,
+    time   timestamptz NOT NULL,
+    PRIMARY KEY(source, target, time)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS market_fee;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE market_fee (
+    market_id            varchar(264) NOT NULL,
+    base_percentage_fee  numeric NOT NULL,
+    quote_percentage_fee numeric NOT NULL,
+    base_fixed_fee       numeric NOT NULL,
+    quote_fixed_fee      numeric NOT NULL,
+    preview_fee_amount   numeric NOT NULL,
+    preview_fee_asset    varchar(264) NOT NULL,
+    time                 timestamptz NOT NULL,
+    PRIMARY KEY(market_id, time)
+);
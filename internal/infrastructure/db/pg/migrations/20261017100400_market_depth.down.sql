@@ -0,0 +1 @@
+DROP TABLE IF EXISTS market_depth;
\ No newline at end of file
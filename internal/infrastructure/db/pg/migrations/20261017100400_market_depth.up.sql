@@ -0,0 +1,8 @@
+CREATE TABLE market_depth (
+    market_id  varchar(264) NOT NULL,
+    trade_type varchar(8) NOT NULL,
+    amount     numeric(20, 0) NOT NULL,
+    price      numeric NOT NULL,
+    time       timestamptz NOT NULL,
+    PRIMARY KEY(market_id, trade_type, amount, time)
+);
@@ -0,0 +1,2 @@
+-- hypertables can not be turned back into plain tables, they are dropped
+-- by the down migrations of the respective tables
@@ -0,0 +1,13 @@
+-- time series tables are turned into hypertables if TimescaleDB extension
+-- has been installed in the database, plain tables are used otherwise
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb') THEN
+        PERFORM create_hypertable('market_price', 'time', migrate_data => true);
+        PERFORM create_hypertable('market_balance', 'time', migrate_data => true);
+        PERFORM create_hypertable('rate', 'time', migrate_data => true);
+        PERFORM create_hypertable('market_fee', 'time', migrate_data => true);
+        PERFORM create_hypertable('market_depth', 'time', migrate_data => true);
+    END IF;
+END
+$$;
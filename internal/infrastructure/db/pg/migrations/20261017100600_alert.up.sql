@@ -0,0 +1,27 @@
+CREATE TABLE alert_rule (
+    id SERIAL PRIMARY KEY,
+    market_id varchar(264) NOT NULL,
+    type varchar(64) NOT NULL,
+    asset varchar(16) NOT NULL,
+    threshold numeric NOT NULL,
+    window_minutes integer NOT NULL,
+    webhook_url text NOT NULL,
+    secret text NOT NULL,
+    triggered boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX alert_rule_market_id_idx ON alert_rule (market_id);
+
+CREATE TABLE alert_delivery (
+    id SERIAL PRIMARY KEY,
+    rule_id integer NOT NULL REFERENCES alert_rule(id) ON DELETE CASCADE,
+    payload text NOT NULL,
+    attempts integer NOT NULL,
+    status_code integer NOT NULL,
+    error text NOT NULL,
+    delivered boolean NOT NULL,
+    time timestamptz NOT NULL
+);
+
+CREATE INDEX alert_delivery_rule_id_time_idx ON alert_delivery (rule_id, time DESC);
@@ -0,0 +1,8 @@
+CREATE TABLE market_event (
+    id SERIAL PRIMARY KEY,
+    market_id integer NOT NULL,
+    type varchar(64) NOT NULL,
+    time timestamptz NOT NULL
+);
+
+CREATE INDEX market_event_market_id_time_idx ON market_event (market_id, time);
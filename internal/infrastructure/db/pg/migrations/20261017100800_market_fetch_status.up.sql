@@ -0,0 +1,12 @@
+CREATE TABLE market_fetch_status (
+    market_id integer NOT NULL,
+    job varchar(64) NOT NULL,
+    last_attempt timestamptz NOT NULL,
+    last_success timestamptz,
+    last_error text NOT NULL,
+    last_error_time timestamptz,
+    consecutive_failures integer NOT NULL,
+    failing_since timestamptz,
+    protocol varchar(64) NOT NULL,
+    PRIMARY KEY(market_id, job)
+);
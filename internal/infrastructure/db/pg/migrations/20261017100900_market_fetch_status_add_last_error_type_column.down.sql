@@ -0,0 +1 @@
+ALTER TABLE market_fetch_status DROP COLUMN last_error_type;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE market_fetch_status ADD COLUMN last_error_type varchar(64) NOT NULL DEFAULT '';
\ No newline at end of file
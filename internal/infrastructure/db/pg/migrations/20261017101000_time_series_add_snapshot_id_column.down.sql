@@ -0,0 +1,2 @@
+ALTER TABLE market_price DROP COLUMN snapshot_id;
+ALTER TABLE market_balance DROP COLUMN snapshot_id;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE market_price ADD COLUMN snapshot_id varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE market_balance ADD COLUMN snapshot_id varchar(64) NOT NULL DEFAULT '';
\ No newline at end of file
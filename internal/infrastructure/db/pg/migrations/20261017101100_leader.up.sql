@@ -0,0 +1,6 @@
+CREATE TABLE leader (
+    lock_id bigint PRIMARY KEY,
+    node_id varchar(255) NOT NULL,
+    elected_at timestamptz NOT NULL,
+    renewed_at timestamptz NOT NULL
+);
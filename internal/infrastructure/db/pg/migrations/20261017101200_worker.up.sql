@@ -0,0 +1,5 @@
+CREATE TABLE worker (
+    node_id varchar(255) PRIMARY KEY,
+    started_at timestamptz NOT NULL,
+    heartbeat_at timestamptz NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE worker DROP COLUMN IF EXISTS circuit_breakers;
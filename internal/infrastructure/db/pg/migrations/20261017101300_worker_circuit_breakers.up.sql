@@ -0,0 +1 @@
+ALTER TABLE worker ADD COLUMN circuit_breakers jsonb NOT NULL DEFAULT '{}';
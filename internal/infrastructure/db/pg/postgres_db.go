@@ -7,6 +7,7 @@ import (
 	"github.com/go-testfixtures/testfixtures/v3"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
 	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
 	"sync"
 
@@ -28,6 +29,18 @@ const (
 
 type Service interface {
 	domain.MarketRepository
+	domain.MarketBalanceRepository
+	domain.MarketPriceRepository
+	domain.MarketSnapshotRepository
+	domain.RateRepository
+	domain.MarketFeeRepository
+	domain.MarketDepthRepository
+	domain.AlertRepository
+	domain.MarketEventRepository
+	domain.MarketFetchStatusRepository
+	domain.LeaderRepository
+	domain.WorkerRepository
+	port.MarketUpdatesBroker
 	Close() error
 	CreateLoader(fixturesPath string) error
 	LoadFixtures() error
@@ -41,10 +54,12 @@ type Config struct {
 }
 
 type postgresDbService struct {
+	dbConfig       DbConfig
 	db             *sql.DB
 	querier        *queries.Queries
 	fixturesLoader *testfixtures.Loader
 	mutex          *sync.RWMutex
+	leader         leaderSession
 }
 
 func New(dbConfig DbConfig) (Service, error) {
@@ -58,8 +73,9 @@ func New(dbConfig DbConfig) (Service, error) {
 	}
 
 	return &postgresDbService{
-		db:      db,
-		querier: queries.New(db),
+		dbConfig: dbConfig,
+		db:       db,
+		querier:  queries.New(db),
 	}, nil
 }
 
@@ -79,15 +95,9 @@ func (p *postgresDbService) Close() error {
 }
 
 func connect(dbConfig DbConfig) (*sql.DB, error) {
-	dataSource := insecureDataSourceStr(dbConfig)
-
-	if !dbConfig.DbInsecure {
-		d, err := dataSourceStr(dbConfig)
-		if err != nil {
-			return nil, err
-		}
-
-		dataSource = d
+	dataSource, err := getDataSource(dbConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	db, err := sql.Open(
@@ -101,6 +111,14 @@ func connect(dbConfig DbConfig) (*sql.DB, error) {
 	return db, nil
 }
 
+func getDataSource(dbConfig DbConfig) (string, error) {
+	if !dbConfig.DbInsecure {
+		return dataSourceStr(dbConfig)
+	}
+
+	return insecureDataSourceStr(dbConfig), nil
+}
+
 func migrateDb(db *sql.DB, migrationSourceUrl string) error {
 	dbInstance, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
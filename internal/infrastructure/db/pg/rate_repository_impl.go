@@ -0,0 +1,57 @@
+package dbpg
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+	"strings"
+	"time"
+)
+
+func (p *postgresDbService) InsertRate(
+	ctx context.Context,
+	rate domain.Rate,
+) error {
+	return p.querier.InsertRate(ctx, queries.InsertRateParams{
+		Source: strings.ToLower(rate.Source),
+		Target: strings.ToLower(rate.Target),
+		Value:  rate.Value.String(),
+		Time:   rate.Time,
+	})
+}
+
+func (p *postgresDbService) GetRates(
+	ctx context.Context,
+	source string,
+	target string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]domain.Rate, error) {
+	rows, err := p.querier.GetRates(ctx, queries.GetRatesParams{
+		Source:    strings.ToLower(source),
+		Target:    strings.ToLower(target),
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]domain.Rate, 0, len(rows))
+	for _, v := range rows {
+		value, err := decimal.NewFromString(v.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, domain.Rate{
+			Source: source,
+			Target: target,
+			Value:  value,
+			Time:   v.Time,
+		})
+	}
+
+	return rates, nil
+}
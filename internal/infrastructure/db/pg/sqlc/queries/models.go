@@ -6,8 +6,41 @@ package queries
 
 import (
 	"database/sql"
+	"encoding/json"
+	"time"
 )
 
+type AlertDelivery struct {
+	ID         int32
+	RuleID     int32
+	Payload    string
+	Attempts   int32
+	StatusCode int32
+	Error      string
+	Delivered  bool
+	Time       time.Time
+}
+
+type AlertRule struct {
+	ID            int32
+	MarketID      string
+	Type          string
+	Asset         string
+	Threshold     string
+	WindowMinutes int32
+	WebhookUrl    string
+	Secret        string
+	Triggered     bool
+	CreatedAt     time.Time
+}
+
+type Leader struct {
+	LockID    int64
+	NodeID    string
+	ElectedAt time.Time
+	RenewedAt time.Time
+}
+
 type Market struct {
 	MarketID     sql.NullInt32
 	ProviderName string
@@ -16,3 +49,72 @@ type Market struct {
 	QuoteAsset   string
 	Active       sql.NullBool
 }
+
+type MarketBalance struct {
+	MarketID     string
+	BaseBalance  string
+	QuoteBalance string
+	Time         time.Time
+	SnapshotID   string
+}
+
+type MarketDepth struct {
+	MarketID  string
+	TradeType string
+	Amount    string
+	Price     string
+	Time      time.Time
+}
+
+type MarketEvent struct {
+	ID       int32
+	MarketID int32
+	Type     string
+	Time     time.Time
+}
+
+type MarketFee struct {
+	MarketID           string
+	BasePercentageFee  string
+	QuotePercentageFee string
+	BaseFixedFee       string
+	QuoteFixedFee      string
+	PreviewFeeAmount   string
+	PreviewFeeAsset    string
+	Time               time.Time
+}
+
+type MarketFetchStatus struct {
+	MarketID            int32
+	Job                 string
+	LastAttempt         time.Time
+	LastSuccess         sql.NullTime
+	LastError           string
+	LastErrorTime       sql.NullTime
+	ConsecutiveFailures int32
+	FailingSince        sql.NullTime
+	Protocol            string
+	LastErrorType       string
+}
+
+type MarketPrice struct {
+	MarketID   string
+	BasePrice  string
+	QuotePrice string
+	Time       time.Time
+	SnapshotID string
+}
+
+type Rate struct {
+	Source string
+	Target string
+	Value  string
+	Time   time.Time
+}
+
+type Worker struct {
+	NodeID          string
+	StartedAt       time.Time
+	HeartbeatAt     time.Time
+	CircuitBreakers json.RawMessage
+}
@@ -8,8 +8,138 @@ package queries
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+const deleteAlertRule = `-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rule WHERE id = $1
+`
+
+func (q *Queries) DeleteAlertRule(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAlertRule, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWorker = `-- name: DeleteWorker :exec
+DELETE FROM worker WHERE node_id = $1
+`
+
+func (q *Queries) DeleteWorker(ctx context.Context, nodeID string) error {
+	_, err := q.db.ExecContext(ctx, deleteWorker, nodeID)
+	return err
+}
+
+const getAlertDeliveries = `-- name: GetAlertDeliveries :many
+SELECT id, rule_id, payload, attempts, status_code, error, delivered, time FROM alert_delivery WHERE rule_id = $1
+ORDER BY time DESC, id DESC LIMIT $2 OFFSET $3
+`
+
+type GetAlertDeliveriesParams struct {
+	RuleID int32
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) GetAlertDeliveries(ctx context.Context, arg GetAlertDeliveriesParams) ([]AlertDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getAlertDeliveries, arg.RuleID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlertDelivery
+	for rows.Next() {
+		var i AlertDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleID,
+			&i.Payload,
+			&i.Attempts,
+			&i.StatusCode,
+			&i.Error,
+			&i.Delivered,
+			&i.Time,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAlertRule = `-- name: GetAlertRule :one
+SELECT id, market_id, type, asset, threshold, window_minutes, webhook_url, secret, triggered, created_at FROM alert_rule WHERE id = $1
+`
+
+func (q *Queries) GetAlertRule(ctx context.Context, id int32) (AlertRule, error) {
+	row := q.db.QueryRowContext(ctx, getAlertRule, id)
+	var i AlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.MarketID,
+		&i.Type,
+		&i.Asset,
+		&i.Threshold,
+		&i.WindowMinutes,
+		&i.WebhookUrl,
+		&i.Secret,
+		&i.Triggered,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAlertRules = `-- name: GetAlertRules :many
+SELECT id, market_id, type, asset, threshold, window_minutes, webhook_url, secret, triggered, created_at FROM alert_rule
+WHERE cardinality($1::varchar[]) = 0 OR market_id = ANY($1::varchar[])
+ORDER BY id
+`
+
+func (q *Queries) GetAlertRules(ctx context.Context, marketIds []string) ([]AlertRule, error) {
+	rows, err := q.db.QueryContext(ctx, getAlertRules, pq.Array(marketIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlertRule
+	for rows.Next() {
+		var i AlertRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.MarketID,
+			&i.Type,
+			&i.Asset,
+			&i.Threshold,
+			&i.WindowMinutes,
+			&i.WebhookUrl,
+			&i.Secret,
+			&i.Triggered,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllMarkets = `-- name: GetAllMarkets :many
 SELECT market_id, provider_name, url, base_asset, quote_asset, active FROM market
 `
@@ -44,6 +174,208 @@ func (q *Queries) GetAllMarkets(ctx context.Context) ([]Market, error) {
 	return items, nil
 }
 
+const getLatestMarketBalances = `-- name: GetLatestMarketBalances :many
+SELECT DISTINCT ON (market_id) market_id, base_balance, quote_balance, time, snapshot_id FROM market_balance
+WHERE cardinality($1::varchar[]) = 0 OR market_id = ANY($1::varchar[])
+ORDER BY market_id, time DESC
+`
+
+func (q *Queries) GetLatestMarketBalances(ctx context.Context, marketIds []string) ([]MarketBalance, error) {
+	rows, err := q.db.QueryContext(ctx, getLatestMarketBalances, pq.Array(marketIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketBalance
+	for rows.Next() {
+		var i MarketBalance
+		if err := rows.Scan(
+			&i.MarketID,
+			&i.BaseBalance,
+			&i.QuoteBalance,
+			&i.Time,
+			&i.SnapshotID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestMarketPrices = `-- name: GetLatestMarketPrices :many
+SELECT DISTINCT ON (market_id) market_id, base_price, quote_price, time, snapshot_id FROM market_price
+WHERE cardinality($1::varchar[]) = 0 OR market_id = ANY($1::varchar[])
+ORDER BY market_id, time DESC
+`
+
+func (q *Queries) GetLatestMarketPrices(ctx context.Context, marketIds []string) ([]MarketPrice, error) {
+	rows, err := q.db.QueryContext(ctx, getLatestMarketPrices, pq.Array(marketIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketPrice
+	for rows.Next() {
+		var i MarketPrice
+		if err := rows.Scan(
+			&i.MarketID,
+			&i.BasePrice,
+			&i.QuotePrice,
+			&i.Time,
+			&i.SnapshotID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLeader = `-- name: GetLeader :one
+SELECT lock_id, node_id, elected_at, renewed_at FROM leader
+WHERE lock_id = $1::bigint AND renewed_at >= $2 AND EXISTS (
+    SELECT 1 FROM pg_catalog.pg_locks
+    WHERE locktype = 'advisory' AND classid = 0 AND objsubid = 1
+    AND objid::bigint = $1::bigint AND granted
+)
+`
+
+type GetLeaderParams struct {
+	LockID       int64
+	RenewedSince time.Time
+}
+
+func (q *Queries) GetLeader(ctx context.Context, arg GetLeaderParams) (Leader, error) {
+	row := q.db.QueryRowContext(ctx, getLeader, arg.LockID, arg.RenewedSince)
+	var i Leader
+	err := row.Scan(
+		&i.LockID,
+		&i.NodeID,
+		&i.ElectedAt,
+		&i.RenewedAt,
+	)
+	return i, err
+}
+
+const getMarketEvents = `-- name: GetMarketEvents :many
+SELECT id, market_id, type, time FROM market_event
+WHERE time < $1 AND (cardinality($2::integer[]) = 0 OR market_id = ANY($2::integer[]))
+ORDER BY time, id
+`
+
+type GetMarketEventsParams struct {
+	EndTime   time.Time
+	MarketIds []int32
+}
+
+func (q *Queries) GetMarketEvents(ctx context.Context, arg GetMarketEventsParams) ([]MarketEvent, error) {
+	rows, err := q.db.QueryContext(ctx, getMarketEvents, arg.EndTime, pq.Array(arg.MarketIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketEvent
+	for rows.Next() {
+		var i MarketEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.MarketID,
+			&i.Type,
+			&i.Time,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMarketFetchStatus = `-- name: GetMarketFetchStatus :one
+SELECT market_id, job, last_attempt, last_success, last_error, last_error_time, consecutive_failures, failing_since, protocol, last_error_type FROM market_fetch_status WHERE market_id = $1 AND job = $2
+`
+
+type GetMarketFetchStatusParams struct {
+	MarketID int32
+	Job      string
+}
+
+func (q *Queries) GetMarketFetchStatus(ctx context.Context, arg GetMarketFetchStatusParams) (MarketFetchStatus, error) {
+	row := q.db.QueryRowContext(ctx, getMarketFetchStatus, arg.MarketID, arg.Job)
+	var i MarketFetchStatus
+	err := row.Scan(
+		&i.MarketID,
+		&i.Job,
+		&i.LastAttempt,
+		&i.LastSuccess,
+		&i.LastError,
+		&i.LastErrorTime,
+		&i.ConsecutiveFailures,
+		&i.FailingSince,
+		&i.Protocol,
+		&i.LastErrorType,
+	)
+	return i, err
+}
+
+const getMarketFetchStatuses = `-- name: GetMarketFetchStatuses :many
+SELECT market_id, job, last_attempt, last_success, last_error, last_error_time, consecutive_failures, failing_since, protocol, last_error_type FROM market_fetch_status
+WHERE cardinality($1::integer[]) = 0 OR market_id = ANY($1::integer[])
+ORDER BY market_id, job
+`
+
+func (q *Queries) GetMarketFetchStatuses(ctx context.Context, marketIds []int32) ([]MarketFetchStatus, error) {
+	rows, err := q.db.QueryContext(ctx, getMarketFetchStatuses, pq.Array(marketIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketFetchStatus
+	for rows.Next() {
+		var i MarketFetchStatus
+		if err := rows.Scan(
+			&i.MarketID,
+			&i.Job,
+			&i.LastAttempt,
+			&i.LastSuccess,
+			&i.LastError,
+			&i.LastErrorTime,
+			&i.ConsecutiveFailures,
+			&i.FailingSince,
+			&i.Protocol,
+			&i.LastErrorType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMarketsForActiveIndicator = `-- name: GetMarketsForActiveIndicator :many
 SELECT market_id, provider_name, url, base_asset, quote_asset, active FROM market where active = $1
 `
@@ -78,6 +410,167 @@ func (q *Queries) GetMarketsForActiveIndicator(ctx context.Context, active sql.N
 	return items, nil
 }
 
+const getRates = `-- name: GetRates :many
+SELECT source, target, value, time FROM rate
+WHERE source = $1 AND target = $2 AND time >= $3 AND time < $4
+ORDER BY time
+`
+
+type GetRatesParams struct {
+	Source    string
+	Target    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (q *Queries) GetRates(ctx context.Context, arg GetRatesParams) ([]Rate, error) {
+	rows, err := q.db.QueryContext(ctx, getRates,
+		arg.Source,
+		arg.Target,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Rate
+	for rows.Next() {
+		var i Rate
+		if err := rows.Scan(
+			&i.Source,
+			&i.Target,
+			&i.Value,
+			&i.Time,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkers = `-- name: GetWorkers :many
+SELECT node_id, started_at, heartbeat_at, circuit_breakers FROM worker WHERE heartbeat_at >= $1 ORDER BY node_id
+`
+
+func (q *Queries) GetWorkers(ctx context.Context, heartbeatAt time.Time) ([]Worker, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkers, heartbeatAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Worker
+	for rows.Next() {
+		var i Worker
+		if err := rows.Scan(
+			&i.NodeID,
+			&i.StartedAt,
+			&i.HeartbeatAt,
+			&i.CircuitBreakers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const holdsAdvisoryLock = `-- name: HoldsAdvisoryLock :one
+SELECT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_locks
+    WHERE locktype = 'advisory' AND classid = 0 AND objsubid = 1
+    AND objid::bigint = $1::bigint
+    AND pid = pg_backend_pid() AND granted
+)::boolean
+`
+
+func (q *Queries) HoldsAdvisoryLock(ctx context.Context, lockID int64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, holdsAdvisoryLock, lockID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const insertAlertDelivery = `-- name: InsertAlertDelivery :exec
+INSERT INTO alert_delivery (
+    rule_id,payload,attempts,status_code,error,delivered,time) VALUES (
+             $1, $2, $3, $4, $5, $6, $7
+    )
+`
+
+type InsertAlertDeliveryParams struct {
+	RuleID     int32
+	Payload    string
+	Attempts   int32
+	StatusCode int32
+	Error      string
+	Delivered  bool
+	Time       time.Time
+}
+
+func (q *Queries) InsertAlertDelivery(ctx context.Context, arg InsertAlertDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, insertAlertDelivery,
+		arg.RuleID,
+		arg.Payload,
+		arg.Attempts,
+		arg.StatusCode,
+		arg.Error,
+		arg.Delivered,
+		arg.Time,
+	)
+	return err
+}
+
+const insertAlertRule = `-- name: InsertAlertRule :one
+INSERT INTO alert_rule (
+    market_id,type,asset,threshold,window_minutes,webhook_url,secret,triggered,created_at) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8, $9
+    )
+    RETURNING id
+`
+
+type InsertAlertRuleParams struct {
+	MarketID      string
+	Type          string
+	Asset         string
+	Threshold     string
+	WindowMinutes int32
+	WebhookUrl    string
+	Secret        string
+	Triggered     bool
+	CreatedAt     time.Time
+}
+
+func (q *Queries) InsertAlertRule(ctx context.Context, arg InsertAlertRuleParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, insertAlertRule,
+		arg.MarketID,
+		arg.Type,
+		arg.Asset,
+		arg.Threshold,
+		arg.WindowMinutes,
+		arg.WebhookUrl,
+		arg.Secret,
+		arg.Triggered,
+		arg.CreatedAt,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
 const insertMarket = `-- name: InsertMarket :one
 INSERT INTO market (
     provider_name,url,base_asset,quote_asset,active) VALUES (
@@ -114,6 +607,200 @@ func (q *Queries) InsertMarket(ctx context.Context, arg InsertMarketParams) (Mar
 	return i, err
 }
 
+const insertMarketBalance = `-- name: InsertMarketBalance :exec
+INSERT INTO market_balance (
+    market_id,base_balance,quote_balance,time,snapshot_id) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_balance = EXCLUDED.base_balance, quote_balance = EXCLUDED.quote_balance,
+    snapshot_id = EXCLUDED.snapshot_id
+`
+
+type InsertMarketBalanceParams struct {
+	MarketID     string
+	BaseBalance  string
+	QuoteBalance string
+	Time         time.Time
+	SnapshotID   string
+}
+
+func (q *Queries) InsertMarketBalance(ctx context.Context, arg InsertMarketBalanceParams) error {
+	_, err := q.db.ExecContext(ctx, insertMarketBalance,
+		arg.MarketID,
+		arg.BaseBalance,
+		arg.QuoteBalance,
+		arg.Time,
+		arg.SnapshotID,
+	)
+	return err
+}
+
+const insertMarketDepth = `-- name: InsertMarketDepth :exec
+INSERT INTO market_depth (
+    market_id,trade_type,amount,price,time) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, trade_type, amount, time) DO UPDATE
+    SET price = EXCLUDED.price
+`
+
+type InsertMarketDepthParams struct {
+	MarketID  string
+	TradeType string
+	Amount    string
+	Price     string
+	Time      time.Time
+}
+
+func (q *Queries) InsertMarketDepth(ctx context.Context, arg InsertMarketDepthParams) error {
+	_, err := q.db.ExecContext(ctx, insertMarketDepth,
+		arg.MarketID,
+		arg.TradeType,
+		arg.Amount,
+		arg.Price,
+		arg.Time,
+	)
+	return err
+}
+
+const insertMarketEvent = `-- name: InsertMarketEvent :exec
+INSERT INTO market_event (
+    market_id,type,time) VALUES (
+             $1, $2, $3
+    )
+`
+
+type InsertMarketEventParams struct {
+	MarketID int32
+	Type     string
+	Time     time.Time
+}
+
+func (q *Queries) InsertMarketEvent(ctx context.Context, arg InsertMarketEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertMarketEvent, arg.MarketID, arg.Type, arg.Time)
+	return err
+}
+
+const insertMarketFee = `-- name: InsertMarketFee :exec
+INSERT INTO market_fee (
+    market_id,base_percentage_fee,quote_percentage_fee,base_fixed_fee,
+    quote_fixed_fee,preview_fee_amount,preview_fee_asset,time) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_percentage_fee = EXCLUDED.base_percentage_fee,
+        quote_percentage_fee = EXCLUDED.quote_percentage_fee,
+        base_fixed_fee = EXCLUDED.base_fixed_fee,
+        quote_fixed_fee = EXCLUDED.quote_fixed_fee,
+        preview_fee_amount = EXCLUDED.preview_fee_amount,
+        preview_fee_asset = EXCLUDED.preview_fee_asset
+`
+
+type InsertMarketFeeParams struct {
+	MarketID           string
+	BasePercentageFee  string
+	QuotePercentageFee string
+	BaseFixedFee       string
+	QuoteFixedFee      string
+	PreviewFeeAmount   string
+	PreviewFeeAsset    string
+	Time               time.Time
+}
+
+func (q *Queries) InsertMarketFee(ctx context.Context, arg InsertMarketFeeParams) error {
+	_, err := q.db.ExecContext(ctx, insertMarketFee,
+		arg.MarketID,
+		arg.BasePercentageFee,
+		arg.QuotePercentageFee,
+		arg.BaseFixedFee,
+		arg.QuoteFixedFee,
+		arg.PreviewFeeAmount,
+		arg.PreviewFeeAsset,
+		arg.Time,
+	)
+	return err
+}
+
+const insertMarketPrice = `-- name: InsertMarketPrice :exec
+INSERT INTO market_price (
+    market_id,base_price,quote_price,time,snapshot_id) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_price = EXCLUDED.base_price, quote_price = EXCLUDED.quote_price,
+    snapshot_id = EXCLUDED.snapshot_id
+`
+
+type InsertMarketPriceParams struct {
+	MarketID   string
+	BasePrice  string
+	QuotePrice string
+	Time       time.Time
+	SnapshotID string
+}
+
+func (q *Queries) InsertMarketPrice(ctx context.Context, arg InsertMarketPriceParams) error {
+	_, err := q.db.ExecContext(ctx, insertMarketPrice,
+		arg.MarketID,
+		arg.BasePrice,
+		arg.QuotePrice,
+		arg.Time,
+		arg.SnapshotID,
+	)
+	return err
+}
+
+const insertRate = `-- name: InsertRate :exec
+INSERT INTO rate (
+    source,target,value,time) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (source, target, time) DO UPDATE SET value = EXCLUDED.value
+`
+
+type InsertRateParams struct {
+	Source string
+	Target string
+	Value  string
+	Time   time.Time
+}
+
+func (q *Queries) InsertRate(ctx context.Context, arg InsertRateParams) error {
+	_, err := q.db.ExecContext(ctx, insertRate,
+		arg.Source,
+		arg.Target,
+		arg.Value,
+		arg.Time,
+	)
+	return err
+}
+
+const notifyChannel = `-- name: NotifyChannel :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyChannelParams struct {
+	Channel string
+	Payload string
+}
+
+func (q *Queries) NotifyChannel(ctx context.Context, arg NotifyChannelParams) error {
+	_, err := q.db.ExecContext(ctx, notifyChannel, arg.Channel, arg.Payload)
+	return err
+}
+
+const tryAdvisoryLock = `-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock($1::bigint)::boolean
+`
+
+func (q *Queries) TryAdvisoryLock(ctx context.Context, lockID int64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tryAdvisoryLock, lockID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const updateActive = `-- name: UpdateActive :exec
 UPDATE market set active = $1 where market_id = $2
 `
@@ -127,3 +814,149 @@ func (q *Queries) UpdateActive(ctx context.Context, arg UpdateActiveParams) erro
 	_, err := q.db.ExecContext(ctx, updateActive, arg.Active, arg.MarketID)
 	return err
 }
+
+const updateAlertRule = `-- name: UpdateAlertRule :execrows
+UPDATE alert_rule SET market_id = $2, type = $3, asset = $4, threshold = $5,
+    window_minutes = $6, webhook_url = $7, secret = $8, triggered = $9
+WHERE id = $1
+`
+
+type UpdateAlertRuleParams struct {
+	ID            int32
+	MarketID      string
+	Type          string
+	Asset         string
+	Threshold     string
+	WindowMinutes int32
+	WebhookUrl    string
+	Secret        string
+	Triggered     bool
+}
+
+func (q *Queries) UpdateAlertRule(ctx context.Context, arg UpdateAlertRuleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateAlertRule,
+		arg.ID,
+		arg.MarketID,
+		arg.Type,
+		arg.Asset,
+		arg.Threshold,
+		arg.WindowMinutes,
+		arg.WebhookUrl,
+		arg.Secret,
+		arg.Triggered,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateAlertRuleTriggered = `-- name: UpdateAlertRuleTriggered :exec
+UPDATE alert_rule SET triggered = $2 WHERE id = $1
+`
+
+type UpdateAlertRuleTriggeredParams struct {
+	ID        int32
+	Triggered bool
+}
+
+func (q *Queries) UpdateAlertRuleTriggered(ctx context.Context, arg UpdateAlertRuleTriggeredParams) error {
+	_, err := q.db.ExecContext(ctx, updateAlertRuleTriggered, arg.ID, arg.Triggered)
+	return err
+}
+
+const upsertLeader = `-- name: UpsertLeader :exec
+INSERT INTO leader (
+    lock_id,node_id,elected_at,renewed_at) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (lock_id) DO UPDATE
+    SET node_id = EXCLUDED.node_id, elected_at = EXCLUDED.elected_at,
+    renewed_at = EXCLUDED.renewed_at
+`
+
+type UpsertLeaderParams struct {
+	LockID    int64
+	NodeID    string
+	ElectedAt time.Time
+	RenewedAt time.Time
+}
+
+func (q *Queries) UpsertLeader(ctx context.Context, arg UpsertLeaderParams) error {
+	_, err := q.db.ExecContext(ctx, upsertLeader,
+		arg.LockID,
+		arg.NodeID,
+		arg.ElectedAt,
+		arg.RenewedAt,
+	)
+	return err
+}
+
+const upsertMarketFetchStatus = `-- name: UpsertMarketFetchStatus :exec
+INSERT INTO market_fetch_status (
+    market_id,job,last_attempt,last_success,last_error,last_error_time,consecutive_failures,failing_since,protocol,last_error_type) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    )
+    ON CONFLICT (market_id, job) DO UPDATE
+    SET last_attempt = EXCLUDED.last_attempt, last_success = EXCLUDED.last_success,
+    last_error = EXCLUDED.last_error, last_error_time = EXCLUDED.last_error_time,
+    consecutive_failures = EXCLUDED.consecutive_failures,
+    failing_since = EXCLUDED.failing_since, protocol = EXCLUDED.protocol,
+    last_error_type = EXCLUDED.last_error_type
+`
+
+type UpsertMarketFetchStatusParams struct {
+	MarketID            int32
+	Job                 string
+	LastAttempt         time.Time
+	LastSuccess         sql.NullTime
+	LastError           string
+	LastErrorTime       sql.NullTime
+	ConsecutiveFailures int32
+	FailingSince        sql.NullTime
+	Protocol            string
+	LastErrorType       string
+}
+
+func (q *Queries) UpsertMarketFetchStatus(ctx context.Context, arg UpsertMarketFetchStatusParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMarketFetchStatus,
+		arg.MarketID,
+		arg.Job,
+		arg.LastAttempt,
+		arg.LastSuccess,
+		arg.LastError,
+		arg.LastErrorTime,
+		arg.ConsecutiveFailures,
+		arg.FailingSince,
+		arg.Protocol,
+		arg.LastErrorType,
+	)
+	return err
+}
+
+const upsertWorker = `-- name: UpsertWorker :exec
+INSERT INTO worker (
+    node_id,started_at,heartbeat_at,circuit_breakers) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (node_id) DO UPDATE
+    SET started_at = EXCLUDED.started_at, heartbeat_at = EXCLUDED.heartbeat_at,
+        circuit_breakers = EXCLUDED.circuit_breakers
+`
+
+type UpsertWorkerParams struct {
+	NodeID          string
+	StartedAt       time.Time
+	HeartbeatAt     time.Time
+	CircuitBreakers json.RawMessage
+}
+
+func (q *Queries) UpsertWorker(ctx context.Context, arg UpsertWorkerParams) error {
+	_, err := q.db.ExecContext(ctx, upsertWorker,
+		arg.NodeID,
+		arg.StartedAt,
+		arg.HeartbeatAt,
+		arg.CircuitBreakers,
+	)
+	return err
+}
@@ -13,3 +13,178 @@ UPDATE market set active = $1 where market_id = $2;
 
 -- name: GetMarketsForActiveIndicator :many
 SELECT * FROM market where active = $1;
+
+-- name: InsertMarketPrice :exec
+INSERT INTO market_price (
+    market_id,base_price,quote_price,time,snapshot_id) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_price = EXCLUDED.base_price, quote_price = EXCLUDED.quote_price,
+    snapshot_id = EXCLUDED.snapshot_id;
+
+-- name: GetLatestMarketPrices :many
+SELECT DISTINCT ON (market_id) * FROM market_price
+WHERE cardinality(@market_ids::varchar[]) = 0 OR market_id = ANY(@market_ids::varchar[])
+ORDER BY market_id, time DESC;
+
+-- name: InsertMarketBalance :exec
+INSERT INTO market_balance (
+    market_id,base_balance,quote_balance,time,snapshot_id) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_balance = EXCLUDED.base_balance, quote_balance = EXCLUDED.quote_balance,
+    snapshot_id = EXCLUDED.snapshot_id;
+
+-- name: GetLatestMarketBalances :many
+SELECT DISTINCT ON (market_id) * FROM market_balance
+WHERE cardinality(@market_ids::varchar[]) = 0 OR market_id = ANY(@market_ids::varchar[])
+ORDER BY market_id, time DESC;
+
+-- name: InsertRate :exec
+INSERT INTO rate (
+    source,target,value,time) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (source, target, time) DO UPDATE SET value = EXCLUDED.value;
+
+-- name: GetRates :many
+SELECT * FROM rate
+WHERE source = @source AND target = @target AND time >= @start_time AND time < @end_time
+ORDER BY time;
+
+-- name: InsertMarketFee :exec
+INSERT INTO market_fee (
+    market_id,base_percentage_fee,quote_percentage_fee,base_fixed_fee,
+    quote_fixed_fee,preview_fee_amount,preview_fee_asset,time) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8
+    )
+    ON CONFLICT (market_id, time) DO UPDATE
+    SET base_percentage_fee = EXCLUDED.base_percentage_fee,
+        quote_percentage_fee = EXCLUDED.quote_percentage_fee,
+        base_fixed_fee = EXCLUDED.base_fixed_fee,
+        quote_fixed_fee = EXCLUDED.quote_fixed_fee,
+        preview_fee_amount = EXCLUDED.preview_fee_amount,
+        preview_fee_asset = EXCLUDED.preview_fee_asset;
+
+-- name: InsertMarketDepth :exec
+INSERT INTO market_depth (
+    market_id,trade_type,amount,price,time) VALUES (
+             $1, $2, $3, $4, $5
+    )
+    ON CONFLICT (market_id, trade_type, amount, time) DO UPDATE
+    SET price = EXCLUDED.price;
+
+-- name: InsertAlertRule :one
+INSERT INTO alert_rule (
+    market_id,type,asset,threshold,window_minutes,webhook_url,secret,triggered,created_at) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8, $9
+    )
+    RETURNING id;
+
+-- name: UpdateAlertRule :execrows
+UPDATE alert_rule SET market_id = $2, type = $3, asset = $4, threshold = $5,
+    window_minutes = $6, webhook_url = $7, secret = $8, triggered = $9
+WHERE id = $1;
+
+-- name: UpdateAlertRuleTriggered :exec
+UPDATE alert_rule SET triggered = $2 WHERE id = $1;
+
+-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rule WHERE id = $1;
+
+-- name: GetAlertRule :one
+SELECT * FROM alert_rule WHERE id = $1;
+
+-- name: GetAlertRules :many
+SELECT * FROM alert_rule
+WHERE cardinality(@market_ids::varchar[]) = 0 OR market_id = ANY(@market_ids::varchar[])
+ORDER BY id;
+
+-- name: InsertAlertDelivery :exec
+INSERT INTO alert_delivery (
+    rule_id,payload,attempts,status_code,error,delivered,time) VALUES (
+             $1, $2, $3, $4, $5, $6, $7
+    );
+
+-- name: GetAlertDeliveries :many
+SELECT * FROM alert_delivery WHERE rule_id = $1
+ORDER BY time DESC, id DESC LIMIT $2 OFFSET $3;
+
+-- name: InsertMarketEvent :exec
+INSERT INTO market_event (
+    market_id,type,time) VALUES (
+             $1, $2, $3
+    );
+
+-- name: GetMarketEvents :many
+SELECT * FROM market_event
+WHERE time < @end_time AND (cardinality(@market_ids::integer[]) = 0 OR market_id = ANY(@market_ids::integer[]))
+ORDER BY time, id;
+
+-- name: GetMarketFetchStatus :one
+SELECT * FROM market_fetch_status WHERE market_id = $1 AND job = $2;
+
+-- name: UpsertMarketFetchStatus :exec
+INSERT INTO market_fetch_status (
+    market_id,job,last_attempt,last_success,last_error,last_error_time,consecutive_failures,failing_since,protocol,last_error_type) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    )
+    ON CONFLICT (market_id, job) DO UPDATE
+    SET last_attempt = EXCLUDED.last_attempt, last_success = EXCLUDED.last_success,
+    last_error = EXCLUDED.last_error, last_error_time = EXCLUDED.last_error_time,
+    consecutive_failures = EXCLUDED.consecutive_failures,
+    failing_since = EXCLUDED.failing_since, protocol = EXCLUDED.protocol,
+    last_error_type = EXCLUDED.last_error_type;
+
+-- name: GetMarketFetchStatuses :many
+SELECT * FROM market_fetch_status
+WHERE cardinality(@market_ids::integer[]) = 0 OR market_id = ANY(@market_ids::integer[])
+ORDER BY market_id, job;
+
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(@lock_id::bigint)::boolean;
+
+-- name: HoldsAdvisoryLock :one
+SELECT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_locks
+    WHERE locktype = 'advisory' AND classid = 0 AND objsubid = 1
+    AND objid::bigint = @lock_id::bigint
+    AND pid = pg_backend_pid() AND granted
+)::boolean;
+
+-- name: UpsertLeader :exec
+INSERT INTO leader (
+    lock_id,node_id,elected_at,renewed_at) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (lock_id) DO UPDATE
+    SET node_id = EXCLUDED.node_id, elected_at = EXCLUDED.elected_at,
+    renewed_at = EXCLUDED.renewed_at;
+
+-- name: GetLeader :one
+SELECT * FROM leader
+WHERE lock_id = @lock_id::bigint AND renewed_at >= @renewed_since AND EXISTS (
+    SELECT 1 FROM pg_catalog.pg_locks
+    WHERE locktype = 'advisory' AND classid = 0 AND objsubid = 1
+    AND objid::bigint = @lock_id::bigint AND granted
+);
+
+-- name: UpsertWorker :exec
+INSERT INTO worker (
+    node_id,started_at,heartbeat_at,circuit_breakers) VALUES (
+             $1, $2, $3, $4
+    )
+    ON CONFLICT (node_id) DO UPDATE
+    SET started_at = EXCLUDED.started_at, heartbeat_at = EXCLUDED.heartbeat_at,
+        circuit_breakers = EXCLUDED.circuit_breakers;
+
+-- name: GetWorkers :many
+SELECT * FROM worker WHERE heartbeat_at >= $1 ORDER BY node_id;
+
+-- name: DeleteWorker :exec
+DELETE FROM worker WHERE node_id = $1;
+
+-- name: NotifyChannel :exec
+SELECT pg_notify(@channel::text, @payload::text);
@@ -0,0 +1,99 @@
+package dbpg
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrInvalidGroupBy = errors.New(
+		"groupBy must be a duration made of an integer and one of the units " +
+			"s, m, h, d, w, mo",
+	)
+	ErrInvalidBalance = errors.New(
+		"balance must be a non negative integer amount of satoshis",
+	)
+
+	groupByRegexp = regexp.MustCompile(`^([1-9][0-9]*)(s|m|h|d|w|mo)$`)
+	unitSeconds   = map[string]int64{
+		"s": 1,
+		"m": 60,
+		"h": 60 * 60,
+		"d": 24 * 60 * 60,
+		"w": 7 * 24 * 60 * 60,
+	}
+)
+
+// windowStopExpression returns the sql expression of the stop of the groupBy
+// window the time column belongs to, clipped to endTimeParam, so that rows are
+// grouped and labeled like influx aggregateWindow does, windows are aligned to
+// unix epoch and month windows to calendar months
+func windowStopExpression(groupBy, endTimeParam string) (string, error) {
+	matches := groupByRegexp.FindStringSubmatch(groupBy)
+	if matches == nil {
+		return "", ErrInvalidGroupBy
+	}
+
+	every, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return "", ErrInvalidGroupBy
+	}
+
+	if matches[2] == "mo" {
+		return fmt.Sprintf(
+			"LEAST(TIMESTAMPTZ '1970-01-01 00:00:00+00' + make_interval(months => "+
+				"((floor(((extract(year FROM time AT TIME ZONE 'UTC') - 1970) * 12 + "+
+				"extract(month FROM time AT TIME ZONE 'UTC') - 1) / %[1]d) + 1) * %[1]d)::int), "+
+				"%[2]s)",
+			every,
+			endTimeParam,
+		), nil
+	}
+
+	seconds := every * unitSeconds[matches[2]]
+	return fmt.Sprintf(
+		"LEAST(to_timestamp((floor(extract(epoch FROM time) / %[1]d) + 1) * %[1]d), %[2]s)",
+		seconds,
+		endTimeParam,
+	), nil
+}
+
+// paginateByMarket wraps query so that only a page of the rows of every
+// market is returned, rows are sorted by market and time ASC, query must
+// select market_id and time columns
+func paginateByMarket(query string, columns string, page int, size int) string {
+	offset := page*size - size
+
+	return fmt.Sprintf(
+		"SELECT %s FROM ("+
+			"SELECT *, ROW_NUMBER() OVER (PARTITION BY market_id ORDER BY time) AS row_number "+
+			"FROM (%s) AS series"+
+			") AS pages WHERE row_number > %d AND row_number <= %d "+
+			"ORDER BY market_id, time",
+		columns,
+		query,
+		offset,
+		offset+size,
+	)
+}
+
+// marketIDsCondition returns the sql condition matching rows of the markets
+// whose ids are passed in the array param, or all markets if it's empty
+func marketIDsCondition(param string) string {
+	return fmt.Sprintf(
+		"(cardinality(%[1]s::varchar[]) = 0 OR market_id = ANY(%[1]s::varchar[]))",
+		param,
+	)
+}
+
+// marketIDsArg returns the value to be passed as array param of
+// marketIDsCondition, that must never be NULL
+func marketIDsArg(marketIDs []string) []string {
+	if marketIDs == nil {
+		return []string{}
+	}
+
+	return marketIDs
+}
@@ -0,0 +1,70 @@
+package dbpg
+
+import "testing"
+
+func Test_windowStopExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupBy string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "hours",
+			groupBy: "4h",
+			want:    "LEAST(to_timestamp((floor(extract(epoch FROM time) / 14400) + 1) * 14400), $2)",
+		},
+		{
+			name:    "weeks",
+			groupBy: "1w",
+			want:    "LEAST(to_timestamp((floor(extract(epoch FROM time) / 604800) + 1) * 604800), $2)",
+		},
+		{
+			name:    "months",
+			groupBy: "1mo",
+			want: "LEAST(TIMESTAMPTZ '1970-01-01 00:00:00+00' + make_interval(months => " +
+				"((floor(((extract(year FROM time AT TIME ZONE 'UTC') - 1970) * 12 + " +
+				"extract(month FROM time AT TIME ZONE 'UTC') - 1) / 1) + 1) * 1)::int), $2)",
+		},
+		{
+			name:    "unknown unit",
+			groupBy: "1y",
+			wantErr: true,
+		},
+		{
+			name:    "zero duration",
+			groupBy: "0h",
+			wantErr: true,
+		},
+		{
+			name:    "injection",
+			groupBy: "1h); DROP TABLE market;--",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := windowStopExpression(tt.groupBy, "$2")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("windowStopExpression() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("windowStopExpression() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_paginateByMarket(t *testing.T) {
+	want := "SELECT market_id, time FROM (" +
+		"SELECT *, ROW_NUMBER() OVER (PARTITION BY market_id ORDER BY time) AS row_number " +
+		"FROM (SELECT market_id, time FROM market_price) AS series" +
+		") AS pages WHERE row_number > 10 AND row_number <= 15 " +
+		"ORDER BY market_id, time"
+
+	got := paginateByMarket("SELECT market_id, time FROM market_price", "market_id, time", 3, 5)
+	if got != want {
+		t.Errorf("paginateByMarket() = %v, want %v", got, want)
+	}
+}
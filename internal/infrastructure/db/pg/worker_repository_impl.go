@@ -0,0 +1,65 @@
+package dbpg
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg/sqlc/queries"
+)
+
+func (p *postgresDbService) Heartbeat(
+	ctx context.Context,
+	worker domain.Worker,
+) error {
+	breakers := worker.CircuitBreakers
+	if breakers == nil {
+		breakers = make(map[string]domain.CircuitBreaker)
+	}
+	circuitBreakers, err := json.Marshal(breakers)
+	if err != nil {
+		return err
+	}
+
+	return p.querier.UpsertWorker(ctx, queries.UpsertWorkerParams{
+		NodeID:          worker.NodeID,
+		StartedAt:       worker.StartedAt,
+		HeartbeatAt:     worker.HeartbeatAt,
+		CircuitBreakers: circuitBreakers,
+	})
+}
+
+func (p *postgresDbService) GetWorkers(
+	ctx context.Context,
+	aliveSince time.Time,
+) ([]domain.Worker, error) {
+	workers, err := p.querier.GetWorkers(ctx, aliveSince)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Worker, 0, len(workers))
+	for _, v := range workers {
+		breakers := make(map[string]domain.CircuitBreaker)
+		if err := json.Unmarshal(v.CircuitBreakers, &breakers); err != nil {
+			return nil, err
+		}
+
+		res = append(res, domain.Worker{
+			NodeID:          v.NodeID,
+			StartedAt:       v.StartedAt,
+			HeartbeatAt:     v.HeartbeatAt,
+			CircuitBreakers: breakers,
+		})
+	}
+
+	return res, nil
+}
+
+func (p *postgresDbService) DeleteWorker(
+	ctx context.Context,
+	nodeID string,
+) error {
+	return p.querier.DeleteWorker(ctx, nodeID)
+}
@@ -0,0 +1,332 @@
+package timeseries
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+// The functions below aggregate the points of a single market in windows of
+// groupBy duration, points must be sorted by time ASC, and so are the
+// returned ones, empty windows are omitted
+
+// MeanPrices returns mean base and quote prices of every window
+func MeanPrices(
+	prices []domain.MarketPrice,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketPrice, error) {
+	stops, err := windowStops(groupBy, priceTimes(prices), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MarketPrice, 0)
+	baseSum, quoteSum, count := decimal.Zero, decimal.Zero, 0
+	for i, v := range prices {
+		baseSum = baseSum.Add(v.BasePrice)
+		quoteSum = quoteSum.Add(v.QuotePrice)
+		count++
+
+		if isLastOfWindow(stops, i) {
+			n := decimal.NewFromInt(int64(count))
+			result = append(result, domain.MarketPrice{
+				MarketID:   v.MarketID,
+				BasePrice:  baseSum.Div(n),
+				QuotePrice: quoteSum.Div(n),
+				Time:       stops[i],
+			})
+			baseSum, quoteSum, count = decimal.Zero, decimal.Zero, 0
+		}
+	}
+
+	return result, nil
+}
+
+// MeanBalances returns mean base and quote balances of every window rounded
+// to the closest satoshi
+func MeanBalances(
+	balances []domain.MarketBalance,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketBalance, error) {
+	stops, err := windowStops(groupBy, balanceTimes(balances), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MarketBalance, 0)
+	baseSum, quoteSum, count := decimal.Zero, decimal.Zero, 0
+	for i, v := range balances {
+		baseSum = baseSum.Add(v.BaseBalance)
+		quoteSum = quoteSum.Add(v.QuoteBalance)
+		count++
+
+		if isLastOfWindow(stops, i) {
+			n := decimal.NewFromInt(int64(count))
+			result = append(result, domain.MarketBalance{
+				MarketID:     v.MarketID,
+				BaseBalance:  baseSum.Div(n).Round(0),
+				QuoteBalance: quoteSum.Div(n).Round(0),
+				Time:         stops[i],
+			})
+			baseSum, quoteSum, count = decimal.Zero, decimal.Zero, 0
+		}
+	}
+
+	return result, nil
+}
+
+// Candles returns first, max, min and last quote price of every window
+func Candles(
+	prices []domain.MarketPrice,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketCandle, error) {
+	stops, err := windowStops(groupBy, priceTimes(prices), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MarketCandle, 0)
+	var candle *domain.MarketCandle
+	for i, v := range prices {
+		if candle == nil {
+			candle = &domain.MarketCandle{
+				MarketID: v.MarketID,
+				Open:     v.QuotePrice,
+				High:     v.QuotePrice,
+				Low:      v.QuotePrice,
+			}
+		}
+		if v.QuotePrice.GreaterThan(candle.High) {
+			candle.High = v.QuotePrice
+		}
+		if v.QuotePrice.LessThan(candle.Low) {
+			candle.Low = v.QuotePrice
+		}
+
+		if isLastOfWindow(stops, i) {
+			candle.Close = v.QuotePrice
+			candle.Time = stops[i]
+			result = append(result, *candle)
+			candle = nil
+		}
+	}
+
+	return result, nil
+}
+
+// LastFees returns the last fee of every window
+func LastFees(
+	fees []domain.MarketFee,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketFee, error) {
+	times := make([]time.Time, 0, len(fees))
+	for _, v := range fees {
+		times = append(times, v.Time)
+	}
+	stops, err := windowStops(groupBy, times, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MarketFee, 0)
+	for i, v := range fees {
+		if isLastOfWindow(stops, i) {
+			v.Time = stops[i]
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+// MeanDepth returns the mean price of every trade type and amount in every
+// window, levels of the same window are returned in the order they are first
+// found
+func MeanDepth(
+	depth []domain.MarketDepth,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketDepth, error) {
+	times := make([]time.Time, 0, len(depth))
+	for _, v := range depth {
+		times = append(times, v.Time)
+	}
+	stops, err := windowStops(groupBy, times, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	type level struct {
+		depth domain.MarketDepth
+		sum   decimal.Decimal
+		count int64
+	}
+
+	result := make([]domain.MarketDepth, 0)
+	levels := make([]*level, 0)
+	levelsByKey := make(map[string]*level)
+	for i, v := range depth {
+		key := v.TradeType + "/" + v.Amount.String()
+		l, ok := levelsByKey[key]
+		if !ok {
+			l = &level{depth: v}
+			levelsByKey[key] = l
+			levels = append(levels, l)
+		}
+		l.sum = l.sum.Add(v.Price)
+		l.count++
+
+		if isLastOfWindow(stops, i) {
+			for _, l := range levels {
+				l.depth.Price = l.sum.Div(decimal.NewFromInt(l.count))
+				l.depth.Time = stops[i]
+				result = append(result, l.depth)
+			}
+			levels = make([]*level, 0)
+			levelsByKey = make(map[string]*level)
+		}
+	}
+
+	return result, nil
+}
+
+// VWAP returns the sum of products of quote price and base balance of every
+// market over the sum of base balances, prices and balances of the same
+// snapshot are paired by snapshot id, the others, fetched separately by
+// previous versions, by the window of averageWindow they are averaged over,
+// the ones without a counterpart are ignored
+func VWAP(
+	prices map[string][]domain.MarketPrice,
+	balances map[string][]domain.MarketBalance,
+	averageWindow string,
+	endTime time.Time,
+) (decimal.Decimal, error) {
+	vwapSum, balanceSum := decimal.Zero, decimal.Zero
+	add := func(price, balance decimal.Decimal) {
+		vwapSum = vwapSum.Add(price.Mul(balance))
+		balanceSum = balanceSum.Add(balance)
+	}
+
+	for marketID, marketBalances := range balances {
+		pricesBySnapshot := make(map[string]decimal.Decimal)
+		unpairedPrices := make([]domain.MarketPrice, 0)
+		for _, v := range prices[marketID] {
+			if v.SnapshotID == "" {
+				unpairedPrices = append(unpairedPrices, v)
+				continue
+			}
+			pricesBySnapshot[v.SnapshotID] = v.QuotePrice
+		}
+
+		unpairedBalances := make([]domain.MarketBalance, 0)
+		for _, v := range marketBalances {
+			if v.SnapshotID == "" {
+				unpairedBalances = append(unpairedBalances, v)
+				continue
+			}
+			if price, ok := pricesBySnapshot[v.SnapshotID]; ok {
+				add(price, v.BaseBalance)
+			}
+		}
+
+		meanBalances, err := meanBaseBalances(unpairedBalances, averageWindow, endTime)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		meanPrices, err := MeanPrices(unpairedPrices, averageWindow, endTime)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		pricesByWindow := make(map[int64]decimal.Decimal)
+		for _, v := range meanPrices {
+			pricesByWindow[v.Time.UnixNano()] = v.QuotePrice
+		}
+		for _, v := range meanBalances {
+			if price, ok := pricesByWindow[v.Time.UnixNano()]; ok {
+				add(price, v.BaseBalance)
+			}
+		}
+	}
+
+	if vwapSum.IsZero() || balanceSum.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return vwapSum.Div(balanceSum), nil
+}
+
+// meanBaseBalances is like MeanBalances but means are not rounded
+func meanBaseBalances(
+	balances []domain.MarketBalance,
+	groupBy string,
+	endTime time.Time,
+) ([]domain.MarketBalance, error) {
+	stops, err := windowStops(groupBy, balanceTimes(balances), endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MarketBalance, 0)
+	sum, count := decimal.Zero, 0
+	for i, v := range balances {
+		sum = sum.Add(v.BaseBalance)
+		count++
+
+		if isLastOfWindow(stops, i) {
+			result = append(result, domain.MarketBalance{
+				MarketID:    v.MarketID,
+				BaseBalance: sum.Div(decimal.NewFromInt(int64(count))),
+				Time:        stops[i],
+			})
+			sum, count = decimal.Zero, 0
+		}
+	}
+
+	return result, nil
+}
+
+// windowStops returns the stop of the window every one of times belongs to
+func windowStops(
+	groupBy string,
+	times []time.Time,
+	endTime time.Time,
+) ([]time.Time, error) {
+	window, err := NewWindow(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	stops := make([]time.Time, 0, len(times))
+	for _, v := range times {
+		stops = append(stops, window.Stop(v, endTime))
+	}
+
+	return stops, nil
+}
+
+// isLastOfWindow returns whether the i-th point is the last of its window
+func isLastOfWindow(stops []time.Time, i int) bool {
+	return i == len(stops)-1 || !stops[i+1].Equal(stops[i])
+}
+
+func priceTimes(prices []domain.MarketPrice) []time.Time {
+	times := make([]time.Time, 0, len(prices))
+	for _, v := range prices {
+		times = append(times, v.Time)
+	}
+	return times
+}
+
+func balanceTimes(balances []domain.MarketBalance) []time.Time {
+	times := make([]time.Time, 0, len(balances))
+	for _, v := range balances {
+		times = append(times, v.Time)
+	}
+	return times
+}
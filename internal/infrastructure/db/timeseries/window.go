@@ -0,0 +1,115 @@
+// Package timeseries provides the aggregations applied to time series by
+// the dbs that, unlike influx, can't run them natively
+package timeseries
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+var (
+	ErrInvalidGroupBy = errors.New(
+		"groupBy must be a duration made of an integer and one of the units " +
+			"s, m, h, d, w, mo",
+	)
+
+	groupByRegexp = regexp.MustCompile(`^([1-9][0-9]*)(s|m|h|d|w|mo)$`)
+	unitDurations = map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+)
+
+// Window groups points in windows of the same duration the way influx
+// aggregateWindow does, windows are aligned to unix epoch, month windows to
+// calendar months, and every window is labeled with its stop time
+type Window struct {
+	every  time.Duration
+	months int
+}
+
+// NewWindow returns the window of groupBy duration, expressed in flux format
+func NewWindow(groupBy string) (*Window, error) {
+	matches := groupByRegexp.FindStringSubmatch(groupBy)
+	if matches == nil {
+		return nil, ErrInvalidGroupBy
+	}
+
+	every, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return nil, ErrInvalidGroupBy
+	}
+
+	if matches[2] == "mo" {
+		return &Window{months: every}, nil
+	}
+
+	return &Window{every: time.Duration(every) * unitDurations[matches[2]]}, nil
+}
+
+// Stop returns the stop time of the window t belongs to, clipped to endTime
+func (w *Window) Stop(t time.Time, endTime time.Time) time.Time {
+	var stop time.Time
+	if w.months > 0 {
+		t = t.UTC()
+		months := (t.Year()-1970)*12 + int(t.Month()) - 1
+		stopMonths := (months/w.months + 1) * w.months
+		stop = time.Date(1970, time.Month(1+stopMonths), 1, 0, 0, 0, 0, time.UTC)
+	} else {
+		every := int64(w.every)
+		stop = time.Unix(0, (t.UnixNano()/every+1)*every).UTC()
+	}
+
+	if !endTime.IsZero() && stop.After(endTime) {
+		return endTime
+	}
+
+	return stop
+}
+
+// InRange returns whether t is in the time range [startTime, endTime)
+func InRange(t, startTime, endTime time.Time) bool {
+	return !t.Before(startTime) && t.Before(endTime)
+}
+
+// MatchMarket returns whether marketID is one of marketIDs, all markets are
+// matched if marketIDs is empty
+func MatchMarket(marketID string, marketIDs []string) bool {
+	if len(marketIDs) == 0 {
+		return true
+	}
+
+	for _, v := range marketIDs {
+		if v == marketID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PageBounds returns the indexes of the first and past the last item of
+// page for a list of length items
+func PageBounds(length int, page domain.Page) (int, int) {
+	from := page.Number*page.Size - page.Size
+	if from < 0 {
+		from = 0
+	}
+	if from > length {
+		from = length
+	}
+
+	to := from + page.Size
+	if to > length {
+		to = length
+	}
+
+	return from, to
+}
@@ -0,0 +1,236 @@
+package timeseries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func TestWindowStop(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupBy string
+		t       time.Time
+		endTime time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "hours",
+			groupBy: "4h",
+			t:       time.Date(2022, 3, 1, 5, 30, 0, 0, time.UTC),
+			want:    time.Date(2022, 3, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "window start belongs to window",
+			groupBy: "1h",
+			t:       time.Date(2022, 3, 1, 5, 0, 0, 0, time.UTC),
+			want:    time.Date(2022, 3, 1, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "months",
+			groupBy: "1mo",
+			t:       time.Date(2022, 12, 15, 0, 0, 0, 0, time.UTC),
+			want:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "clipped to end time",
+			groupBy: "1d",
+			t:       time.Date(2022, 3, 1, 5, 0, 0, 0, time.UTC),
+			endTime: time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+			want:    time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "unknown unit",
+			groupBy: "1y",
+			wantErr: true,
+		},
+		{
+			name:    "zero duration",
+			groupBy: "0h",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := NewWindow(tt.groupBy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewWindow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got := window.Stop(tt.t, tt.endTime); !got.Equal(tt.want) {
+				t.Errorf("Stop() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		length   int
+		page     domain.Page
+		wantFrom int
+		wantTo   int
+	}{
+		{
+			name:     "first page",
+			length:   25,
+			page:     domain.Page{Number: 1, Size: 10},
+			wantFrom: 0,
+			wantTo:   10,
+		},
+		{
+			name:     "last page",
+			length:   25,
+			page:     domain.Page{Number: 3, Size: 10},
+			wantFrom: 20,
+			wantTo:   25,
+		},
+		{
+			name:     "past last page",
+			length:   25,
+			page:     domain.Page{Number: 4, Size: 10},
+			wantFrom: 25,
+			wantTo:   25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := PageBounds(tt.length, tt.page)
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf(
+					"PageBounds() = %v, %v, want %v, %v", from, to, tt.wantFrom, tt.wantTo,
+				)
+			}
+		})
+	}
+}
+
+func TestCandles(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	prices := make([]domain.MarketPrice, 0)
+	for i, v := range []int64{10, 12, 8, 11, 20, 19} {
+		prices = append(prices, domain.MarketPrice{
+			MarketID:   "1",
+			QuotePrice: decimal.NewFromInt(v),
+			Time:       start.Add(time.Duration(i) * 20 * time.Minute),
+		})
+	}
+
+	candles, err := Candles(prices, "1h", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []domain.MarketCandle{
+		{
+			MarketID: "1",
+			Open:     decimal.NewFromInt(10),
+			High:     decimal.NewFromInt(12),
+			Low:      decimal.NewFromInt(8),
+			Close:    decimal.NewFromInt(8),
+			Time:     start.Add(time.Hour),
+		},
+		{
+			MarketID: "1",
+			Open:     decimal.NewFromInt(11),
+			High:     decimal.NewFromInt(20),
+			Low:      decimal.NewFromInt(11),
+			Close:    decimal.NewFromInt(19),
+			Time:     start.Add(2 * time.Hour),
+		},
+	}
+	if len(candles) != len(want) {
+		t.Fatalf("Candles() returned %d candles, want %d", len(candles), len(want))
+	}
+	for i, v := range candles {
+		if !v.Open.Equal(want[i].Open) || !v.High.Equal(want[i].High) ||
+			!v.Low.Equal(want[i].Low) || !v.Close.Equal(want[i].Close) ||
+			!v.Time.Equal(want[i].Time) {
+			t.Errorf("Candles()[%d] = %v, want %v", i, v, want[i])
+		}
+	}
+}
+
+func TestMeanBalances(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	balances := []domain.MarketBalance{
+		{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(1),
+			Time:         start,
+		},
+		{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(101),
+			QuoteBalance: decimal.NewFromInt(2),
+			Time:         start.Add(time.Minute),
+		},
+		{
+			MarketID:     "1",
+			BaseBalance:  decimal.NewFromInt(300),
+			QuoteBalance: decimal.NewFromInt(3),
+			Time:         start.Add(time.Hour),
+		},
+	}
+
+	means, err := MeanBalances(balances, "1h", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(means) != 2 {
+		t.Fatalf("MeanBalances() returned %d balances, want 2", len(means))
+	}
+	// 100.5 and 1.5 are rounded to the closest satoshi
+	if !means[0].BaseBalance.Equal(decimal.NewFromInt(101)) ||
+		!means[0].QuoteBalance.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("MeanBalances()[0] = %v", means[0])
+	}
+	if !means[1].BaseBalance.Equal(decimal.NewFromInt(300)) ||
+		!means[1].Time.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("MeanBalances()[1] = %v", means[1])
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	prices := map[string][]domain.MarketPrice{
+		"1": {
+			{MarketID: "1", QuotePrice: decimal.NewFromInt(10), Time: start, SnapshotID: "a"},
+			{MarketID: "1", QuotePrice: decimal.NewFromInt(20), Time: start.Add(time.Hour), SnapshotID: "b"},
+			// prices without snapshot are averaged by window
+			{MarketID: "1", QuotePrice: decimal.NewFromInt(30), Time: start.Add(121 * time.Minute)},
+			{MarketID: "1", QuotePrice: decimal.NewFromInt(50), Time: start.Add(130 * time.Minute)},
+		},
+	}
+	balances := map[string][]domain.MarketBalance{
+		"1": {
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(1), Time: start, SnapshotID: "a"},
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(3), Time: start.Add(time.Hour), SnapshotID: "b"},
+			// balance of a snapshot without a price is ignored
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(9), Time: start.Add(90 * time.Minute), SnapshotID: "c"},
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(4), Time: start.Add(122 * time.Minute)},
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(6), Time: start.Add(140 * time.Minute)},
+			// balance without a price in its window is ignored
+			{MarketID: "1", BaseBalance: decimal.NewFromInt(7), Time: start.Add(245 * time.Minute)},
+		},
+	}
+
+	vwap, err := VWAP(prices, balances, "1h", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// (10*1 + 20*3 + 40*5) / (1 + 3 + 5)
+	if want := decimal.NewFromInt(30); !vwap.Equal(want) {
+		t.Errorf("VWAP() = %v, want %v", vwap, want)
+	}
+}
@@ -2,26 +2,62 @@ package grpchandler
 
 import (
 	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
 	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
 	"github.com/tdex-network/tdex-analytics/internal/core/application"
 )
 
 type analyticsHandler struct {
 	tdexav1.UnimplementedAnalyticsServer
-	marketBalanceSvc application.MarketBalanceService
-	marketPriceSvc   application.MarketPriceService
-	marketSvc        application.MarketService
+	marketBalanceSvc   application.MarketBalanceService
+	marketPriceSvc     application.MarketPriceService
+	marketSvc          application.MarketService
+	tvlSvc             application.TotalValueLockedService
+	marketVolumeSvc    application.MarketVolumeService
+	marketSpreadSvc    application.MarketSpreadService
+	marketDeviationSvc application.MarketDeviationService
+	marketFeeSvc       application.MarketFeeService
+	marketDepthSvc     application.MarketDepthService
+	marketUpdatesSvc   application.MarketUpdatesService
+	alertSvc           application.AlertService
+	marketUptimeSvc    application.MarketUptimeService
+	marketStatusSvc    application.MarketStatusService
+	leaderSvc          application.LeaderService
 }
 
 func NewAnalyticsHandler(
 	marketBalanceSvc application.MarketBalanceService,
 	marketPriceSvc application.MarketPriceService,
 	marketSvc application.MarketService,
+	tvlSvc application.TotalValueLockedService,
+	marketVolumeSvc application.MarketVolumeService,
+	marketSpreadSvc application.MarketSpreadService,
+	marketDeviationSvc application.MarketDeviationService,
+	marketFeeSvc application.MarketFeeService,
+	marketDepthSvc application.MarketDepthService,
+	marketUpdatesSvc application.MarketUpdatesService,
+	alertSvc application.AlertService,
+	marketUptimeSvc application.MarketUptimeService,
+	marketStatusSvc application.MarketStatusService,
+	leaderSvc application.LeaderService,
 ) tdexav1.AnalyticsServer {
 	return &analyticsHandler{
-		marketBalanceSvc: marketBalanceSvc,
-		marketPriceSvc:   marketPriceSvc,
-		marketSvc:        marketSvc,
+		marketBalanceSvc:   marketBalanceSvc,
+		marketPriceSvc:     marketPriceSvc,
+		marketSvc:          marketSvc,
+		tvlSvc:             tvlSvc,
+		marketVolumeSvc:    marketVolumeSvc,
+		marketSpreadSvc:    marketSpreadSvc,
+		marketDeviationSvc: marketDeviationSvc,
+		marketFeeSvc:       marketFeeSvc,
+		marketDepthSvc:     marketDepthSvc,
+		marketUpdatesSvc:   marketUpdatesSvc,
+		alertSvc:           alertSvc,
+		marketUptimeSvc:    marketUptimeSvc,
+		marketStatusSvc:    marketStatusSvc,
+		leaderSvc:          leaderSvc,
 	}
 }
 
@@ -118,6 +154,627 @@ func (a *analyticsHandler) MarketsPrices(
 	}, nil
 }
 
+func (a *analyticsHandler) MarketsCandles(
+	ctx context.Context,
+	req *tdexav1.MarketsCandlesRequest,
+) (*tdexav1.MarketsCandlesReply, error) {
+	mc, err := a.marketPriceSvc.GetCandles(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parsePage(req.GetPage()),
+		req.GetReferenceCurrency(),
+		parseTimeFrame(req.GetTimeFrame()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsCandles := make(map[string]*tdexav1.MarketCandles)
+
+	for k, v := range mc.MarketsCandles {
+		marketCandles := make([]*tdexav1.MarketCandle, 0, len(v))
+		for _, v1 := range v {
+			open, _ := v1.Open.Float64()
+			high, _ := v1.High.Float64()
+			low, _ := v1.Low.Float64()
+			closePrice, _ := v1.Close.Float64()
+			referenceOpen, _ := v1.ReferentOpen.Float64()
+			referenceHigh, _ := v1.ReferentHigh.Float64()
+			referenceLow, _ := v1.ReferentLow.Float64()
+			referenceClose, _ := v1.ReferentClose.Float64()
+			marketCandles = append(marketCandles, &tdexav1.MarketCandle{
+				Open:           open,
+				High:           high,
+				Low:            low,
+				Close:          closePrice,
+				ReferenceOpen:  referenceOpen,
+				ReferenceHigh:  referenceHigh,
+				ReferenceLow:   referenceLow,
+				ReferenceClose: referenceClose,
+				Time:           v1.Time.String(),
+			})
+		}
+		marketsCandles[k] = &tdexav1.MarketCandles{
+			MarketCandle: marketCandles,
+		}
+	}
+
+	return &tdexav1.MarketsCandlesReply{
+		MarketsCandles: marketsCandles,
+	}, nil
+}
+
+func (a *analyticsHandler) GetTotalValueLocked(
+	ctx context.Context,
+	req *tdexav1.GetTotalValueLockedRequest,
+) (*tdexav1.GetTotalValueLockedReply, error) {
+	var timeRange *application.TimeRange
+	if req.GetTimeRange() != nil {
+		tr := grpcTimeRangeToAppTimeRange(req.GetTimeRange())
+		timeRange = &tr
+	}
+
+	tvls, err := a.tvlSvc.GetTotalValueLocked(
+		ctx,
+		timeRange,
+		parseTimeFrame(req.GetTimeFrame()),
+		req.GetReferenceCurrency(),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	totalValueLocked := make([]*tdexav1.TotalValueLocked, 0, len(tvls))
+	for _, v := range tvls {
+		markets := make([]*tdexav1.MarketValueLocked, 0, len(v.Markets))
+		for _, v1 := range v.Markets {
+			baseAmount, _ := v1.BaseAmount.Float64()
+			baseValue, _ := v1.BaseValue.Float64()
+			quoteAmount, _ := v1.QuoteAmount.Float64()
+			quoteValue, _ := v1.QuoteValue.Float64()
+			total, _ := v1.Total.Float64()
+			markets = append(markets, &tdexav1.MarketValueLocked{
+				MarketId:     v1.MarketID,
+				ProviderName: v1.ProviderName,
+				BaseAsset:    v1.BaseAsset,
+				BaseAmount:   baseAmount,
+				BaseValue:    baseValue,
+				QuoteAsset:   v1.QuoteAsset,
+				QuoteAmount:  quoteAmount,
+				QuoteValue:   quoteValue,
+				Total:        total,
+			})
+		}
+
+		providers := make([]*tdexav1.ProviderValueLocked, 0, len(v.Providers))
+		for _, v1 := range v.Providers {
+			total, _ := v1.Total.Float64()
+			providers = append(providers, &tdexav1.ProviderValueLocked{
+				ProviderName: v1.ProviderName,
+				Total:        total,
+			})
+		}
+
+		assets := make([]*tdexav1.AssetValueLocked, 0, len(v.Assets))
+		for _, v1 := range v.Assets {
+			amount, _ := v1.Amount.Float64()
+			value, _ := v1.Value.Float64()
+			assets = append(assets, &tdexav1.AssetValueLocked{
+				Asset:  v1.Asset,
+				Amount: amount,
+				Value:  value,
+			})
+		}
+
+		total, _ := v.Total.Float64()
+		totalValueLocked = append(totalValueLocked, &tdexav1.TotalValueLocked{
+			ReferenceCurrency: v.ReferenceCurrency,
+			Total:             total,
+			Markets:           markets,
+			Providers:         providers,
+			Assets:            assets,
+			Time:              v.Time.String(),
+		})
+	}
+
+	return &tdexav1.GetTotalValueLockedReply{
+		TotalValueLocked: totalValueLocked,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsVolumes(
+	ctx context.Context,
+	req *tdexav1.MarketsVolumesRequest,
+) (*tdexav1.MarketsVolumesReply, error) {
+	mv, err := a.marketVolumeSvc.GetVolumes(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parseTimeFrame(req.GetTimeFrame()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsVolumes := make(map[string]*tdexav1.MarketVolumes)
+
+	for k, v := range mv.MarketsVolumes {
+		marketVolumes := make([]*tdexav1.MarketVolume, 0, len(v))
+		for _, v1 := range v {
+			baseVolume, _ := v1.BaseVolume.Float64()
+			quoteVolume, _ := v1.QuoteVolume.Float64()
+			vwap, _ := v1.Vwap.Float64()
+			marketVolumes = append(marketVolumes, &tdexav1.MarketVolume{
+				BaseVolume:  baseVolume,
+				QuoteVolume: quoteVolume,
+				TradeCount:  uint64(v1.TradeCount),
+				Vwap:        vwap,
+				Time:        v1.Time.String(),
+			})
+		}
+		marketsVolumes[k] = &tdexav1.MarketVolumes{
+			MarketVolume: marketVolumes,
+		}
+	}
+
+	return &tdexav1.MarketsVolumesReply{
+		MarketsVolumes: marketsVolumes,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsSpreads(
+	ctx context.Context,
+	req *tdexav1.MarketsSpreadsRequest,
+) (*tdexav1.MarketsSpreadsReply, error) {
+	var feeBps *int
+	if req.FeeBps != nil {
+		fee := int(req.GetFeeBps())
+		feeBps = &fee
+	}
+
+	ps, err := a.marketSpreadSvc.GetSpreads(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parseTimeFrame(req.GetTimeFrame()),
+		feeBps,
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pairSpreads := make([]*tdexav1.PairSpreads, 0, len(ps))
+	for _, v := range ps {
+		spreads := make([]*tdexav1.MarketSpread, 0, len(v.Spreads))
+		for _, v1 := range v.Spreads {
+			bestBid, _ := v1.BestBid.Float64()
+			bestAsk, _ := v1.BestAsk.Float64()
+			spread, _ := v1.Spread.Float64()
+			spreadBps, _ := v1.SpreadBps.Float64()
+			spreads = append(spreads, &tdexav1.MarketSpread{
+				BestBid:         bestBid,
+				BestBidMarketId: v1.BestBidMarketID,
+				BestAsk:         bestAsk,
+				BestAskMarketId: v1.BestAskMarketID,
+				Spread:          spread,
+				SpreadBps:       spreadBps,
+				Arbitrage:       v1.Arbitrage,
+				Time:            v1.Time.String(),
+			})
+		}
+		pairSpreads = append(pairSpreads, &tdexav1.PairSpreads{
+			BaseAsset:  v.BaseAsset,
+			QuoteAsset: v.QuoteAsset,
+			MarketIds:  v.MarketIDs,
+			Spreads:    spreads,
+		})
+	}
+
+	return &tdexav1.MarketsSpreadsReply{
+		PairSpreads: pairSpreads,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsDeviations(
+	ctx context.Context,
+	req *tdexav1.MarketsDeviationsRequest,
+) (*tdexav1.MarketsDeviationsReply, error) {
+	md, err := a.marketDeviationSvc.GetDeviations(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parseTimeFrame(req.GetTimeFrame()),
+		int(req.GetToleranceBps()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsDeviations := make(map[string]*tdexav1.MarketDeviations)
+
+	for k, v := range md.MarketsDeviations {
+		deviations := make([]*tdexav1.MarketDeviation, 0, len(v.Deviations))
+		for _, v1 := range v.Deviations {
+			price, _ := v1.Price.Float64()
+			referencePrice, _ := v1.ReferencePrice.Float64()
+			deviationBps, _ := v1.DeviationBps.Float64()
+			deviations = append(deviations, &tdexav1.MarketDeviation{
+				Price:          price,
+				ReferencePrice: referencePrice,
+				DeviationBps:   deviationBps,
+				Time:           v1.Time.String(),
+			})
+		}
+
+		meanBps, _ := v.MeanBps.Float64()
+		maxBps, _ := v.MaxBps.Float64()
+		outsideTolerancePercentage, _ := v.OutsideTolerancePercentage.Float64()
+		marketsDeviations[k] = &tdexav1.MarketDeviations{
+			BaseAsset:                  v.BaseAsset,
+			QuoteAsset:                 v.QuoteAsset,
+			Deviations:                 deviations,
+			MeanBps:                    meanBps,
+			MaxBps:                     maxBps,
+			OutsideTolerancePercentage: outsideTolerancePercentage,
+		}
+	}
+
+	return &tdexav1.MarketsDeviationsReply{
+		MarketsDeviations: marketsDeviations,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsFees(
+	ctx context.Context,
+	req *tdexav1.MarketsFeesRequest,
+) (*tdexav1.MarketsFeesReply, error) {
+	mf, err := a.marketFeeSvc.GetFees(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parsePage(req.GetPage()),
+		parseTimeFrame(req.GetTimeFrame()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsFees := make(map[string]*tdexav1.MarketFees)
+
+	for k, v := range mf.MarketsFees {
+		marketFees := make([]*tdexav1.MarketFee, 0, len(v))
+		var baseAsset, quoteAsset string
+		for _, v1 := range v {
+			baseAsset = v1.BaseAsset
+			quoteAsset = v1.QuoteAsset
+			marketFees = append(marketFees, &tdexav1.MarketFee{
+				BasePercentageFee:  v1.BasePercentageFee.IntPart(),
+				QuotePercentageFee: v1.QuotePercentageFee.IntPart(),
+				BaseFixedFee:       v1.BaseFixedFee.IntPart(),
+				QuoteFixedFee:      v1.QuoteFixedFee.IntPart(),
+				PreviewFeeAmount:   uint64(v1.PreviewFeeAmount.IntPart()),
+				PreviewFeeAsset:    v1.PreviewFeeAsset,
+				Time:               v1.Time.String(),
+			})
+		}
+		marketsFees[k] = &tdexav1.MarketFees{
+			BaseAsset:  baseAsset,
+			QuoteAsset: quoteAsset,
+			MarketFee:  marketFees,
+		}
+	}
+
+	return &tdexav1.MarketsFeesReply{
+		MarketsFees: marketsFees,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsDepth(
+	ctx context.Context,
+	req *tdexav1.MarketsDepthRequest,
+) (*tdexav1.MarketsDepthReply, error) {
+	md, err := a.marketDepthSvc.GetDepth(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		parseTimeFrame(req.GetTimeFrame()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsDepth := make(map[string]*tdexav1.MarketDepth)
+
+	for k, v := range md.MarketsDepth {
+		curves := make([]*tdexav1.DepthCurve, 0, len(v.Curves))
+		for _, v1 := range v.Curves {
+			levels := make([]*tdexav1.DepthLevel, 0, len(v1.Levels))
+			for _, v2 := range v1.Levels {
+				amount, _ := v2.Amount.Float64()
+				buyPrice, _ := v2.BuyPrice.Float64()
+				buySlippageBps, _ := v2.BuySlippageBps.Float64()
+				sellPrice, _ := v2.SellPrice.Float64()
+				sellSlippageBps, _ := v2.SellSlippageBps.Float64()
+				levels = append(levels, &tdexav1.DepthLevel{
+					Amount:          amount,
+					BuyPrice:        buyPrice,
+					BuySlippageBps:  buySlippageBps,
+					SellPrice:       sellPrice,
+					SellSlippageBps: sellSlippageBps,
+				})
+			}
+
+			midPrice, _ := v1.MidPrice.Float64()
+			curves = append(curves, &tdexav1.DepthCurve{
+				MidPrice: midPrice,
+				Levels:   levels,
+				Time:     v1.Time.String(),
+			})
+		}
+
+		marketsDepth[k] = &tdexav1.MarketDepth{
+			BaseAsset:  v.BaseAsset,
+			QuoteAsset: v.QuoteAsset,
+			Curves:     curves,
+		}
+	}
+
+	return &tdexav1.MarketsDepthReply{
+		MarketsDepth: marketsDepth,
+	}, nil
+}
+
+func (a *analyticsHandler) SubscribeMarkets(
+	req *tdexav1.SubscribeMarketsRequest,
+	stream tdexav1.Analytics_SubscribeMarketsServer,
+) error {
+	updates := a.marketUpdatesSvc.Subscribe(stream.Context(), req.GetMarketIds()...)
+	for update := range updates {
+		reply := &tdexav1.SubscribeMarketsReply{
+			MarketId: update.MarketID,
+		}
+		if update.Price != nil {
+			basePrice, _ := update.Price.BasePrice.Float64()
+			quotePrice, _ := update.Price.QuotePrice.Float64()
+			reply.Update = &tdexav1.SubscribeMarketsReply_Price{
+				Price: &tdexav1.MarketPrice{
+					BasePrice:  basePrice,
+					QuotePrice: quotePrice,
+					Time:       update.Price.Time.String(),
+				},
+			}
+		}
+		if update.Balance != nil {
+			baseBalance, _ := update.Balance.BaseBalance.Float64()
+			quoteBalance, _ := update.Balance.QuoteBalance.Float64()
+			reply.Update = &tdexav1.SubscribeMarketsReply_Balance{
+				Balance: &tdexav1.MarketBalance{
+					BaseBalance:  baseBalance,
+					QuoteBalance: quoteBalance,
+					Time:         update.Balance.Time.String(),
+				},
+			}
+		}
+
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *analyticsHandler) CreateAlertRule(
+	ctx context.Context,
+	req *tdexav1.CreateAlertRuleRequest,
+) (*tdexav1.CreateAlertRuleReply, error) {
+	id, err := a.alertSvc.CreateAlertRule(ctx, parseAlertRule(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tdexav1.CreateAlertRuleReply{
+		Id: int64(id),
+	}, nil
+}
+
+func (a *analyticsHandler) UpdateAlertRule(
+	ctx context.Context,
+	req *tdexav1.UpdateAlertRuleRequest,
+) (*tdexav1.UpdateAlertRuleReply, error) {
+	if err := a.alertSvc.UpdateAlertRule(
+		ctx,
+		parseAlertRule(req.GetRule()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &tdexav1.UpdateAlertRuleReply{}, nil
+}
+
+func (a *analyticsHandler) DeleteAlertRule(
+	ctx context.Context,
+	req *tdexav1.DeleteAlertRuleRequest,
+) (*tdexav1.DeleteAlertRuleReply, error) {
+	if err := a.alertSvc.DeleteAlertRule(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &tdexav1.DeleteAlertRuleReply{}, nil
+}
+
+func (a *analyticsHandler) ListAlertRules(
+	ctx context.Context,
+	req *tdexav1.ListAlertRulesRequest,
+) (*tdexav1.ListAlertRulesReply, error) {
+	rules, err := a.alertSvc.ListAlertRules(ctx, req.GetMarketIds()...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*tdexav1.AlertRule, 0, len(rules))
+	for _, v := range rules {
+		threshold, _ := v.Threshold.Float64()
+		resp = append(resp, &tdexav1.AlertRule{
+			Id:            int64(v.ID),
+			MarketId:      v.MarketID,
+			Type:          alertRuleTypeToGrpc(v.Type),
+			Asset:         alertAssetToGrpc(v.Asset),
+			Threshold:     threshold,
+			WindowMinutes: int64(v.WindowMinutes),
+			WebhookUrl:    v.WebhookUrl,
+			Triggered:     v.Triggered,
+			CreatedAt:     v.CreatedAt.String(),
+		})
+	}
+
+	return &tdexav1.ListAlertRulesReply{
+		Rules: resp,
+	}, nil
+}
+
+func (a *analyticsHandler) ListAlertDeliveries(
+	ctx context.Context,
+	req *tdexav1.ListAlertDeliveriesRequest,
+) (*tdexav1.ListAlertDeliveriesReply, error) {
+	deliveries, err := a.alertSvc.ListAlertDeliveries(
+		ctx,
+		int(req.GetRuleId()),
+		parsePage(req.GetPage()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*tdexav1.AlertDelivery, 0, len(deliveries))
+	for _, v := range deliveries {
+		resp = append(resp, &tdexav1.AlertDelivery{
+			Id:         int64(v.ID),
+			RuleId:     int64(v.RuleID),
+			Payload:    v.Payload,
+			Attempts:   int64(v.Attempts),
+			StatusCode: int64(v.StatusCode),
+			Error:      v.Error,
+			Delivered:  v.Delivered,
+			Time:       v.Time.String(),
+		})
+	}
+
+	return &tdexav1.ListAlertDeliveriesReply{
+		Deliveries: resp,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsUptime(
+	ctx context.Context,
+	req *tdexav1.MarketsUptimeRequest,
+) (*tdexav1.MarketsUptimeReply, error) {
+	mu, err := a.marketUptimeSvc.GetUptime(
+		ctx,
+		grpcTimeRangeToAppTimeRange(req.GetTimeRange()),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsUptime := make(map[string]*tdexav1.MarketUptime)
+	for k, v := range mu.MarketsUptime {
+		marketsUptime[k] = &tdexav1.MarketUptime{
+			ProviderName: v.ProviderName,
+			Url:          v.Url,
+			BaseAsset:    v.BaseAsset,
+			QuoteAsset:   v.QuoteAsset,
+			Uptime:       uptimeToGrpc(v.Uptime),
+		}
+	}
+
+	providersUptime := make(map[string]*tdexav1.ProviderUptime)
+	for k, v := range mu.ProvidersUptime {
+		providersUptime[k] = &tdexav1.ProviderUptime{
+			ProviderName: v.ProviderName,
+			Uptime:       uptimeToGrpc(v.Uptime),
+		}
+	}
+
+	return &tdexav1.MarketsUptimeReply{
+		MarketsUptime:   marketsUptime,
+		ProvidersUptime: providersUptime,
+	}, nil
+}
+
+func (a *analyticsHandler) MarketsStatus(
+	ctx context.Context,
+	req *tdexav1.MarketsStatusRequest,
+) (*tdexav1.MarketsStatusReply, error) {
+	ms, err := a.marketStatusSvc.GetMarketsStatus(ctx, req.GetMarketIds()...)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsStatus := make(map[string]*tdexav1.MarketStatus)
+	for k, v := range ms.MarketsStatus {
+		fetchStatuses := make([]*tdexav1.FetchStatus, 0, len(v.FetchStatuses))
+		for _, s := range v.FetchStatuses {
+			fetchStatuses = append(fetchStatuses, &tdexav1.FetchStatus{
+				Job:                 s.Job,
+				LastAttempt:         timeToGrpc(s.LastAttempt),
+				LastSuccess:         timeToGrpc(s.LastSuccess),
+				LastError:           s.LastError,
+				LastErrorType:       s.LastErrorType,
+				LastErrorTime:       timeToGrpc(s.LastErrorTime),
+				ConsecutiveFailures: uint32(s.ConsecutiveFailures),
+				FailingSince:        timeToGrpc(s.FailingSince),
+				Protocol:            s.Protocol,
+			})
+		}
+
+		marketsStatus[k] = &tdexav1.MarketStatus{
+			ProviderName:  v.ProviderName,
+			Url:           v.Url,
+			BaseAsset:     v.BaseAsset,
+			QuoteAsset:    v.QuoteAsset,
+			Active:        v.Active,
+			FetchStatuses: fetchStatuses,
+		}
+	}
+
+	providersCircuitBreaker := make(map[string]*tdexav1.CircuitBreaker)
+	for k, v := range ms.ProvidersCircuitBreaker {
+		providersCircuitBreaker[k] = &tdexav1.CircuitBreaker{
+			State:               v.State,
+			ConsecutiveFailures: uint32(v.ConsecutiveFailures),
+			LastError:           v.LastError,
+			RetryAt:             timeToGrpc(v.RetryAt),
+		}
+	}
+
+	return &tdexav1.MarketsStatusReply{
+		MarketsStatus:           marketsStatus,
+		ProvidersCircuitBreaker: providersCircuitBreaker,
+	}, nil
+}
+
+func (a *analyticsHandler) LeaderStatus(
+	ctx context.Context,
+	req *tdexav1.LeaderStatusRequest,
+) (*tdexav1.LeaderStatusReply, error) {
+	status, err := a.leaderSvc.GetLeaderStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tdexav1.LeaderStatusReply{
+		NodeId:          status.NodeID,
+		IsLeader:        status.IsLeader,
+		LeaderNodeId:    status.LeaderNodeID,
+		LeaderElectedAt: timeToGrpc(status.LeaderElectedAt),
+		LeaderRenewedAt: timeToGrpc(status.LeaderRenewedAt),
+	}, nil
+}
+
 func (a *analyticsHandler) ListMarkets(
 	ctx context.Context,
 	req *tdexav1.ListMarketsRequest,
@@ -203,3 +860,92 @@ func parseTimeFrame(timeFrame tdexav1.TimeFrame) application.TimeFrame {
 		return application.TzNil
 	}
 }
+
+func parseAlertRule(rule *tdexav1.AlertRule) application.AlertRule {
+	var ruleType string
+	switch rule.GetType() {
+	case tdexav1.AlertRuleType_ALERT_RULE_TYPE_BALANCE_BELOW:
+		ruleType = application.AlertRuleBalanceBelow
+	case tdexav1.AlertRuleType_ALERT_RULE_TYPE_PRICE_CHANGE:
+		ruleType = application.AlertRulePriceChange
+	case tdexav1.AlertRuleType_ALERT_RULE_TYPE_MARKET_INACTIVE:
+		ruleType = application.AlertRuleMarketInactive
+	}
+
+	var asset string
+	switch rule.GetAsset() {
+	case tdexav1.AlertAsset_ALERT_ASSET_BASE:
+		asset = application.AlertAssetBase
+	case tdexav1.AlertAsset_ALERT_ASSET_QUOTE:
+		asset = application.AlertAssetQuote
+	}
+
+	return application.AlertRule{
+		ID:            int(rule.GetId()),
+		MarketID:      rule.GetMarketId(),
+		Type:          ruleType,
+		Asset:         asset,
+		Threshold:     decimal.NewFromFloat(rule.GetThreshold()),
+		WindowMinutes: int(rule.GetWindowMinutes()),
+		WebhookUrl:    rule.GetWebhookUrl(),
+		Secret:        rule.GetSecret(),
+	}
+}
+
+func alertRuleTypeToGrpc(ruleType string) tdexav1.AlertRuleType {
+	switch ruleType {
+	case application.AlertRuleBalanceBelow:
+		return tdexav1.AlertRuleType_ALERT_RULE_TYPE_BALANCE_BELOW
+	case application.AlertRulePriceChange:
+		return tdexav1.AlertRuleType_ALERT_RULE_TYPE_PRICE_CHANGE
+	case application.AlertRuleMarketInactive:
+		return tdexav1.AlertRuleType_ALERT_RULE_TYPE_MARKET_INACTIVE
+	default:
+		return tdexav1.AlertRuleType_ALERT_RULE_TYPE_NULL
+	}
+}
+
+func alertAssetToGrpc(asset string) tdexav1.AlertAsset {
+	switch asset {
+	case application.AlertAssetBase:
+		return tdexav1.AlertAsset_ALERT_ASSET_BASE
+	case application.AlertAssetQuote:
+		return tdexav1.AlertAsset_ALERT_ASSET_QUOTE
+	default:
+		return tdexav1.AlertAsset_ALERT_ASSET_NULL
+	}
+}
+
+func uptimeToGrpc(uptime application.Uptime) *tdexav1.Uptime {
+	outages := make([]*tdexav1.Outage, 0, len(uptime.Outages))
+	for _, v := range uptime.Outages {
+		outages = append(outages, &tdexav1.Outage{
+			Start: v.Start.String(),
+			End:   v.End.String(),
+		})
+	}
+
+	var firstSeen, lastSeen string
+	if !uptime.FirstSeen.IsZero() {
+		firstSeen = uptime.FirstSeen.String()
+	}
+	if !uptime.LastSeen.IsZero() {
+		lastSeen = uptime.LastSeen.String()
+	}
+
+	uptimePercentage, _ := uptime.UptimePercentage.Float64()
+	return &tdexav1.Uptime{
+		UptimePercentage: uptimePercentage,
+		Outages:          outages,
+		FirstSeen:        firstSeen,
+		LastSeen:         lastSeen,
+	}
+}
+
+// timeToGrpc returns an empty string for the zero time
+func timeToGrpc(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.String()
+}
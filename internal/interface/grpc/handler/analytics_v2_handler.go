@@ -0,0 +1,84 @@
+package grpchandler
+
+import (
+	"context"
+	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	tdexav2 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v2"
+	"github.com/tdex-network/tdex-analytics/internal/core/application"
+)
+
+type analyticsV2Handler struct {
+	tdexav2.UnimplementedAnalyticsServer
+	marketBalanceSvc application.MarketBalanceService
+}
+
+func NewAnalyticsV2Handler(
+	marketBalanceSvc application.MarketBalanceService,
+) tdexav2.AnalyticsServer {
+	return &analyticsV2Handler{
+		marketBalanceSvc: marketBalanceSvc,
+	}
+}
+
+func (a *analyticsV2Handler) MarketsBalances(
+	ctx context.Context,
+	req *tdexav2.MarketsBalancesRequest,
+) (*tdexav2.MarketsBalancesReply, error) {
+	mb, err := a.marketBalanceSvc.GetBalances(
+		ctx,
+		grpcV2TimeRangeToAppTimeRange(req.GetTimeRange()),
+		parsePageV2(req.GetPage()),
+		parseTimeFrame(tdexav1.TimeFrame(req.GetTimeFrame())),
+		req.GetMarketIds()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	marketsBalances := make(map[string]*tdexav2.MarketBalances)
+
+	for k, v := range mb.MarketsBalances {
+		marketBalances := make([]*tdexav2.MarketBalance, 0, len(v))
+		for _, v1 := range v {
+			marketBalances = append(marketBalances, &tdexav2.MarketBalance{
+				BaseBalance:  v1.BaseBalance.BigInt().Uint64(),
+				QuoteBalance: v1.QuoteBalance.BigInt().Uint64(),
+				Time:         v1.Time.String(),
+			})
+		}
+		marketsBalances[k] = &tdexav2.MarketBalances{
+			MarketBalance: marketBalances,
+		}
+	}
+
+	return &tdexav2.MarketsBalancesReply{
+		MarketsBalances: marketsBalances,
+	}, nil
+}
+
+func grpcV2TimeRangeToAppTimeRange(
+	timeRange *tdexav2.TimeRange,
+) application.TimeRange {
+	var customPeriod *tdexav1.CustomPeriod
+	if timeRange.GetCustomPeriod() != nil {
+		customPeriod = &tdexav1.CustomPeriod{
+			StartDate: timeRange.GetCustomPeriod().GetStartDate(),
+			EndDate:   timeRange.GetCustomPeriod().GetEndDate(),
+		}
+	}
+
+	return grpcTimeRangeToAppTimeRange(&tdexav1.TimeRange{
+		PredefinedPeriod: tdexav1.PredefinedPeriod(timeRange.GetPredefinedPeriod()),
+		CustomPeriod:     customPeriod,
+	})
+}
+
+func parsePageV2(p *tdexav2.Page) application.Page {
+	if p == nil {
+		return parsePage(nil)
+	}
+	return parsePage(&tdexav1.Page{
+		PageNumber: p.GetPageNumber(),
+		PageSize:   p.GetPageSize(),
+	})
+}
@@ -0,0 +1,79 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationKey = "authorization"
+	// gatewayAuthorizationKey is the key the grpc gateway forwards the
+	//Authorization header of http requests with
+	gatewayAuthorizationKey = "grpcgateway-authorization"
+	bearerPrefix            = "Bearer "
+)
+
+// adminMethods are the RPCs requiring the admin token, the ones managing
+// alert rules and their webhooks
+var adminMethods = map[string]bool{
+	"/tdexa.v1.Analytics/CreateAlertRule":     true,
+	"/tdexa.v1.Analytics/UpdateAlertRule":     true,
+	"/tdexa.v1.Analytics/DeleteAlertRule":     true,
+	"/tdexa.v1.Analytics/ListAlertRules":      true,
+	"/tdexa.v1.Analytics/ListAlertDeliveries": true,
+}
+
+func (i *interceptorChain) unaryAuth(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := i.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *interceptorChain) streamAuth(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := i.authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authorize checks the admin token of the calls to admin methods, these are
+// disabled if no token is configured
+func (i *interceptorChain) authorize(ctx context.Context, method string) error {
+	if !adminMethods[method] {
+		return nil
+	}
+	if i.adminToken == "" {
+		return status.Error(
+			codes.PermissionDenied, "admin token not configured, method disabled",
+		)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{authorizationKey, gatewayAuthorizationKey} {
+		for _, v := range md.Get(key) {
+			token := strings.TrimPrefix(v, bearerPrefix)
+			if subtle.ConstantTimeCompare([]byte(token), []byte(i.adminToken)) == 1 {
+				return nil
+			}
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid or missing admin token")
+}
@@ -0,0 +1,35 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorize(t *testing.T) {
+	const method = "/tdexa.v1.Analytics/ListAlertRules"
+	withToken := func(key, token string) context.Context {
+		return metadata.NewIncomingContext(
+			context.Background(), metadata.Pairs(key, token),
+		)
+	}
+
+	// admin methods are disabled without token
+	i := &interceptorChain{}
+	err := i.authorize(withToken(authorizationKey, "Bearer "), method)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	i = &interceptorChain{adminToken: "token"}
+	require.NoError(t, i.authorize(context.Background(), "/tdexa.v1.Analytics/MarketsPrices"))
+	require.NoError(t, i.authorize(withToken(authorizationKey, "Bearer token"), method))
+	require.NoError(t, i.authorize(withToken(gatewayAuthorizationKey, "Bearer token"), method))
+
+	err = i.authorize(context.Background(), method)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = i.authorize(withToken(authorizationKey, "Bearer other"), method)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
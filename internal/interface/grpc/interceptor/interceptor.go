@@ -11,10 +11,15 @@ type Service interface {
 }
 
 type interceptorChain struct {
+	adminToken string
 }
 
-func NewService() (Service, error) {
-	return &interceptorChain{}, nil
+// NewService returns the interceptors of the server, adminToken is required
+// by the admin methods, these are disabled if it is empty
+func NewService(adminToken string) (Service, error) {
+	return &interceptorChain{
+		adminToken: adminToken,
+	}, nil
 }
 
 func (i *interceptorChain) CreateServerOpts() []grpc.ServerOption {
@@ -29,6 +34,14 @@ func (i *interceptorChain) CreateServerOpts() []grpc.ServerOption {
 		streamInterceptors, i.streamLogger,
 	)
 
+	//auth, before the error handler so that its status is not overwritten
+	unaryInterceptors = append(
+		unaryInterceptors, i.unaryAuth,
+	)
+	streamInterceptors = append(
+		streamInterceptors, i.streamAuth,
+	)
+
 	//error handler
 	unaryInterceptors = append(
 		unaryInterceptors, i.unaryErrorHandler,
@@ -3,6 +3,7 @@ package tdexagrpc
 import (
 	"context"
 	tdexav1 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v1"
+	tdexav2 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdexa/v2"
 	"github.com/tdex-network/tdex-analytics/internal/core/application"
 	grpchandler "github.com/tdex-network/tdex-analytics/internal/interface/grpc/handler"
 	"github.com/tdex-network/tdex-analytics/internal/interface/grpc/interceptor"
@@ -28,12 +29,25 @@ type Server interface {
 }
 
 type server struct {
-	serverPort       string
-	marketBalanceSvc application.MarketBalanceService
-	marketPriceSvc   application.MarketPriceService
-	marketsLoaderSvc application.MarketsLoaderService
-	marketSvc        application.MarketService
-	opts             serverOptions
+	serverPort         string
+	marketBalanceSvc   application.MarketBalanceService
+	marketPriceSvc     application.MarketPriceService
+	marketsLoaderSvc   application.MarketsLoaderService
+	marketSvc          application.MarketService
+	tvlSvc             application.TotalValueLockedService
+	marketVolumeSvc    application.MarketVolumeService
+	marketSpreadSvc    application.MarketSpreadService
+	marketDeviationSvc application.MarketDeviationService
+	marketFeeSvc       application.MarketFeeService
+	marketDepthSvc     application.MarketDepthService
+	marketUpdatesSvc   application.MarketUpdatesService
+	alertSvc           application.AlertService
+	marketUptimeSvc    application.MarketUptimeService
+	marketStatusSvc    application.MarketStatusService
+	leaderSvc          application.LeaderService
+	marketsShardSvc    application.MarketsShardService
+	marketSnapshotSvc  application.MarketSnapshotService
+	opts               serverOptions
 }
 
 func NewServer(
@@ -41,35 +55,88 @@ func NewServer(
 	marketBalanceSvc application.MarketBalanceService,
 	marketPriceSvc application.MarketPriceService,
 	marketsLoaderSvc application.MarketsLoaderService,
+	rateHistorySvc application.RateHistoryService,
 	marketSvc application.MarketService,
+	tvlSvc application.TotalValueLockedService,
+	marketVolumeSvc application.MarketVolumeService,
+	marketSpreadSvc application.MarketSpreadService,
+	marketDeviationSvc application.MarketDeviationService,
+	marketFeeSvc application.MarketFeeService,
+	marketDepthSvc application.MarketDepthService,
+	marketUpdatesSvc application.MarketUpdatesService,
+	alertSvc application.AlertService,
+	marketUptimeSvc application.MarketUptimeService,
+	marketStatusSvc application.MarketStatusService,
+	marketSnapshotSvc application.MarketSnapshotService,
+	leaderSvc application.LeaderService,
+	marketsShardSvc application.MarketsShardService,
 	opts ...ServerOption,
 ) (Server, error) {
-	if err := marketsLoaderSvc.StartFetchingMarketsJob(); err != nil {
+	defaultOpts := defaultServerOptions(serverPort)
+	for _, o := range opts {
+		if err := o.apply(&defaultOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	// jobs discovering markets and fetching rates are run only by the leader,
+	//so that replicas don't store every point more than once
+	if err := leaderSvc.Start(
+		application.LeaderJob{
+			Name:  "markets",
+			Start: marketsLoaderSvc.StartFetchingMarketsJob,
+			Stop:  marketsLoaderSvc.StopFetchingMarketsJob,
+		},
+		application.LeaderJob{
+			Name:  "rates",
+			Start: rateHistorySvc.StartFetchingRatesJob,
+			Stop:  rateHistorySvc.StopFetchingRatesJob,
+		},
+	); err != nil {
 		return nil, err
 	}
 
-	if err := marketPriceSvc.StartFetchingPricesJob(); err != nil {
+	// jobs fetching data of every market are run by all replicas, each one
+	//for the markets assigned to it, prices and balances are fetched together
+	//by the snapshot job, so that both are stored with the same time
+	marketsShardSvc.Start()
+
+	if err := marketUpdatesSvc.StartListening(); err != nil {
 		return nil, err
 	}
 
-	if err := marketBalanceSvc.StartFetchingBalancesJob(); err != nil {
+	if err := marketSnapshotSvc.StartFetchingSnapshotsJob(); err != nil {
 		return nil, err
 	}
 
-	defaultOpts := defaultServerOptions(serverPort)
-	for _, o := range opts {
-		if err := o.apply(&defaultOpts); err != nil {
-			return nil, err
-		}
+	if err := marketFeeSvc.StartFetchingFeesJob(); err != nil {
+		return nil, err
+	}
+
+	if err := marketDepthSvc.StartFetchingDepthJob(); err != nil {
+		return nil, err
 	}
 
 	return &server{
-		serverPort:       serverPort,
-		marketBalanceSvc: marketBalanceSvc,
-		marketPriceSvc:   marketPriceSvc,
-		marketsLoaderSvc: marketsLoaderSvc,
-		marketSvc:        marketSvc,
-		opts:             defaultOpts,
+		serverPort:         serverPort,
+		marketBalanceSvc:   marketBalanceSvc,
+		marketPriceSvc:     marketPriceSvc,
+		marketsLoaderSvc:   marketsLoaderSvc,
+		marketSvc:          marketSvc,
+		tvlSvc:             tvlSvc,
+		marketVolumeSvc:    marketVolumeSvc,
+		marketSpreadSvc:    marketSpreadSvc,
+		marketDeviationSvc: marketDeviationSvc,
+		marketFeeSvc:       marketFeeSvc,
+		marketDepthSvc:     marketDepthSvc,
+		marketUpdatesSvc:   marketUpdatesSvc,
+		alertSvc:           alertSvc,
+		marketUptimeSvc:    marketUptimeSvc,
+		marketStatusSvc:    marketStatusSvc,
+		leaderSvc:          leaderSvc,
+		marketsShardSvc:    marketsShardSvc,
+		marketSnapshotSvc:  marketSnapshotSvc,
+		opts:               defaultOpts,
 	}, nil
 }
 
@@ -119,6 +186,16 @@ func (s *server) Start(ctx context.Context, stop context.CancelFunc) <-chan erro
 			close(errC)
 		}()
 
+		// the leadership and the markets are given up right away, so that
+		//other replicas take over the jobs, the ones fetching the markets
+		//are stopped before releasing them
+		s.leaderSvc.Stop()
+		s.marketSnapshotSvc.StopFetchingSnapshotsJob()
+		s.marketFeeSvc.StopFetchingFeesJob()
+		s.marketDepthSvc.StopFetchingDepthJob()
+		s.marketsShardSvc.Stop()
+		s.marketUpdatesSvc.StopListening()
+
 		httpServer.SetKeepAlivesEnabled(false)
 		if err := httpServer.Shutdown(ctxTimeout); err != nil {
 			errC <- err
@@ -151,11 +228,23 @@ func (s *server) tdexaGrpcServer() (*grpc.Server, error) {
 		s.marketBalanceSvc,
 		s.marketPriceSvc,
 		s.marketSvc,
+		s.tvlSvc,
+		s.marketVolumeSvc,
+		s.marketSpreadSvc,
+		s.marketDeviationSvc,
+		s.marketFeeSvc,
+		s.marketDepthSvc,
+		s.marketUpdatesSvc,
+		s.alertSvc,
+		s.marketUptimeSvc,
+		s.marketStatusSvc,
+		s.leaderSvc,
 	)
+	analyticsV2Handler := grpchandler.NewAnalyticsV2Handler(s.marketBalanceSvc)
 
 	healthHandler := grpchandler.NewHealthHandler()
 
-	chainInterceptorSvc, err := interceptor.NewService()
+	chainInterceptorSvc, err := interceptor.NewService(s.opts.adminToken)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +259,7 @@ func (s *server) tdexaGrpcServer() (*grpc.Server, error) {
 
 	tdexaGrpcServer := grpc.NewServer(opts...)
 	tdexav1.RegisterAnalyticsServer(tdexaGrpcServer, analyticsHandler)
+	tdexav2.RegisterAnalyticsServer(tdexaGrpcServer, analyticsV2Handler)
 	grpchealth.RegisterHealthServer(tdexaGrpcServer, healthHandler)
 
 	return tdexaGrpcServer, nil
@@ -185,6 +275,9 @@ func (s *server) tdexaGrpcGateway(ctx context.Context) (http.Handler, error) {
 	if err := tdexav1.RegisterAnalyticsHandler(ctx, grpcGatewayMux, conn); err != nil {
 		return nil, err
 	}
+	if err := tdexav2.RegisterAnalyticsHandler(ctx, grpcGatewayMux, conn); err != nil {
+		return nil, err
+	}
 
 	grpcGatewayHandler := http.Handler(grpcGatewayMux)
 
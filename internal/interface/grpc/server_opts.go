@@ -31,6 +31,7 @@ type serverOptions struct {
 	grpcServerCredsOpts []grpc.ServerOption
 	keyFile             string
 	certFile            string
+	adminToken          string
 }
 
 // funcServerOption wraps a function that modifies serverOptions into an
@@ -80,6 +81,16 @@ func WithTls(certFile, keyFile string) ServerOption {
 	})
 }
 
+// WithAdminToken sets the token required by the RPCs managing alert rules,
+// these are disabled if it is not set
+func WithAdminToken(token string) ServerOption {
+	return newFuncServerOption(func(s *serverOptions) error {
+		s.adminToken = token
+
+		return nil
+	})
+}
+
 func tlsConfig(certFile, keyFile string) (*tls.Config, error) {
 	//TODO add acme
 	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
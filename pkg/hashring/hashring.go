@@ -0,0 +1,80 @@
+package hashring
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// Ring is a consistent hash ring, every node is placed on it at virtualNodes
+// points so that keys are evenly spread among nodes, and only the keys of a
+// joining or leaving node move to another node. Rings built with the same
+// nodes, in any order, assign keys the same way
+type Ring struct {
+	points []uint64
+	owners map[uint64]string
+	nodes  []string
+}
+
+func New(virtualNodes int, nodes ...string) *Ring {
+	sorted := make([]string, 0, len(nodes))
+	seen := make(map[string]bool)
+	for _, v := range nodes {
+		if !seen[v] {
+			seen[v] = true
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Strings(sorted)
+
+	r := &Ring{
+		points: make([]uint64, 0, len(sorted)*virtualNodes),
+		owners: make(map[uint64]string),
+		nodes:  sorted,
+	}
+	for _, node := range sorted {
+		for i := 0; i < virtualNodes; i++ {
+			point := hash(fmt.Sprintf("%s#%d", node, i))
+			// on collisions the point stays to the first node in order
+			if _, ok := r.owners[point]; ok {
+				continue
+			}
+			r.owners[point] = node
+			r.points = append(r.points, point)
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool {
+		return r.points[i] < r.points[j]
+	})
+
+	return r
+}
+
+// Get returns the node owning key, the one of the first point following the
+// key hash on the ring, empty if the ring has no nodes
+func (r *Ring) Get(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i] >= h
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+
+	return r.owners[r.points[i]]
+}
+
+// Nodes returns the nodes of the ring sorted
+func (r *Ring) Nodes() []string {
+	return append([]string(nil), r.nodes...)
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,54 @@
+package hashring
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRing(t *testing.T) {
+	keys := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprintf("market-%d", i))
+	}
+
+	assert.Empty(t, New(100).Get("market-1"))
+
+	ring := New(100, "node1", "node2", "node3", "node1")
+	require.Equal(t, []string{"node1", "node2", "node3"}, ring.Nodes())
+
+	// keys are spread among nodes, the same way whatever the order of nodes
+	sameRing := New(100, "node3", "node1", "node2")
+	owned := make(map[string]int)
+	for _, v := range keys {
+		owner := ring.Get(v)
+		require.Equal(t, owner, sameRing.Get(v))
+		owned[owner]++
+	}
+	require.Len(t, owned, 3)
+	for _, v := range owned {
+		assert.Greater(t, v, 200)
+	}
+
+	// only keys of the leaving node move, the others stay put
+	smallerRing := New(100, "node1", "node3")
+	for _, v := range keys {
+		if owner := ring.Get(v); owner != "node2" {
+			require.Equal(t, owner, smallerRing.Get(v))
+		}
+	}
+
+	// a joining node takes keys only from the others
+	largerRing := New(100, "node1", "node2", "node3", "node4")
+	moved := 0
+	for _, v := range keys {
+		if owner := largerRing.Get(v); owner != ring.Get(v) {
+			require.Equal(t, "node4", owner)
+			moved++
+		}
+	}
+	assert.Greater(t, moved, 0)
+	assert.Less(t, moved, 500)
+}
@@ -0,0 +1,184 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	// AdapterTdex is the adapter of providers speaking the tdex protocol, the
+	//default one of registry entries
+	AdapterTdex = "tdex"
+	// AdapterJsonHttp is the adapter of providers serving plain json over
+	//http, see jsonHttpAdapter
+	AdapterJsonHttp = "json-http"
+)
+
+var (
+	// ErrNotSupportedByAdapter is returned for fees and depth of markets of
+	//providers not speaking the tdex protocol
+	ErrNotSupportedByAdapter = errors.New("not supported by provider adapter")
+)
+
+// SourceAdapter fetches the markets of a liquidity provider, and their
+// balances and prices, speaking the provider protocol, calls are made through
+// the provider circuit breaker and within the request timeout
+type SourceAdapter interface {
+	FetchMarkets(ctx context.Context, liquidityProvider LiquidityProvider) ([]Market, error)
+	FetchBalance(ctx context.Context, market Market) (*Balance, error)
+	FetchPrice(ctx context.Context, market Market) (*Price, error)
+}
+
+// providerAdapters holds the adapter name of the providers listed by the
+// registries, by endpoint
+type providerAdapters struct {
+	lock     sync.RWMutex
+	adapters map[string]string
+	// loaded is set once the registries were fetched at least once
+	loaded bool
+	// loadLock makes concurrent calls wait for a single fetch of the
+	//registries
+	loadLock sync.Mutex
+}
+
+func newProviderAdapters() *providerAdapters {
+	return &providerAdapters{
+		adapters: make(map[string]string),
+	}
+}
+
+func (p *providerAdapters) get(endpoint string) (string, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	adapter, ok := p.adapters[registryKey(endpoint)]
+	return adapter, ok
+}
+
+func (p *providerAdapters) set(liquidityProviders []LiquidityProvider) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, v := range liquidityProviders {
+		p.adapters[registryKey(v.Endpoint)] = adapterName(v)
+	}
+	p.loaded = true
+}
+
+func (p *providerAdapters) isLoaded() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.loaded
+}
+
+func adapterName(liquidityProvider LiquidityProvider) string {
+	if liquidityProvider.Adapter == "" {
+		return AdapterTdex
+	}
+	return liquidityProvider.Adapter
+}
+
+// adapter returns the adapter of the given provider entry
+func (t *tdexMarketLoaderService) adapter(
+	liquidityProvider LiquidityProvider,
+) (SourceAdapter, error) {
+	name := adapterName(liquidityProvider)
+	adapter, ok := t.adapters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown adapter %v", name)
+	}
+
+	return adapter, nil
+}
+
+// marketAdapter returns the adapter of the provider serving market, the
+// registries are fetched if they were not yet, so that markets stored before
+// a restart are fetched with the right adapter, markets of providers no
+// longer listed are assumed to speak the tdex protocol
+func (t *tdexMarketLoaderService) marketAdapter(
+	ctx context.Context,
+	market Market,
+) (SourceAdapter, error) {
+	if !t.providerAdapters.isLoaded() {
+		t.providerAdapters.loadLock.Lock()
+		if !t.providerAdapters.isLoaded() {
+			if liquidityProviders, err := t.fetchLiquidityProviders(ctx); err == nil {
+				t.providerAdapters.set(liquidityProviders)
+			}
+		}
+		t.providerAdapters.loadLock.Unlock()
+	}
+
+	name, _ := t.providerAdapters.get(market.Url)
+	return t.adapter(LiquidityProvider{Endpoint: market.Url, Adapter: name})
+}
+
+// servesTdex returns an error if the provider of market is not tracked with
+// the tdex adapter
+func (t *tdexMarketLoaderService) servesTdex(market Market) error {
+	if name, ok := t.providerAdapters.get(market.Url); ok && name != AdapterTdex {
+		return fmt.Errorf("%w: %v", ErrNotSupportedByAdapter, name)
+	}
+	return nil
+}
+
+// tdexAdapter speaks the tdex protocol, v2 and then v1, served over either
+// gRPC or HTTP/1, the protocol negotiated with every provider is cached
+type tdexAdapter struct {
+	t *tdexMarketLoaderService
+}
+
+func (a *tdexAdapter) FetchMarkets(
+	ctx context.Context,
+	liquidityProvider LiquidityProvider,
+) ([]Market, error) {
+	endpoint := liquidityProvider.Endpoint
+	markets, protocol, err := a.t.fetchMarkets(
+		ctx, liquidityProvider, a.t.protocols.get(endpoint),
+	)
+	// the cached protocol may be outdated, negotiate it again
+	if err != nil && a.t.protocols.forget(endpoint) {
+		markets, protocol, err = a.t.fetchMarkets(ctx, liquidityProvider, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a.t.protocols.set(endpoint, protocol)
+	return markets, nil
+}
+
+func (a *tdexAdapter) FetchBalance(
+	ctx context.Context,
+	market Market,
+) (*Balance, error) {
+	balance, err := a.t.fetchBalance(ctx, market, a.t.protocols.get(market.Url))
+	if err != nil && a.t.protocols.forget(market.Url) {
+		balance, err = a.t.fetchBalance(ctx, market, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a.t.protocols.set(market.Url, balance.Protocol)
+	return balance, nil
+}
+
+func (a *tdexAdapter) FetchPrice(
+	ctx context.Context,
+	market Market,
+) (*Price, error) {
+	price, err := a.t.fetchPrice(ctx, market, a.t.protocols.get(market.Url))
+	if err != nil && a.t.protocols.forget(market.Url) {
+		price, err = a.t.fetchPrice(ctx, market, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a.t.protocols.set(market.Url, price.Protocol)
+	return price, nil
+}
@@ -0,0 +1,224 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestMaxAttempts is the number of times a call to a provider is made
+	//before failing, as long as its timeout is not reached
+	requestMaxAttempts = 3
+	// requestRetryInterval is the wait before the first retry, it is doubled
+	//after every failed attempt
+	requestRetryInterval = 500 * time.Millisecond
+	// breakerMaxCooldown caps the time a breaker stays open, the cooldown is
+	//doubled every time a probe fails
+	breakerMaxCooldown = 30 * time.Minute
+)
+
+var (
+	// ErrProviderUnavailable is returned without calling a provider whose
+	//circuit breaker is open
+	ErrProviderUnavailable = errors.New(
+		"provider unavailable, circuit breaker is open",
+	)
+)
+
+// circuitBreaker stops calling a provider after threshold consecutive
+// failures, after a cooldown a single call probes it and either closes the
+// breaker or opens it again for twice the time
+type circuitBreaker struct {
+	lock      sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	providers map[string]*breakerState
+}
+
+type breakerState struct {
+	state               string
+	consecutiveFailures int
+	lastError           string
+	// opens counts the times the breaker opened since last closed
+	opens     int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		providers: make(map[string]*breakerState),
+	}
+}
+
+// allow returns ErrProviderUnavailable if endpoint can't be called, once the
+// cooldown is over only the first caller is allowed to probe it
+func (c *circuitBreaker) allow(endpoint string, now time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, ok := c.providers[endpoint]
+	if !ok {
+		return nil
+	}
+
+	switch s.state {
+	case CircuitOpen:
+		if now.Before(s.openUntil) {
+			return ErrProviderUnavailable
+		}
+		s.state = CircuitHalfOpen
+		return nil
+	case CircuitHalfOpen:
+		return ErrProviderUnavailable
+	default:
+		return nil
+	}
+}
+
+// available tells if endpoint can be called without probing it, for calls
+// whose failure says nothing about the provider health
+func (c *circuitBreaker) available(endpoint string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, ok := c.providers[endpoint]
+	return !ok || s.state == CircuitClosed
+}
+
+// record updates the breaker of endpoint with the outcome of a call
+func (c *circuitBreaker) record(endpoint string, err error, now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err == nil {
+		delete(c.providers, endpoint)
+		return
+	}
+
+	s, ok := c.providers[endpoint]
+	if !ok {
+		s = &breakerState{state: CircuitClosed}
+		c.providers[endpoint] = s
+	}
+	s.consecutiveFailures++
+	s.lastError = err.Error()
+
+	if s.state == CircuitHalfOpen || s.consecutiveFailures >= c.threshold {
+		cooldown := c.cooldown << s.opens
+		if cooldown > breakerMaxCooldown || cooldown <= 0 {
+			cooldown = breakerMaxCooldown
+		}
+		s.state = CircuitOpen
+		s.opens++
+		s.openUntil = now.Add(cooldown)
+	}
+}
+
+func (c *circuitBreaker) status() map[string]CircuitBreakerStatus {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res := make(map[string]CircuitBreakerStatus)
+	for k, v := range c.providers {
+		status := CircuitBreakerStatus{
+			State:               v.state,
+			ConsecutiveFailures: v.consecutiveFailures,
+			LastError:           v.lastError,
+		}
+		if v.state == CircuitOpen {
+			status.RetryAt = v.openUntil
+		}
+		res[k] = status
+	}
+
+	return res
+}
+
+// callProvider calls endpoint through its circuit breaker, retrying with
+// exponential backoff until requestMaxAttempts or ctx is done
+func (t *tdexMarketLoaderService) callProvider(
+	ctx context.Context,
+	endpoint string,
+	call func() error,
+) error {
+	if err := t.breaker.allow(endpoint, time.Now()); err != nil {
+		return err
+	}
+
+	err := call()
+	interval := requestRetryInterval
+	// a certificate failing verification is not going to change on retry
+	for attempt := 1; err != nil && !isTLSError(err) &&
+		attempt < requestMaxAttempts; attempt++ {
+		if !sleep(ctx, interval) {
+			break
+		}
+		interval *= 2
+		err = call()
+	}
+
+	// errors of a single market, like an unknown pair, prove the provider is
+	//answering, so they don't count toward its breaker
+	breakerErr := err
+	if !isAvailabilityError(err) {
+		breakerErr = nil
+	}
+	t.breaker.record(endpoint, breakerErr, time.Now())
+
+	if isTLSError(err) {
+		return fmt.Errorf("%w: %v", ErrTLSVerification, err)
+	}
+	return err
+}
+
+// isAvailabilityError tells if err means that the provider can't be reached
+// or is not serving: dial, tls and timeout errors, gRPC Unavailable and
+// DeadlineExceeded ones, http/1 server errors
+func isAvailabilityError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if isTLSError(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+		return false
+	}
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// sleep waits for d, false is returned if ctx is done before
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
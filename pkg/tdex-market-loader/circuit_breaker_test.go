@@ -0,0 +1,136 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := newCircuitBreaker(2, time.Minute)
+	now := time.Now()
+	failure := errors.New("connection refused")
+
+	assert.NoError(t, breaker.allow("provider", now))
+	breaker.record("provider", failure, now)
+	assert.True(t, breaker.available("provider"))
+	assert.Equal(t, CircuitClosed, breaker.status()["provider"].State)
+
+	// opens after threshold consecutive failures
+	breaker.record("provider", failure, now)
+	assert.Equal(t, ErrProviderUnavailable, breaker.allow("provider", now))
+	assert.False(t, breaker.available("provider"))
+	status := breaker.status()["provider"]
+	assert.Equal(t, CircuitOpen, status.State)
+	assert.Equal(t, 2, status.ConsecutiveFailures)
+	assert.Equal(t, "connection refused", status.LastError)
+	assert.Equal(t, now.Add(time.Minute), status.RetryAt)
+
+	// a single call probes the provider after the cooldown
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.allow("provider", now))
+	assert.Equal(t, ErrProviderUnavailable, breaker.allow("provider", now))
+	assert.Equal(t, CircuitHalfOpen, breaker.status()["provider"].State)
+
+	// a failed probe doubles the cooldown
+	breaker.record("provider", failure, now)
+	assert.Equal(t, now.Add(2*time.Minute), breaker.status()["provider"].RetryAt)
+
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, breaker.allow("provider", now))
+	breaker.record("provider", nil, now)
+	assert.Empty(t, breaker.status())
+	assert.NoError(t, breaker.allow("provider", now))
+}
+
+func TestCallProvider(t *testing.T) {
+	svc := &tdexMarketLoaderService{
+		breaker: newCircuitBreaker(1, time.Minute),
+	}
+
+	calls := 0
+	err := svc.callProvider(context.Background(), "provider", func() error {
+		calls++
+		if calls < 2 {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, svc.ProvidersCircuitBreaker())
+
+	// no retry once ctx is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	err = svc.callProvider(ctx, "provider", func() error {
+		calls++
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	err = svc.callProvider(context.Background(), "provider", func() error {
+		calls++
+		return nil
+	})
+	assert.Equal(t, ErrProviderUnavailable, err)
+	assert.Equal(t, 1, calls)
+
+	// errors of a single market don't count toward the breaker
+	calls = 0
+	for i := 0; i < 2; i++ {
+		err = svc.callProvider(context.Background(), "market", func() error {
+			calls++
+			return status.Error(codes.InvalidArgument, "market not found")
+		})
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 2*requestMaxAttempts, calls)
+	assert.NotContains(t, svc.ProvidersCircuitBreaker(), "market")
+
+	// tls failures are not retried
+	calls = 0
+	err = svc.callProvider(context.Background(), "other", func() error {
+		calls++
+		return x509.UnknownAuthorityError{}
+	})
+	assert.ErrorIs(t, err, ErrTLSVerification)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIsAvailabilityError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), true},
+		{"deadline", status.Error(codes.DeadlineExceeded, "timeout"), true},
+		{"context deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), true},
+		{"tls", x509.UnknownAuthorityError{}, true},
+		{"dial", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"server error", &httpStatusError{code: http.StatusBadGateway}, true},
+		{"not found", status.Error(codes.NotFound, "market not found"), false},
+		{"invalid argument", status.Error(codes.InvalidArgument, "zero amount"), false},
+		{"bad request", &httpStatusError{code: http.StatusBadRequest}, false},
+		{"application", errors.New("trade preview returned no previews"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isAvailabilityError(tt.err))
+		})
+	}
+}
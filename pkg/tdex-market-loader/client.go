@@ -3,14 +3,14 @@ package tdexmarketloader
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"math/big"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
@@ -38,37 +38,109 @@ const (
 	fetchMarketTradePreviewUrlRegexV1 = "%s/v1/trade/preview"
 )
 
+var (
+	// errProtocolNotTried is returned for a protocol path skipped because
+	//another one is cached for the provider
+	errProtocolNotTried = errors.New("protocol not tried")
+)
+
 type Service interface {
 	FetchProvidersMarkets(ctx context.Context) ([]LiquidityProvider, error)
 	FetchBalance(ctx context.Context, market Market) (*Balance, error)
 	FetchPrice(ctx context.Context, market Market) (*Price, error)
+	// FetchSnapshot fetches price and balance of the market in one pass, over
+	//the same connection, the pass is retried as a whole if any of them fails
+	FetchSnapshot(ctx context.Context, market Market) (*Snapshot, error)
+	FetchFee(ctx context.Context, market Market) (*Fee, error)
+	// FetchDepth previews buying and selling each of the given amounts of
+	//market base asset, levels whose preview fails, for example because the
+	//market can't fill the amount, are omitted
+	FetchDepth(ctx context.Context, market Market, amounts []uint64) ([]DepthLevel, error)
+	// ProvidersCircuitBreaker returns, by provider endpoint, the circuit
+	//breaker of providers that failed since they last answered
+	ProvidersCircuitBreaker() map[string]CircuitBreakerStatus
 }
 
 type tdexMarketLoaderService struct {
 	torProxyUrl string
-	registryUrl string
+	registries  []RegistrySource
 	priceAmount int
+	// requestTimeout bounds every call made to a provider, fallbacks
+	//included
+	requestTimeout time.Duration
+	connPool       *connPool
+	httpClients    *httpClients
+	protocols      *protocolCache
+	breaker        *circuitBreaker
+	pins           *tlsPins
+	// adapters are the SourceAdapter implementations by name
+	adapters         map[string]SourceAdapter
+	providerAdapters *providerAdapters
+}
+
+type Config struct {
+	TorProxyUrl string
+	// Registries list the liquidity providers to track
+	Registries  []RegistrySource
+	PriceAmount int
+	// ConnIdleTimeout is time after which the gRPC connection shared by the
+	//calls to a provider is closed if unused
+	ConnIdleTimeout time.Duration
+	// RequestTimeout is timeout of every call to a provider
+	RequestTimeout time.Duration
+	// BreakerThreshold is number of consecutive failures to reach a provider
+	//after which it is not called for BreakerCooldown, doubled on every
+	//failed probe, errors of a single market are not counted
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// PinsOverride maps provider endpoints to the pins of their certificates
+	//and takes precedence over the ones published in the registry
+	PinsOverride map[string][]string
 }
 
-func NewService(torProxyUrl, registryUrl string, priceAmount int) Service {
-	return &tdexMarketLoaderService{
-		torProxyUrl: torProxyUrl,
-		registryUrl: registryUrl,
-		priceAmount: priceAmount,
+// NewService returns a Service tracking the providers listed by the
+// registries of the given config
+func NewService(config Config) Service {
+	t := &tdexMarketLoaderService{
+		torProxyUrl:    config.TorProxyUrl,
+		registries:     config.Registries,
+		priceAmount:    config.PriceAmount,
+		requestTimeout: config.RequestTimeout,
+		protocols:      newProtocolCache(),
+		breaker: newCircuitBreaker(
+			config.BreakerThreshold, config.BreakerCooldown,
+		),
+		pins:             newTLSPins(config.PinsOverride),
+		providerAdapters: newProviderAdapters(),
+	}
+	t.connPool = newConnPool(config.ConnIdleTimeout, t.dialConn)
+	t.httpClients = newHttpClients(config.ConnIdleTimeout, t.newHttpTransport)
+	t.adapters = map[string]SourceAdapter{
+		AdapterTdex:     &tdexAdapter{t},
+		AdapterJsonHttp: &jsonHttpAdapter{t},
 	}
+
+	return t
 }
 
 func (t *tdexMarketLoaderService) FetchProvidersMarkets(
 	ctx context.Context,
 ) ([]LiquidityProvider, error) {
 	res := make([]LiquidityProvider, 0)
-	liquidityProviders, err := t.fetchLiquidityProviders()
+	liquidityProviders, err := t.fetchLiquidityProviders(ctx)
 	if err != nil {
 		return nil, err
 	}
+	t.providerAdapters.set(liquidityProviders)
 
 	for _, v := range liquidityProviders {
+		t.setRegistryPins(v)
+
 		markets, err := t.fetchLiquidityProviderMarkets(ctx, v)
+		if errors.Is(err, ErrProviderUnavailable) {
+			log.Debugf("skip fetching markets for liquidity provider: %v, err: %v", v.Name, err)
+			continue
+		}
 		if err != nil {
 			log.Errorf(
 				"error while trying to fetch markets for liquidity provider: %v, err: %v\n",
@@ -81,6 +153,7 @@ func (t *tdexMarketLoaderService) FetchProvidersMarkets(
 		res = append(res, LiquidityProvider{
 			Name:     v.Name,
 			Endpoint: v.Endpoint,
+			Adapter:  adapterName(v),
 			Markets:  markets,
 		})
 	}
@@ -92,29 +165,370 @@ func (t *tdexMarketLoaderService) FetchBalance(
 	ctx context.Context,
 	market Market,
 ) (*Balance, error) {
-	balance, err := t.getBalanceV2(ctx, market)
-	if err == nil {
-		return balance, nil
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	adapter, err := t.marketAdapter(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	var balance *Balance
+	if err := t.callProvider(ctx, market.Url, func() (err error) {
+		balance, err = adapter.FetchBalance(ctx, market)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// fetchBalance tries v2 and then v1 protocol, or only the cached one
+func (t *tdexMarketLoaderService) fetchBalance(
+	ctx context.Context,
+	market Market,
+	cached string,
+) (*Balance, error) {
+	if !tryV2(cached) {
+		return t.getBalanceV1(ctx, market, cached)
+	}
+
+	balance, err := t.getBalanceV2(ctx, market, cached)
+	if err == nil || !tryV1(cached) {
+		return balance, err
 	}
 
-	return t.getBalanceV1(ctx, market)
+	return t.getBalanceV1(ctx, market, cached)
 }
 
 func (t *tdexMarketLoaderService) FetchPrice(
 	ctx context.Context,
 	market Market,
 ) (*Price, error) {
-	price, err := t.getPriceV2(ctx, market)
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	adapter, err := t.marketAdapter(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	var price *Price
+	if err := t.callProvider(ctx, market.Url, func() (err error) {
+		price, err = adapter.FetchPrice(ctx, market)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return price, nil
+}
+
+// fetchPrice tries v2 and then v1 protocol, or only the cached one
+func (t *tdexMarketLoaderService) fetchPrice(
+	ctx context.Context,
+	market Market,
+	cached string,
+) (*Price, error) {
+	if !tryV2(cached) {
+		return t.getPriceV1(ctx, market, cached)
+	}
+
+	price, err := t.getPriceV2(ctx, market, cached)
+	if err == nil || !tryV1(cached) {
+		return price, err
+	}
+
+	return t.getPriceV1(ctx, market, cached)
+}
+
+func (t *tdexMarketLoaderService) FetchSnapshot(
+	ctx context.Context,
+	market Market,
+) (*Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	adapter, err := t.marketAdapter(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	// holding the pooled connection for the whole pass prevents it from
+	//being evicted, and dialed again, between price and balance calls
+	if _, ok := adapter.(*tdexAdapter); ok && tryGrpc(t.protocols.get(market.Url)) {
+		if _, release, err := t.getConn(market.Url); err == nil {
+			defer release()
+		}
+	}
+
+	var snapshot *Snapshot
+	if err := t.callProvider(ctx, market.Url, func() error {
+		price, err := adapter.FetchPrice(ctx, market)
+		if err != nil {
+			return err
+		}
+		balance, err := adapter.FetchBalance(ctx, market)
+		if err != nil {
+			return err
+		}
+
+		snapshot = &Snapshot{Price: *price, Balance: *balance}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (t *tdexMarketLoaderService) FetchFee(
+	ctx context.Context,
+	market Market,
+) (*Fee, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	// fee and depth are not served by all providers, so they don't probe
+	//providers nor affect their breaker
+	if !t.breaker.available(market.Url) {
+		return nil, ErrProviderUnavailable
+	}
+	if err := t.servesTdex(market); err != nil {
+		return nil, err
+	}
+
+	cached := t.protocols.get(market.Url)
+	if !tryV2(cached) {
+		return nil, fmt.Errorf("fee not served by %s protocol", cached)
+	}
+
+	fee, err := t.previewFeeV2(ctx, market, cached)
 	if err == nil {
-		return price, nil
+		return fee, nil
+	}
+
+	markets, _, err := t.getMarketsV2(
+		ctx, LiquidityProvider{Endpoint: market.Url}, cached,
+	)
+	if err != nil {
+		return nil, err
 	}
-	return t.getPriceV1(ctx, market)
+
+	for _, v := range markets {
+		if v.BaseAsset == market.BaseAsset && v.QuoteAsset == market.QuoteAsset &&
+			v.Fee != nil {
+			return v.Fee, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"fee not found for market %s/%s", market.BaseAsset, market.QuoteAsset,
+	)
+}
+
+func (t *tdexMarketLoaderService) ProvidersCircuitBreaker() map[string]CircuitBreakerStatus {
+	return t.breaker.status()
+}
+
+func (t *tdexMarketLoaderService) FetchDepth(
+	ctx context.Context,
+	market Market,
+	amounts []uint64,
+) ([]DepthLevel, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	if !t.breaker.available(market.Url) {
+		return nil, ErrProviderUnavailable
+	}
+	if err := t.servesTdex(market); err != nil {
+		return nil, err
+	}
+
+	cached := t.protocols.get(market.Url)
+
+	levels := make([]DepthLevel, 0, 2*len(amounts))
+	var lastErr error
+	for _, tradeType := range []TradeType{TradeTypeBuy, TradeTypeSell} {
+		for _, amount := range amounts {
+			price, err := decimal.Zero, errProtocolNotTried
+			if tryV2(cached) {
+				price, err = t.previewDepthLevelV2(ctx, market, tradeType, amount, cached)
+			}
+			if err != nil && tryV1(cached) {
+				price, err = t.previewDepthLevelV1(ctx, market, tradeType, amount, cached)
+			}
+			if err != nil {
+				lastErr = err
+				continue
+			}
+
+			levels = append(levels, DepthLevel{
+				TradeType: tradeType,
+				Amount:    amount,
+				Price:     price,
+			})
+		}
+	}
+
+	if len(levels) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return levels, nil
+}
+
+func (t *tdexMarketLoaderService) previewDepthLevelV2(
+	ctx context.Context,
+	market Market,
+	tradeType TradeType,
+	amount uint64,
+	cached string,
+) (decimal.Decimal, error) {
+	req := &tdexv2.PreviewTradeRequest{
+		Market: &tdexv2.Market{
+			BaseAsset:  market.BaseAsset,
+			QuoteAsset: market.QuoteAsset,
+		},
+		Type:     tdexv2.TradeType_TRADE_TYPE_BUY,
+		Amount:   amount,
+		Asset:    market.BaseAsset,
+		FeeAsset: market.QuoteAsset,
+	}
+	if tradeType == TradeTypeSell {
+		req.Type = tdexv2.TradeType_TRADE_TYPE_SELL
+	}
+	// Try HTTP/2 endpoint.
+	var reply *tdexv2.PreviewTradeResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv2.NewTradeServiceClient(conn).PreviewTrade(ctx, req)
+			return
+		},
+	)
+	if err != nil {
+		if !tryHttp1(cached) {
+			return decimal.Zero, err
+		}
+
+		requestData, _ := protojson.Marshal(req)
+		// Fallback to HTTP/1 endpoint.
+		r, err := t.http1Req(
+			ctx,
+			fmt.Sprintf(fetchMarketTradePreviewUrlRegexV2, market.Url),
+			"POST",
+			requestData,
+		)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		reply = &tdexv2.PreviewTradeResponse{}
+		if err := protojson.Unmarshal(r, reply); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	if len(reply.GetPreviews()) == 0 {
+		return decimal.Zero, fmt.Errorf("trade preview returned no previews")
+	}
+
+	preview := reply.GetPreviews()[0]
+	var feeAmount uint64
+	if preview.GetFeeAsset() == market.QuoteAsset {
+		feeAmount = preview.GetFeeAmount()
+	}
+
+	return effectivePrice(tradeType, amount, preview.GetAmount(), feeAmount)
+}
+
+func (t *tdexMarketLoaderService) previewDepthLevelV1(
+	ctx context.Context,
+	market Market,
+	tradeType TradeType,
+	amount uint64,
+	cached string,
+) (decimal.Decimal, error) {
+	req := &tdexv1.PreviewTradeRequest{
+		Market: &tdexv1.Market{
+			BaseAsset:  market.BaseAsset,
+			QuoteAsset: market.QuoteAsset,
+		},
+		Type:   tdexv1.TradeType_TRADE_TYPE_BUY,
+		Amount: amount,
+		Asset:  market.BaseAsset,
+	}
+	if tradeType == TradeTypeSell {
+		req.Type = tdexv1.TradeType_TRADE_TYPE_SELL
+	}
+	// Try HTTP/2 endpoint.
+	var reply *tdexv1.PreviewTradeResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv1.NewTradeServiceClient(conn).PreviewTrade(ctx, req)
+			return
+		},
+	)
+	if err != nil {
+		if !tryHttp1(cached) {
+			return decimal.Zero, err
+		}
+
+		requestData, _ := protojson.Marshal(req)
+		// Fallback to HTTP/1 endpoint.
+		r, err := t.http1Req(
+			ctx,
+			fmt.Sprintf(fetchMarketTradePreviewUrlRegexV1, market.Url),
+			"POST",
+			requestData,
+		)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		reply = &tdexv1.PreviewTradeResponse{}
+		if err := protojson.Unmarshal(r, reply); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	if len(reply.GetPreviews()) == 0 {
+		return decimal.Zero, fmt.Errorf("trade preview returned no previews")
+	}
+
+	return effectivePrice(tradeType, amount, reply.GetPreviews()[0].GetAmount(), 0)
+}
+
+// effectivePrice returns the amount of quote asset paid, when buying, or
+// received, when selling, per unit of base asset, quoteFeeAmount is added to
+// or subtracted from the previewed quoteAmount accordingly
+func effectivePrice(
+	tradeType TradeType,
+	baseAmount, quoteAmount, quoteFeeAmount uint64,
+) (decimal.Decimal, error) {
+	if baseAmount == 0 || quoteAmount == 0 {
+		return decimal.Zero, fmt.Errorf("trade preview returned zero amount")
+	}
+
+	quote := decimal.NewFromInt(int64(quoteAmount))
+	fee := decimal.NewFromInt(int64(quoteFeeAmount))
+	if tradeType == TradeTypeSell {
+		quote = quote.Sub(fee)
+	} else {
+		quote = quote.Add(fee)
+	}
+
+	return quote.Div(decimal.NewFromInt(int64(baseAmount))).Round(8), nil
 }
 
 func (t *tdexMarketLoaderService) previewPrice(
 	ctx context.Context,
-	client tdexv1.TradeServiceClient,
 	market Market,
+	cached string,
 ) (decimal.Decimal, decimal.Decimal, error) {
 	req := &tdexv1.PreviewTradeRequest{
 		Market: &tdexv1.Market{
@@ -126,13 +540,24 @@ func (t *tdexMarketLoaderService) previewPrice(
 		Asset:  market.BaseAsset,
 	}
 	// Try HTTP/2 endpoint.
-	reply, err := client.PreviewTrade(ctx, req)
+	var reply *tdexv1.PreviewTradeResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv1.NewTradeServiceClient(conn).PreviewTrade(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return decimal.Zero, decimal.Zero, err
+		}
+
 		requestData, _ := protojson.Marshal(req)
 		// Fallback to HTTP/1 endpoint.
-		r, err := http1Req(
+		r, err := t.http1Req(
+			ctx,
 			fmt.Sprintf(fetchMarketTradePreviewUrlRegexV1, market.Url),
-			t.torProxyUrl,
 			"POST",
 			requestData,
 		)
@@ -158,51 +583,82 @@ func (t *tdexMarketLoaderService) previewPrice(
 	return basePrice, quotePrice, nil
 }
 
-func (t *tdexMarketLoaderService) fetchLiquidityProviders() ([]LiquidityProvider, error) {
-	resp, err := http.Get(t.registryUrl)
-	if err != nil {
-		return nil, err
-	}
-	body, err := ioutil.ReadAll(resp.Body)
+func (t *tdexMarketLoaderService) fetchLiquidityProviderMarkets(
+	ctx context.Context,
+	liquidityProvider LiquidityProvider,
+) ([]Market, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	adapter, err := t.adapter(liquidityProvider)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %v, err: %v", resp.StatusCode, string(body))
-	}
-
-	var liquidityProviders []LiquidityProvider
-	if err = json.Unmarshal(body, &liquidityProviders); err != nil {
+	var markets []Market
+	if err := t.callProvider(ctx, liquidityProvider.Endpoint, func() (err error) {
+		markets, err = adapter.FetchMarkets(ctx, liquidityProvider)
+		return
+	}); err != nil {
 		return nil, err
 	}
 
-	return liquidityProviders, nil
+	return markets, nil
 }
 
-func (t *tdexMarketLoaderService) fetchLiquidityProviderMarkets(
+// fetchMarkets tries v2 and then v1 protocol, or only the cached one
+func (t *tdexMarketLoaderService) fetchMarkets(
 	ctx context.Context,
 	liquidityProvider LiquidityProvider,
-) ([]Market, error) {
-	markets, err := t.getMarketsV2(ctx, liquidityProvider)
-	if err == nil {
-		return markets, nil
+	cached string,
+) ([]Market, string, error) {
+	if !tryV2(cached) {
+		return t.getMarketsV1(ctx, liquidityProvider, cached)
+	}
+
+	markets, protocol, err := t.getMarketsV2(ctx, liquidityProvider, cached)
+	if err == nil || !tryV1(cached) {
+		return markets, protocol, err
 	}
 
-	return t.getMarketsV1(ctx, liquidityProvider)
+	return t.getMarketsV1(ctx, liquidityProvider, cached)
 }
 
+// getConn returns the pooled connection to endpoint and a func to release it
 func (t *tdexMarketLoaderService) getConn(endpoint string) (*grpc.ClientConn, func(), error) {
+	return t.connPool.get(endpoint)
+}
+
+// grpcCall runs call with the pooled connection to endpoint, unless gRPC is
+// skipped because HTTP/1 is cached for it
+func (t *tdexMarketLoaderService) grpcCall(
+	endpoint string,
+	cached string,
+	call func(conn *grpc.ClientConn) error,
+) error {
+	if !tryGrpc(cached) {
+		return errProtocolNotTried
+	}
+
+	conn, release, err := t.getConn(endpoint)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return call(conn)
+}
+
+func (t *tdexMarketLoaderService) dialConn(endpoint string) (*grpc.ClientConn, error) {
 	var conn *grpc.ClientConn
 
 	url := strings.ReplaceAll(endpoint, httpRegex, "")
 	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
-	if strings.Contains(endpoint, httpsRegex) { //if https skip TLS cert, it can be trusted
+	if strings.Contains(endpoint, httpsRegex) {
 		url = strings.ReplaceAll(endpoint, httpsRegex, "")
-		config := &tls.Config{
-			InsecureSkipVerify: true,
-		}
-		creds = grpc.WithTransportCredentials(credentials.NewTLS(config))
+		creds = grpc.WithTransportCredentials(
+			credentials.NewTLS(t.tlsConfig(endpoint)),
+		)
 	}
 
 	if strings.Contains(endpoint, onionUrlRegex) {
@@ -213,7 +669,7 @@ func (t *tdexMarketLoaderService) getConn(endpoint string) (*grpc.ClientConn, fu
 			creds,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		conn = c
@@ -224,49 +680,55 @@ func (t *tdexMarketLoaderService) getConn(endpoint string) (*grpc.ClientConn, fu
 			creds,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		conn = c
 	}
 
-	cleanup := func() { conn.Close() }
-
-	return conn, cleanup, nil
+	return conn, nil
 }
 
+// getMarketsV2 returns the markets of the provider and the protocol they
+// were fetched with
 func (t *tdexMarketLoaderService) getMarketsV2(
 	ctx context.Context,
 	liquidityProvider LiquidityProvider,
-) ([]Market, error) {
-	conn, close, err := t.getConn(liquidityProvider.Endpoint)
-	if err != nil {
-		return nil, err
-	}
-	defer close()
-
-	client := tdexv2.NewTradeServiceClient(conn)
+	cached string,
+) ([]Market, string, error) {
 	req := &tdexv2.ListMarketsRequest{}
 	// Try HTTP/2 endpoint.
-	reply, err := client.ListMarkets(ctx, req)
+	protocol := ProtocolV2
+	var reply *tdexv2.ListMarketsResponse
+	err := t.grpcCall(
+		liquidityProvider.Endpoint, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv2.NewTradeServiceClient(conn).ListMarkets(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return nil, "", err
+		}
+
 		requestData := []byte("{}")
 		// Fallback to HTTP/1 endpoint.
-		r, err := http1Req(fmt.Sprintf(
+		r, err := t.http1Req(ctx, fmt.Sprintf(
 			listMarketsUrlRegexV2,
 			liquidityProvider.Endpoint),
-			t.torProxyUrl,
 			"POST",
 			requestData,
 		)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		reply = &tdexv2.ListMarketsResponse{}
 		if err := protojson.Unmarshal(r, reply); err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		protocol = ProtocolV2Http1
 	}
 
 	resp := make([]Market, 0, len(reply.GetMarkets()))
@@ -274,45 +736,128 @@ func (t *tdexMarketLoaderService) getMarketsV2(
 		resp = append(resp, Market{
 			QuoteAsset: v.GetMarket().GetQuoteAsset(),
 			BaseAsset:  v.GetMarket().GetBaseAsset(),
+			Fee:        feeFromV2(v.GetFee()),
 		})
 	}
 
-	return resp, nil
+	return resp, protocol, nil
 }
 
-func (t *tdexMarketLoaderService) getMarketsV1(
+func (t *tdexMarketLoaderService) previewFeeV2(
 	ctx context.Context,
-	liquidityProvider LiquidityProvider,
-) ([]Market, error) {
-
-	conn, close, err := t.getConn(liquidityProvider.Endpoint)
+	market Market,
+	cached string,
+) (*Fee, error) {
+	req := &tdexv2.PreviewTradeRequest{
+		Market: &tdexv2.Market{
+			BaseAsset:  market.BaseAsset,
+			QuoteAsset: market.QuoteAsset,
+		},
+		Type:     tdexv2.TradeType_TRADE_TYPE_SELL,
+		Amount:   uint64(t.priceAmount),
+		Asset:    market.BaseAsset,
+		FeeAsset: market.QuoteAsset,
+	}
+	// Try HTTP/2 endpoint.
+	var reply *tdexv2.PreviewTradeResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv2.NewTradeServiceClient(conn).PreviewTrade(ctx, req)
+			return
+		},
+	)
 	if err != nil {
-		return nil, err
+		if !tryHttp1(cached) {
+			return nil, err
+		}
+
+		requestData, _ := protojson.Marshal(req)
+		// Fallback to HTTP/1 endpoint.
+		r, err := t.http1Req(
+			ctx,
+			fmt.Sprintf(fetchMarketTradePreviewUrlRegexV2, market.Url),
+			"POST",
+			requestData,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		reply = &tdexv2.PreviewTradeResponse{}
+		if err := protojson.Unmarshal(r, reply); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(reply.GetPreviews()) == 0 {
+		return nil, fmt.Errorf("trade preview returned no previews")
+	}
+
+	preview := reply.GetPreviews()[0]
+	fee := feeFromV2(preview.GetFee())
+	if fee == nil {
+		fee = &Fee{}
+	}
+	fee.PreviewFeeAmount = preview.GetFeeAmount()
+	fee.PreviewFeeAsset = preview.GetFeeAsset()
+
+	return fee, nil
+}
+
+func feeFromV2(fee *tdexv2.Fee) *Fee {
+	if fee == nil {
+		return nil
+	}
+
+	return &Fee{
+		BasePercentageFee:  fee.GetPercentageFee().GetBaseAsset(),
+		QuotePercentageFee: fee.GetPercentageFee().GetQuoteAsset(),
+		BaseFixedFee:       fee.GetFixedFee().GetBaseAsset(),
+		QuoteFixedFee:      fee.GetFixedFee().GetQuoteAsset(),
 	}
-	defer close()
+}
 
-	client := tdexv1.NewTradeServiceClient(conn)
+// getMarketsV1 returns the markets of the provider and the protocol they
+// were fetched with
+func (t *tdexMarketLoaderService) getMarketsV1(
+	ctx context.Context,
+	liquidityProvider LiquidityProvider,
+	cached string,
+) ([]Market, string, error) {
 	req := &tdexv1.ListMarketsRequest{}
 	// Try HTTP/2 endpoint.
-	reply, err := client.ListMarkets(ctx, req)
+	protocol := ProtocolV1
+	var reply *tdexv1.ListMarketsResponse
+	err := t.grpcCall(
+		liquidityProvider.Endpoint, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv1.NewTradeServiceClient(conn).ListMarkets(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return nil, "", err
+		}
+
 		requestData := []byte("{}")
 		// Fallback to HTTP/1 endpoint.
-		r, err := http1Req(fmt.Sprintf(
+		r, err := t.http1Req(ctx, fmt.Sprintf(
 			listMarketsUrlRegexV1,
 			liquidityProvider.Endpoint),
-			t.torProxyUrl,
 			"POST",
 			requestData,
 		)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		reply = &tdexv1.ListMarketsResponse{}
 		if err := protojson.Unmarshal(r, reply); err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		protocol = ProtocolV1Http1
 	}
 
 	resp := make([]Market, 0, len(reply.GetMarkets()))
@@ -323,20 +868,14 @@ func (t *tdexMarketLoaderService) getMarketsV1(
 		})
 	}
 
-	return resp, nil
+	return resp, protocol, nil
 }
 
 func (t *tdexMarketLoaderService) getBalanceV2(
 	ctx context.Context,
 	market Market,
+	cached string,
 ) (*Balance, error) {
-	conn, close, err := t.getConn(market.Url)
-	if err != nil {
-		return nil, err
-	}
-	defer close()
-
-	client := tdexv2.NewTradeServiceClient(conn)
 	req := &tdexv2.GetMarketBalanceRequest{
 		Market: &tdexv2.Market{
 			BaseAsset:  market.BaseAsset,
@@ -345,13 +884,26 @@ func (t *tdexMarketLoaderService) getBalanceV2(
 	}
 
 	// Try HTTP/2 endpoint.
-	reply, err := client.GetMarketBalance(ctx, req)
+	protocol := ProtocolV2
+	var reply *tdexv2.GetMarketBalanceResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv2.NewTradeServiceClient(conn).GetMarketBalance(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return nil, err
+		}
+
 		requestData, _ := protojson.Marshal(req)
 		// Fallback to HTTP/1 endpoint.
-		r, err := http1Req(
+		r, err := t.http1Req(
+			ctx,
 			fmt.Sprintf(fetchMarketBalanceUrlRegexV2, market.Url),
-			t.torProxyUrl, "POST",
+			"POST",
 			requestData,
 		)
 		if err != nil {
@@ -362,24 +914,20 @@ func (t *tdexMarketLoaderService) getBalanceV2(
 		if err := protojson.Unmarshal(r, reply); err != nil {
 			return nil, err
 		}
+		protocol = ProtocolV2Http1
 	}
 	return &Balance{
-		BaseBalance:  decimal.NewFromInt(int64(reply.GetBalance().GetBaseAmount())),
-		QuoteBalance: decimal.NewFromInt(int64(reply.GetBalance().GetQuoteAmount())),
+		BaseBalance:  satsToDecimal(reply.GetBalance().GetBaseAmount()),
+		QuoteBalance: satsToDecimal(reply.GetBalance().GetQuoteAmount()),
+		Protocol:     protocol,
 	}, nil
 }
 
 func (t *tdexMarketLoaderService) getBalanceV1(
 	ctx context.Context,
 	market Market,
+	cached string,
 ) (*Balance, error) {
-	conn, close, err := t.getConn(market.Url)
-	if err != nil {
-		return nil, err
-	}
-	defer close()
-
-	client := tdexv1.NewTradeServiceClient(conn)
 	req := &tdexv1.GetMarketBalanceRequest{
 		Market: &tdexv1.Market{
 			BaseAsset:  market.BaseAsset,
@@ -388,13 +936,26 @@ func (t *tdexMarketLoaderService) getBalanceV1(
 	}
 
 	// Try HTTP/2 endpoint.
-	reply, err := client.GetMarketBalance(ctx, req)
+	protocol := ProtocolV1
+	var reply *tdexv1.GetMarketBalanceResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv1.NewTradeServiceClient(conn).GetMarketBalance(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return nil, err
+		}
+
 		requestData, _ := protojson.Marshal(req)
 		// Fallback to HTTP/1 endpoint.
-		r, err := http1Req(
+		r, err := t.http1Req(
+			ctx,
 			fmt.Sprintf(fetchMarketBalanceUrlRegexV1, market.Url),
-			t.torProxyUrl, "POST",
+			"POST",
 			requestData,
 		)
 		if err != nil {
@@ -405,29 +966,31 @@ func (t *tdexMarketLoaderService) getBalanceV1(
 		if err := protojson.Unmarshal(r, reply); err != nil {
 			return nil, err
 		}
+		protocol = ProtocolV1Http1
 	}
 	return &Balance{
-		BaseBalance:  decimal.NewFromInt(int64(reply.GetBalance().GetBalance().GetBaseAmount())),
-		QuoteBalance: decimal.NewFromInt(int64(reply.GetBalance().GetBalance().GetQuoteAmount())),
+		BaseBalance:  satsToDecimal(reply.GetBalance().GetBalance().GetBaseAmount()),
+		QuoteBalance: satsToDecimal(reply.GetBalance().GetBalance().GetQuoteAmount()),
+		Protocol:     protocol,
 	}, nil
 }
 
+// satsToDecimal converts an amount of satoshis to decimal without the loss
+// of precision of the int64 conversion
+func satsToDecimal(amount uint64) decimal.Decimal {
+	return decimal.NewFromBigInt(new(big.Int).SetUint64(amount), 0)
+}
+
 func (t *tdexMarketLoaderService) getPriceV2(
 	ctx context.Context,
 	market Market,
+	cached string,
 ) (*Price, error) {
 	var (
 		basePrice  decimal.Decimal
 		quotePrice decimal.Decimal
 	)
 
-	conn, close, err := t.getConn(market.Url)
-	if err != nil {
-		return nil, err
-	}
-	defer close()
-
-	client := tdexv2.NewTradeServiceClient(conn)
 	req := &tdexv2.GetMarketPriceRequest{
 		Market: &tdexv2.Market{
 			BaseAsset:  market.BaseAsset,
@@ -435,13 +998,25 @@ func (t *tdexMarketLoaderService) getPriceV2(
 		},
 	}
 	// Try HTTP/2 market price endpoint.
-	reply, err := client.GetMarketPrice(ctx, req)
+	protocol := ProtocolV2
+	var reply *tdexv2.GetMarketPriceResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv2.NewTradeServiceClient(conn).GetMarketPrice(ctx, req)
+			return
+		},
+	)
 	if err != nil {
+		if !tryHttp1(cached) {
+			return nil, err
+		}
+
 		requestData, _ := protojson.Marshal(req)
 		// Fallback to HTTP/1 market price endpoint.
-		r, err := http1Req(
+		r, err := t.http1Req(
+			ctx,
 			fmt.Sprintf(fetchMarketPriceUrlRegexV2, market.Url),
-			t.torProxyUrl,
 			"POST",
 			requestData,
 		)
@@ -454,6 +1029,7 @@ func (t *tdexMarketLoaderService) getPriceV2(
 		if err := protojson.Unmarshal(r, reply); err != nil {
 			return nil, err
 		}
+		protocol = ProtocolV2Http1
 	}
 	quotePrice = decimal.NewFromFloat(reply.GetSpotPrice())
 	basePrice = decimal.NewFromFloat(1).Div(quotePrice)
@@ -461,25 +1037,20 @@ func (t *tdexMarketLoaderService) getPriceV2(
 	return &Price{
 		BasePrice:  basePrice,
 		QuotePrice: quotePrice,
+		Protocol:   protocol,
 	}, nil
 }
 
 func (t *tdexMarketLoaderService) getPriceV1(
 	ctx context.Context,
 	market Market,
+	cached string,
 ) (*Price, error) {
 	var (
 		basePrice  decimal.Decimal
 		quotePrice decimal.Decimal
 	)
 
-	conn, close, err := t.getConn(market.Url)
-	if err != nil {
-		return nil, err
-	}
-	defer close()
-
-	client := tdexv1.NewTradeServiceClient(conn)
 	req := &tdexv1.GetMarketPriceRequest{
 		Market: &tdexv1.Market{
 			BaseAsset:  market.BaseAsset,
@@ -487,19 +1058,31 @@ func (t *tdexMarketLoaderService) getPriceV1(
 		},
 	}
 	// Try HTTP/2 market price endpoint.
-	reply, err := client.GetMarketPrice(ctx, req)
+	protocol := ProtocolV1
+	var reply *tdexv1.GetMarketPriceResponse
+	err := t.grpcCall(
+		market.Url, cached,
+		func(conn *grpc.ClientConn) (err error) {
+			reply, err = tdexv1.NewTradeServiceClient(conn).GetMarketPrice(ctx, req)
+			return
+		},
+	)
 	if err != nil {
-		requestData, _ := protojson.Marshal(req)
 		// Fallback to HTTP/1 market price endpoint.
-		r, err := http1Req(
-			fmt.Sprintf(fetchMarketPriceUrlRegexV1, market.Url),
-			t.torProxyUrl,
-			"POST",
-			requestData,
-		)
+		var r []byte
+		err = errProtocolNotTried
+		if tryHttp1(cached) {
+			requestData, _ := protojson.Marshal(req)
+			r, err = t.http1Req(
+				ctx,
+				fmt.Sprintf(fetchMarketPriceUrlRegexV1, market.Url),
+				"POST",
+				requestData,
+			)
+		}
 		if err != nil {
 			// Fallback to trade preview endpoint.
-			bp, qp, err := t.previewPrice(ctx, client, market)
+			bp, qp, err := t.previewPrice(ctx, market, cached)
 			if err != nil {
 				return nil, err
 			}
@@ -507,6 +1090,7 @@ func (t *tdexMarketLoaderService) getPriceV1(
 			return &Price{
 				BasePrice:  bp,
 				QuotePrice: qp,
+				Protocol:   ProtocolV1Preview,
 			}, nil
 		}
 
@@ -514,6 +1098,7 @@ func (t *tdexMarketLoaderService) getPriceV1(
 		if err := protojson.Unmarshal(r, reply); err != nil {
 			return nil, err
 		}
+		protocol = ProtocolV1Http1
 	}
 	quotePrice = decimal.NewFromFloat(reply.GetSpotPrice())
 	basePrice = decimal.NewFromFloat(1).Div(quotePrice)
@@ -521,6 +1106,7 @@ func (t *tdexMarketLoaderService) getPriceV1(
 	return &Price{
 		BasePrice:  basePrice,
 		QuotePrice: quotePrice,
+		Protocol:   protocol,
 	}, nil
 }
 
@@ -576,26 +1162,33 @@ func getGrpcConnectionWithTorClient(
 	}
 }
 
-func http1Req(url string, socksUrl string, method string, payload []byte) ([]byte, error) {
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(payload))
+// httpStatusError is returned for http/1 calls answered with a status other
+// than 200
+type httpStatusError struct {
+	code int
+	body string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d, error: %v", e.code, e.body)
+}
+
+func (t *tdexMarketLoaderService) http1Req(
+	ctx context.Context,
+	url string,
+	method string,
+	payload []byte,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(payload))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	httpTransport := &http.Transport{}
-	if strings.Contains(url, onionUrlRegex) {
-		socksDialer, err := proxy.SOCKS5("tcp", socksUrl, nil, proxy.Direct)
-		if err != nil {
-			return nil, err
-		}
-
-		httpTransport = &http.Transport{
-			DialContext: socksDialer.(proxy.ContextDialer).DialContext,
-		}
+	client, err := t.httpClients.get(url)
+	if err != nil {
+		return nil, err
 	}
-
-	client := &http.Client{Transport: httpTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -608,12 +1201,29 @@ func http1Req(url string, socksUrl string, method string, payload []byte) ([]byt
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf(
-			"unexpected status code: %d, error: %v",
-			resp.StatusCode,
-			string(body),
-		)
+		return nil, &httpStatusError{code: resp.StatusCode, body: string(body)}
 	}
 
 	return body, nil
 }
+
+// newHttpTransport returns the transport of the http/1 calls to the host of
+// rawUrl, verifying its pins if any and going through tor for onion ones
+func (t *tdexMarketLoaderService) newHttpTransport(
+	rawUrl string,
+) (*http.Transport, error) {
+	httpTransport := &http.Transport{}
+	if strings.Contains(rawUrl, httpsRegex) {
+		httpTransport.TLSClientConfig = t.tlsConfig(rawUrl)
+	}
+	if strings.Contains(rawUrl, onionUrlRegex) {
+		socksDialer, err := proxy.SOCKS5("tcp", t.torProxyUrl, nil, proxy.Direct)
+		if err != nil {
+			return nil, err
+		}
+
+		httpTransport.DialContext = socksDialer.(proxy.ContextDialer).DialContext
+	}
+
+	return httpTransport, nil
+}
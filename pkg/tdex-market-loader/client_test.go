@@ -3,15 +3,24 @@ package tdexmarketloader
 import (
 	"context"
 	"github.com/stretchr/testify/assert"
+	tdexv2 "github.com/tdex-network/tdex-analytics/api-spec/protobuf/gen/tdex/v2"
 	"testing"
+	"time"
 )
 
 func TestGetProvidersAndMarkets(t *testing.T) {
-	tdexMarketLoaderSvc := NewService(
-		"127.0.0.1:9050",
-		"https://raw.githubusercontent.com/tdex-network/tdex-registry/master/registry.json",
-		1000,
-	)
+	tdexMarketLoaderSvc := NewService(Config{
+		TorProxyUrl: "127.0.0.1:9050",
+		Registries: []RegistrySource{NewHttpRegistrySource(
+			"https://raw.githubusercontent.com/tdex-network/tdex-registry/master/registry.json",
+			nil,
+		)},
+		PriceAmount:      1000,
+		ConnIdleTimeout:  time.Minute,
+		RequestTimeout:   30 * time.Second,
+		BreakerThreshold: 5,
+		BreakerCooldown:  time.Minute,
+	})
 	liquidityProviders, err := tdexMarketLoaderSvc.FetchProvidersMarkets(context.Background())
 	if err != nil {
 		t.Fatal(err)
@@ -19,3 +28,37 @@ func TestGetProvidersAndMarkets(t *testing.T) {
 
 	assert.Equal(t, true, len(liquidityProviders) > 0)
 }
+
+func TestFeeFromV2(t *testing.T) {
+	assert.Nil(t, feeFromV2(nil))
+
+	fee := feeFromV2(&tdexv2.Fee{
+		PercentageFee: &tdexv2.MarketFee{BaseAsset: 25, QuoteAsset: 30},
+		FixedFee:      &tdexv2.MarketFee{BaseAsset: 100, QuoteAsset: 200},
+	})
+	assert.Equal(t, &Fee{
+		BasePercentageFee:  25,
+		QuotePercentageFee: 30,
+		BaseFixedFee:       100,
+		QuoteFixedFee:      200,
+	}, fee)
+}
+
+func TestEffectivePrice(t *testing.T) {
+	price, err := effectivePrice(TradeTypeBuy, 100000, 3000000, 7500)
+	assert.NoError(t, err)
+	assert.Equal(t, "30.075", price.String())
+
+	price, err = effectivePrice(TradeTypeSell, 100000, 3000000, 7500)
+	assert.NoError(t, err)
+	assert.Equal(t, "29.925", price.String())
+
+	_, err = effectivePrice(TradeTypeSell, 100000, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestSatsToDecimal(t *testing.T) {
+	assert.Equal(t, "0", satsToDecimal(0).String())
+	assert.Equal(t, "9007199254740993", satsToDecimal(9007199254740993).String())
+	assert.Equal(t, "18446744073709551615", satsToDecimal(18446744073709551615).String())
+}
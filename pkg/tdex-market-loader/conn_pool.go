@@ -0,0 +1,142 @@
+package tdexmarketloader
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	// minEvictInterval bounds how often idle connections are looked for, the
+	//ticker would panic for idle timeouts below 2ns
+	minEvictInterval = time.Second
+)
+
+// connPool shares one gRPC connection per provider endpoint among concurrent
+// calls, connections not used for idleTimeout are closed
+type connPool struct {
+	lock        sync.Mutex
+	conns       map[string]*pooledConn
+	idleTimeout time.Duration
+	dial        func(endpoint string) (*grpc.ClientConn, error)
+}
+
+type pooledConn struct {
+	conn *grpc.ClientConn
+	// refs is the number of calls using the connection
+	refs     int
+	lastUsed time.Time
+	// dropped is set once removed from the pool while in use, the
+	//connection is closed by the last release
+	dropped bool
+}
+
+func newConnPool(
+	idleTimeout time.Duration,
+	dial func(endpoint string) (*grpc.ClientConn, error),
+) *connPool {
+	p := &connPool{
+		conns:       make(map[string]*pooledConn),
+		idleTimeout: idleTimeout,
+		dial:        dial,
+	}
+
+	go p.evictIdleConns()
+
+	return p
+}
+
+// get returns the connection to endpoint, dialing it if not pooled yet, and a
+// func to release it once the call is done
+func (p *connPool) get(endpoint string) (*grpc.ClientConn, func(), error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pc, ok := p.conns[endpoint]
+	if ok && pc.conn.GetState() == connectivity.Shutdown {
+		delete(p.conns, endpoint)
+		ok = false
+	}
+	if !ok {
+		conn, err := p.dial(endpoint)
+		if err != nil {
+			return nil, nil, err
+		}
+		pc = &pooledConn{conn: conn}
+		p.conns[endpoint] = pc
+	}
+
+	pc.refs++
+	pc.lastUsed = time.Now()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+
+			pc.refs--
+			pc.lastUsed = time.Now()
+			if pc.dropped && pc.refs == 0 {
+				pc.conn.Close()
+			}
+		})
+	}
+
+	return pc.conn, release, nil
+}
+
+// drop removes the connection to endpoint from the pool so that the next call
+// dials a new one, it is closed as soon as it is not in use
+func (p *connPool) drop(endpoint string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pc, ok := p.conns[endpoint]
+	if !ok {
+		return
+	}
+	delete(p.conns, endpoint)
+
+	if pc.refs > 0 {
+		pc.dropped = true
+		return
+	}
+	pc.conn.Close()
+}
+
+func (p *connPool) evictIdleConns() {
+	ticker := time.NewTicker(evictInterval(p.idleTimeout))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		p.evict(time.Now())
+	}
+}
+
+// evictInterval returns the interval idle connections are looked for at, half
+// of idleTimeout but not less than minEvictInterval
+func evictInterval(idleTimeout time.Duration) time.Duration {
+	if interval := idleTimeout / 2; interval > minEvictInterval {
+		return interval
+	}
+	return minEvictInterval
+}
+
+// evict closes the connections not in use and idle since before
+// now - idleTimeout
+func (p *connPool) evict(now time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for k, v := range p.conns {
+		if v.refs > 0 || now.Sub(v.lastUsed) < p.idleTimeout {
+			continue
+		}
+
+		v.conn.Close()
+		delete(p.conns, k)
+	}
+}
@@ -0,0 +1,74 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestConnPool(t *testing.T) {
+	dials := 0
+	pool := &connPool{
+		conns:       make(map[string]*pooledConn),
+		idleTimeout: time.Minute,
+		dial: func(endpoint string) (*grpc.ClientConn, error) {
+			dials++
+			return grpc.DialContext(
+				context.Background(),
+				endpoint,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+		},
+	}
+
+	conn, release, err := pool.get("127.0.0.1:1")
+	assert.NoError(t, err)
+	other, releaseOther, err := pool.get("127.0.0.1:1")
+	assert.NoError(t, err)
+	assert.Same(t, conn, other)
+	assert.Equal(t, 1, dials)
+
+	// connections in use are never evicted
+	releaseOther()
+	releaseOther()
+	pool.evict(time.Now().Add(2 * time.Minute))
+	assert.Len(t, pool.conns, 1)
+
+	release()
+	pool.evict(time.Now())
+	assert.Len(t, pool.conns, 1)
+
+	pool.evict(time.Now().Add(2 * time.Minute))
+	assert.Empty(t, pool.conns)
+	assert.Equal(t, connectivity.Shutdown, conn.GetState())
+
+	// closed connections are dialed again
+	conn, release, err = pool.get("127.0.0.1:1")
+	assert.NoError(t, err)
+	defer release()
+	conn.Close()
+	other, releaseOther, err = pool.get("127.0.0.1:1")
+	assert.NoError(t, err)
+	defer releaseOther()
+	assert.NotSame(t, conn, other)
+	assert.Equal(t, 3, dials)
+
+	// dropped connections are closed once released
+	pool.drop("127.0.0.1:1")
+	assert.Empty(t, pool.conns)
+	assert.NotEqual(t, connectivity.Shutdown, other.GetState())
+	releaseOther()
+	assert.Equal(t, connectivity.Shutdown, other.GetState())
+}
+
+func TestEvictInterval(t *testing.T) {
+	assert.Equal(t, minEvictInterval, evictInterval(0))
+	assert.Equal(t, minEvictInterval, evictInterval(time.Nanosecond))
+	assert.Equal(t, minEvictInterval, evictInterval(2*time.Second))
+	assert.Equal(t, time.Minute, evictInterval(2*time.Minute))
+}
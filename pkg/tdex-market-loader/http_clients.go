@@ -0,0 +1,67 @@
+package tdexmarketloader
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// httpClients shares one http client per provider host among the http/1
+// calls so that keep-alive connections are reused, idle ones are closed
+// after idleTimeout
+type httpClients struct {
+	lock        sync.Mutex
+	clients     map[string]*http.Client
+	idleTimeout time.Duration
+	// newTransport returns the transport of the calls to the host of rawUrl
+	newTransport func(rawUrl string) (*http.Transport, error)
+}
+
+func newHttpClients(
+	idleTimeout time.Duration,
+	newTransport func(rawUrl string) (*http.Transport, error),
+) *httpClients {
+	return &httpClients{
+		clients:      make(map[string]*http.Client),
+		idleTimeout:  idleTimeout,
+		newTransport: newTransport,
+	}
+}
+
+// get returns the client of the host of rawUrl, creating it if not shared yet
+func (c *httpClients) get(rawUrl string) (*http.Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	host := endpointHost(rawUrl)
+	if client, ok := c.clients[host]; ok {
+		return client, nil
+	}
+
+	transport, err := c.newTransport(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	transport.IdleConnTimeout = c.idleTimeout
+
+	client := &http.Client{Transport: transport}
+	c.clients[host] = client
+
+	return client, nil
+}
+
+// drop removes the client of the host of endpoint so that the next call
+// creates a new one, its idle connections are closed
+func (c *httpClients) drop(endpoint string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	host := endpointHost(endpoint)
+	client, ok := c.clients[host]
+	if !ok {
+		return
+	}
+	delete(c.clients, host)
+
+	client.CloseIdleConnections()
+}
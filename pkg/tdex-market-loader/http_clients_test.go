@@ -0,0 +1,37 @@
+package tdexmarketloader
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpClients(t *testing.T) {
+	transports := 0
+	clients := newHttpClients(time.Minute, func(string) (*http.Transport, error) {
+		transports++
+		return &http.Transport{}, nil
+	})
+
+	client, err := clients.get("https://provider.com/v2/markets")
+	require.NoError(t, err)
+	other, err := clients.get("https://provider.com/v2/price")
+	require.NoError(t, err)
+	assert.Same(t, client, other)
+	assert.Equal(t, 1, transports)
+	assert.Equal(t, time.Minute, client.Transport.(*http.Transport).IdleConnTimeout)
+
+	other, err = clients.get("https://other.com/v2/markets")
+	require.NoError(t, err)
+	assert.NotSame(t, client, other)
+
+	// dropped clients are created again
+	clients.drop("https://provider.com")
+	other, err = clients.get("https://provider.com/v2/markets")
+	require.NoError(t, err)
+	assert.NotSame(t, client, other)
+	assert.Equal(t, 3, transports)
+}
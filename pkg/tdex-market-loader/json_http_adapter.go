@@ -0,0 +1,124 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ProtocolJsonHttp is the protocol path of the json-http adapter
+	ProtocolJsonHttp = "json/http1"
+
+	jsonHttpMarketsUrlRegex = "%s/markets"
+	jsonHttpBalanceUrlRegex = "%s/markets/%s/%s/balance"
+	jsonHttpPriceUrlRegex   = "%s/markets/%s/%s/price"
+)
+
+type jsonHttpMarket struct {
+	BaseAsset  string `json:"base_asset"`
+	QuoteAsset string `json:"quote_asset"`
+}
+
+type jsonHttpBalance struct {
+	BaseAmount  uint64 `json:"base_amount"`
+	QuoteAmount uint64 `json:"quote_amount"`
+}
+
+type jsonHttpPrice struct {
+	BasePrice  decimal.Decimal `json:"base_price"`
+	QuotePrice decimal.Decimal `json:"quote_price"`
+}
+
+// jsonHttpAdapter tracks venues not speaking the tdex protocol, serving, or
+// proxied by a service serving, plain json over http:
+// GET <endpoint>/markets -> [{"base_asset": "<hash>", "quote_asset": "<hash>"}]
+// GET <endpoint>/markets/<base_asset>/<quote_asset>/balance ->
+// {"base_amount": <sats>, "quote_amount": <sats>}
+// GET <endpoint>/markets/<base_asset>/<quote_asset>/price ->
+// {"base_price": "<decimal>", "quote_price": "<decimal>"}, prices have the
+// same meaning of the tdex protocol ones
+type jsonHttpAdapter struct {
+	t *tdexMarketLoaderService
+}
+
+func (a *jsonHttpAdapter) FetchMarkets(
+	ctx context.Context,
+	liquidityProvider LiquidityProvider,
+) ([]Market, error) {
+	endpoint := strings.TrimRight(liquidityProvider.Endpoint, "/")
+
+	var reply []jsonHttpMarket
+	if err := a.get(ctx, fmt.Sprintf(jsonHttpMarketsUrlRegex, endpoint), &reply); err != nil {
+		return nil, err
+	}
+
+	markets := make([]Market, 0, len(reply))
+	for _, v := range reply {
+		if v.BaseAsset == "" || v.QuoteAsset == "" {
+			continue
+		}
+		markets = append(markets, Market{
+			Url:        liquidityProvider.Endpoint,
+			BaseAsset:  v.BaseAsset,
+			QuoteAsset: v.QuoteAsset,
+		})
+	}
+
+	return markets, nil
+}
+
+func (a *jsonHttpAdapter) FetchBalance(
+	ctx context.Context,
+	market Market,
+) (*Balance, error) {
+	reqUrl := fmt.Sprintf(
+		jsonHttpBalanceUrlRegex, strings.TrimRight(market.Url, "/"),
+		url.PathEscape(market.BaseAsset), url.PathEscape(market.QuoteAsset),
+	)
+
+	var reply jsonHttpBalance
+	if err := a.get(ctx, reqUrl, &reply); err != nil {
+		return nil, err
+	}
+
+	return &Balance{
+		BaseBalance:  satsToDecimal(reply.BaseAmount),
+		QuoteBalance: satsToDecimal(reply.QuoteAmount),
+		Protocol:     ProtocolJsonHttp,
+	}, nil
+}
+
+func (a *jsonHttpAdapter) FetchPrice(
+	ctx context.Context,
+	market Market,
+) (*Price, error) {
+	reqUrl := fmt.Sprintf(
+		jsonHttpPriceUrlRegex, strings.TrimRight(market.Url, "/"),
+		url.PathEscape(market.BaseAsset), url.PathEscape(market.QuoteAsset),
+	)
+
+	var reply jsonHttpPrice
+	if err := a.get(ctx, reqUrl, &reply); err != nil {
+		return nil, err
+	}
+
+	return &Price{
+		BasePrice:  reply.BasePrice,
+		QuotePrice: reply.QuotePrice,
+		Protocol:   ProtocolJsonHttp,
+	}, nil
+}
+
+func (a *jsonHttpAdapter) get(ctx context.Context, reqUrl string, reply interface{}) error {
+	body, err := a.t.http1Req(ctx, reqUrl, "GET", nil)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, reply)
+}
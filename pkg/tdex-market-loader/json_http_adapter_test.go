@@ -0,0 +1,97 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonHttpAdapter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+
+			switch r.URL.EscapedPath() {
+			case "/api/markets":
+				w.Write([]byte(`[
+					{"base_asset": "lbtc", "quote_asset": "usdt"},
+					{"base_asset": "", "quote_asset": "usdt"}
+				]`))
+			case "/api/markets/lbtc/usdt/balance":
+				w.Write([]byte(`{"base_amount": 100000000, "quote_amount": 2000000000000}`))
+			case "/api/markets/lbtc/usdt/price":
+				w.Write([]byte(`{"base_price": "0.00005", "quote_price": 20000}`))
+			case "/api/markets/l%2Fbtc%3F/usdt/price":
+				w.Write([]byte(`{"base_price": "1", "quote_price": 1}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+
+	endpoint := server.URL + "/api"
+	svc := NewService(Config{
+		Registries: []RegistrySource{NewStaticRegistrySource([]LiquidityProvider{
+			{Name: "venue", Endpoint: endpoint, Adapter: AdapterJsonHttp},
+			{Name: "unknown", Endpoint: "https://unknown.com", Adapter: "unknown"},
+		})},
+		PriceAmount:      1000,
+		ConnIdleTimeout:  time.Minute,
+		RequestTimeout:   10 * time.Second,
+		BreakerThreshold: 5,
+		BreakerCooldown:  time.Minute,
+	})
+	ctx := context.Background()
+	market := Market{Url: endpoint, BaseAsset: "lbtc", QuoteAsset: "usdt"}
+
+	// the adapter of the provider is known without fetching its markets
+	balance, err := svc.FetchBalance(ctx, market)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(100000000).Equal(balance.BaseBalance))
+	assert.True(t, decimal.NewFromInt(2000000000000).Equal(balance.QuoteBalance))
+	assert.Equal(t, ProtocolJsonHttp, balance.Protocol)
+
+	price, err := svc.FetchPrice(ctx, market)
+	require.NoError(t, err)
+	assert.True(t, decimal.RequireFromString("0.00005").Equal(price.BasePrice))
+	assert.True(t, decimal.NewFromInt(20000).Equal(price.QuotePrice))
+
+	// assets are escaped in the request path
+	escaped := Market{Url: endpoint, BaseAsset: "l/btc?", QuoteAsset: "usdt"}
+	escapedPrice, err := svc.FetchPrice(ctx, escaped)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(1).Equal(escapedPrice.BasePrice))
+
+	snapshot, err := svc.FetchSnapshot(ctx, market)
+	require.NoError(t, err)
+	assert.Equal(t, *price, snapshot.Price)
+	assert.Equal(t, *balance, snapshot.Balance)
+
+	// providers with unknown adapter are skipped
+	providers, err := svc.FetchProvidersMarkets(ctx)
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	assert.Equal(t, AdapterJsonHttp, providers[0].Adapter)
+	assert.Equal(t, []Market{market}, providers[0].Markets)
+
+	_, err = svc.FetchFee(ctx, market)
+	assert.ErrorIs(t, err, ErrNotSupportedByAdapter)
+	_, err = svc.FetchDepth(ctx, market, []uint64{1000})
+	assert.ErrorIs(t, err, ErrNotSupportedByAdapter)
+
+	// malformed endpoints fail the request
+	_, err = svc.(*tdexMarketLoaderService).http1Req(
+		ctx, "http://venue\x7f/markets", "GET", nil,
+	)
+	assert.Error(t, err)
+}
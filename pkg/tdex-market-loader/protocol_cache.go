@@ -0,0 +1,95 @@
+package tdexmarketloader
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// protocolCacheTTL is how long the protocol of a provider is trusted
+	//before being negotiated again, so that upgrades are picked up
+	protocolCacheTTL = time.Hour
+)
+
+// protocolCache remembers the protocol each provider last answered with, so
+// that calls go straight to it instead of trying v2, v1 and HTTP/1 in turn
+type protocolCache struct {
+	lock      sync.RWMutex
+	protocols map[string]cachedProtocol
+}
+
+type cachedProtocol struct {
+	protocol  string
+	expiresAt time.Time
+}
+
+func newProtocolCache() *protocolCache {
+	return &protocolCache{
+		protocols: make(map[string]cachedProtocol),
+	}
+}
+
+// get returns the protocol of endpoint, empty if it has to be negotiated
+func (p *protocolCache) get(endpoint string) string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	v, ok := p.protocols[endpoint]
+	if !ok || time.Now().After(v.expiresAt) {
+		return ""
+	}
+
+	return v.protocol
+}
+
+// set caches the protocol endpoint answered with, the expiry is not extended
+// if it is unchanged, the v1 trade preview fallback tells nothing about the
+// transport so it is not cached
+func (p *protocolCache) set(endpoint, protocol string) {
+	if protocol == "" || protocol == ProtocolV1Preview {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if v, ok := p.protocols[endpoint]; ok && v.protocol == protocol &&
+		time.Now().Before(v.expiresAt) {
+		return
+	}
+
+	p.protocols[endpoint] = cachedProtocol{
+		protocol:  protocol,
+		expiresAt: time.Now().Add(protocolCacheTTL),
+	}
+}
+
+// forget drops the protocol of endpoint and returns true if one was cached
+func (p *protocolCache) forget(endpoint string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	v, ok := p.protocols[endpoint]
+	delete(p.protocols, endpoint)
+
+	return ok && time.Now().Before(v.expiresAt)
+}
+
+// the helpers below tell if a protocol path has to be tried given the cached
+// protocol, every path is tried if it is empty
+
+func tryV2(cached string) bool {
+	return cached == "" || cached == ProtocolV2 || cached == ProtocolV2Http1
+}
+
+func tryV1(cached string) bool {
+	return cached == "" || cached == ProtocolV1 || cached == ProtocolV1Http1
+}
+
+func tryGrpc(cached string) bool {
+	return cached == "" || cached == ProtocolV2 || cached == ProtocolV1
+}
+
+func tryHttp1(cached string) bool {
+	return cached == "" || cached == ProtocolV2Http1 || cached == ProtocolV1Http1
+}
@@ -0,0 +1,42 @@
+package tdexmarketloader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolCache(t *testing.T) {
+	cache := newProtocolCache()
+	assert.Equal(t, "", cache.get("provider"))
+	assert.False(t, cache.forget("provider"))
+
+	cache.set("provider", ProtocolV1Preview)
+	assert.Equal(t, "", cache.get("provider"))
+
+	cache.set("provider", ProtocolV1Http1)
+	assert.Equal(t, ProtocolV1Http1, cache.get("provider"))
+	assert.False(t, tryV2(cache.get("provider")))
+	assert.False(t, tryGrpc(cache.get("provider")))
+	assert.True(t, tryHttp1(cache.get("provider")))
+
+	// an expired protocol is negotiated again
+	cache.protocols["provider"] = cachedProtocol{
+		protocol:  ProtocolV2,
+		expiresAt: time.Now().Add(-time.Second),
+	}
+	assert.Equal(t, "", cache.get("provider"))
+	assert.False(t, cache.forget("provider"))
+
+	cache.set("provider", ProtocolV2)
+	assert.True(t, cache.forget("provider"))
+	assert.Equal(t, "", cache.get("provider"))
+
+	for _, v := range []string{
+		ProtocolV2, ProtocolV2Http1, ProtocolV1, ProtocolV1Http1, "",
+	} {
+		assert.True(t, tryV2(v) || tryV1(v))
+		assert.True(t, tryGrpc(v) || tryHttp1(v))
+	}
+}
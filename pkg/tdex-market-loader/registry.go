@@ -0,0 +1,243 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RegistrySignatureSuffix is appended to the url or path of a registry to
+	//get its detached signature, the hex encoded ed25519 signature of the
+	//registry json
+	RegistrySignatureSuffix = ".sig"
+)
+
+var (
+	// ErrInvalidRegistrySignature is returned for registries whose signature
+	//does not match the configured public key
+	ErrInvalidRegistrySignature = errors.New("invalid registry signature")
+)
+
+// RegistrySource lists the liquidity providers whose markets are tracked
+type RegistrySource interface {
+	// Name identifies the source in logs
+	Name() string
+	FetchLiquidityProviders(ctx context.Context) ([]LiquidityProvider, error)
+}
+
+// NewRegistrySource returns a source reading the registry from source, either
+// an http(s) url or the path of a local file, if pubKey is not nil the
+// registry must be signed with the matching private key
+func NewRegistrySource(source string, pubKey ed25519.PublicKey) RegistrySource {
+	if strings.HasPrefix(source, httpRegex) || strings.HasPrefix(source, httpsRegex) {
+		return NewHttpRegistrySource(source, pubKey)
+	}
+	return NewFileRegistrySource(source, pubKey)
+}
+
+type httpRegistrySource struct {
+	url    string
+	pubKey ed25519.PublicKey
+}
+
+// NewHttpRegistrySource returns a source downloading the registry from url,
+// its signature is downloaded from url + RegistrySignatureSuffix
+func NewHttpRegistrySource(url string, pubKey ed25519.PublicKey) RegistrySource {
+	return &httpRegistrySource{
+		url:    url,
+		pubKey: pubKey,
+	}
+}
+
+func (h *httpRegistrySource) Name() string {
+	return h.url
+}
+
+func (h *httpRegistrySource) FetchLiquidityProviders(
+	ctx context.Context,
+) ([]LiquidityProvider, error) {
+	body, err := httpGet(ctx, h.url)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	if h.pubKey != nil {
+		signature, err = httpGet(ctx, h.url+RegistrySignatureSuffix)
+		if err != nil {
+			return nil, fmt.Errorf("fetch registry signature: %w", err)
+		}
+	}
+
+	return parseRegistry(body, signature, h.pubKey)
+}
+
+type fileRegistrySource struct {
+	path   string
+	pubKey ed25519.PublicKey
+}
+
+// NewFileRegistrySource returns a source reading the registry from the local
+// file at path, its signature is read from path + RegistrySignatureSuffix
+func NewFileRegistrySource(path string, pubKey ed25519.PublicKey) RegistrySource {
+	return &fileRegistrySource{
+		path:   path,
+		pubKey: pubKey,
+	}
+}
+
+func (f *fileRegistrySource) Name() string {
+	return f.path
+}
+
+func (f *fileRegistrySource) FetchLiquidityProviders(
+	_ context.Context,
+) ([]LiquidityProvider, error) {
+	body, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	if f.pubKey != nil {
+		signature, err = ioutil.ReadFile(f.path + RegistrySignatureSuffix)
+		if err != nil {
+			return nil, fmt.Errorf("read registry signature: %w", err)
+		}
+	}
+
+	return parseRegistry(body, signature, f.pubKey)
+}
+
+type staticRegistrySource struct {
+	liquidityProviders []LiquidityProvider
+}
+
+// NewStaticRegistrySource returns a source listing the given providers, ie.
+// configured by the operator, so they are not signed
+func NewStaticRegistrySource(
+	liquidityProviders []LiquidityProvider,
+) RegistrySource {
+	return &staticRegistrySource{
+		liquidityProviders: liquidityProviders,
+	}
+}
+
+func (s *staticRegistrySource) Name() string {
+	return "static"
+}
+
+func (s *staticRegistrySource) FetchLiquidityProviders(
+	_ context.Context,
+) ([]LiquidityProvider, error) {
+	res := make([]LiquidityProvider, len(s.liquidityProviders))
+	copy(res, s.liquidityProviders)
+
+	return res, nil
+}
+
+// fetchLiquidityProviders merges the providers of all registries, a provider
+// listed by more than one is taken from the first of them, it fails only if
+// no registry could be fetched
+func (t *tdexMarketLoaderService) fetchLiquidityProviders(
+	ctx context.Context,
+) ([]LiquidityProvider, error) {
+	res := make([]LiquidityProvider, 0)
+	seen := make(map[string]bool)
+	failures := make([]string, 0)
+	for _, registry := range t.registries {
+		liquidityProviders, err := t.fetchRegistry(ctx, registry)
+		if err != nil {
+			log.Errorf("fetch registry %v: %v", registry.Name(), err)
+			failures = append(failures, fmt.Sprintf("%v: %v", registry.Name(), err))
+			continue
+		}
+
+		for _, v := range liquidityProviders {
+			key := registryKey(v.Endpoint)
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			res = append(res, v)
+		}
+	}
+
+	if len(t.registries) > 0 && len(failures) == len(t.registries) {
+		return nil, fmt.Errorf(
+			"failed to fetch registries: %v", strings.Join(failures, ", "),
+		)
+	}
+
+	return res, nil
+}
+
+// fetchRegistry fetches the providers of registry, every registry has its own
+// timeout so that a slow one doesn't make the next ones fail
+func (t *tdexMarketLoaderService) fetchRegistry(
+	ctx context.Context,
+	registry RegistrySource,
+) ([]LiquidityProvider, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	return registry.FetchLiquidityProviders(ctx)
+}
+
+// parseRegistry verifies the signature of the registry, if pubKey is not nil,
+// and returns the providers it lists
+func parseRegistry(
+	body, signature []byte,
+	pubKey ed25519.PublicKey,
+) ([]LiquidityProvider, error) {
+	if pubKey != nil {
+		sig, err := hex.DecodeString(strings.TrimSpace(string(signature)))
+		if err != nil || !ed25519.Verify(pubKey, body, sig) {
+			return nil, ErrInvalidRegistrySignature
+		}
+	}
+
+	var liquidityProviders []LiquidityProvider
+	if err := json.Unmarshal(body, &liquidityProviders); err != nil {
+		return nil, err
+	}
+
+	return liquidityProviders, nil
+}
+
+// registryKey is the endpoint providers are deduplicated by
+func registryKey(endpoint string) string {
+	return strings.TrimRight(strings.ToLower(strings.TrimSpace(endpoint)), "/")
+}
+
+func httpGet(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status: %v, err: %v", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,155 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegistry = `[
+	{"name": "provider1", "endpoint": "https://provider1.com:9945"},
+	{"name": "provider2", "endpoint": "http://provider2.onion"}
+]`
+
+func TestFileRegistrySource(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "registry.json")
+	require.NoError(t, os.WriteFile(path, []byte(testRegistry), 0600))
+	ctx := context.Background()
+
+	// signature is not needed without pubkey
+	providers, err := NewRegistrySource(path, nil).FetchLiquidityProviders(ctx)
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+	assert.Equal(t, "provider1", providers[0].Name)
+	assert.Equal(t, "http://provider2.onion", providers[1].Endpoint)
+
+	_, err = NewRegistrySource(path, pubKey).FetchLiquidityProviders(ctx)
+	assert.Error(t, err)
+
+	signature := hex.EncodeToString(ed25519.Sign(privKey, []byte(testRegistry)))
+	require.NoError(t, os.WriteFile(
+		path+RegistrySignatureSuffix, []byte(signature+"\n"), 0600,
+	))
+	providers, err = NewRegistrySource(path, pubKey).FetchLiquidityProviders(ctx)
+	require.NoError(t, err)
+	assert.Len(t, providers, 2)
+
+	_, err = NewRegistrySource(path, otherPubKey).FetchLiquidityProviders(ctx)
+	assert.ErrorIs(t, err, ErrInvalidRegistrySignature)
+
+	// tampered registry
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"name": "provider1", "endpoint": "https://provider1.com:9945"},
+		{"name": "fake", "endpoint": "https://fake.com"}
+	]`), 0600))
+	_, err = NewRegistrySource(path, pubKey).FetchLiquidityProviders(ctx)
+	assert.ErrorIs(t, err, ErrInvalidRegistrySignature)
+}
+
+func TestHttpRegistrySource(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signature := hex.EncodeToString(ed25519.Sign(privKey, []byte(testRegistry)))
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/registry.json":
+				w.Write([]byte(testRegistry))
+			case "/registry.json" + RegistrySignatureSuffix:
+				w.Write([]byte(signature))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+	ctx := context.Background()
+
+	source := NewRegistrySource(server.URL+"/registry.json", pubKey)
+	assert.Equal(t, server.URL+"/registry.json", source.Name())
+	providers, err := source.FetchLiquidityProviders(ctx)
+	require.NoError(t, err)
+	assert.Len(t, providers, 2)
+
+	_, err = NewRegistrySource(server.URL+"/other.json", nil).
+		FetchLiquidityProviders(ctx)
+	assert.Error(t, err)
+}
+
+func TestFetchLiquidityProviders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registry.json")
+	require.NoError(t, os.WriteFile(path, []byte(testRegistry), 0600))
+
+	svc := &tdexMarketLoaderService{
+		requestTimeout: time.Minute,
+		registries: []RegistrySource{
+			NewStaticRegistrySource([]LiquidityProvider{
+				{Name: "static", Endpoint: "https://Provider1.com:9945/"},
+				{Name: "", Endpoint: ""},
+			}),
+			NewRegistrySource(filepath.Join(t.TempDir(), "missing.json"), nil),
+			NewRegistrySource(path, nil),
+		},
+	}
+
+	// providers are deduplicated by endpoint, the first registry wins
+	providers, err := svc.fetchLiquidityProviders(context.Background())
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+	assert.Equal(t, "static", providers[0].Name)
+	assert.Equal(t, "provider2", providers[1].Name)
+
+	// fails only if no registry is available
+	svc.registries = svc.registries[1:2]
+	_, err = svc.fetchLiquidityProviders(context.Background())
+	assert.Error(t, err)
+
+	// a slow registry doesn't consume the timeout of the next ones
+	svc.requestTimeout = 50 * time.Millisecond
+	svc.registries = []RegistrySource{
+		slowRegistrySource{40 * time.Millisecond, []LiquidityProvider{
+			{Name: "first", Endpoint: "https://provider1.com:9945"},
+		}},
+		slowRegistrySource{40 * time.Millisecond, []LiquidityProvider{
+			{Name: "second", Endpoint: "https://provider2.com:9945"},
+		}},
+	}
+	providers, err = svc.fetchLiquidityProviders(context.Background())
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+}
+
+// slowRegistrySource is a registry answering after delay, unless its ctx is
+// done before
+type slowRegistrySource struct {
+	delay     time.Duration
+	providers []LiquidityProvider
+}
+
+func (slowRegistrySource) Name() string { return "slow" }
+
+func (s slowRegistrySource) FetchLiquidityProviders(
+	ctx context.Context,
+) ([]LiquidityProvider, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.delay):
+		return s.providers, nil
+	}
+}
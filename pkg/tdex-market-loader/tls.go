@@ -0,0 +1,273 @@
+package tdexmarketloader
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SpkiPinPrefix prefixes the base64 sha256 of the subject public key info
+	//of a certificate, as in curl's --pinnedpubkey
+	SpkiPinPrefix = "sha256/"
+	// CertPinPrefix prefixes the base64 sha256 of a DER certificate
+	CertPinPrefix = "cert-sha256/"
+
+	grpcHandshakeFailure = "authentication handshake failed"
+)
+
+var (
+	// ErrTLSVerification wraps the errors of providers whose certificate is
+	//not valid or does not match the pinned ones
+	ErrTLSVerification = errors.New("provider tls verification failed")
+
+	errPinMismatch = errors.New("tls: certificate does not match any pin")
+)
+
+// LoadPins reads the file at path mapping provider endpoints to their pins,
+// ie. {"https://provider.com:9945": ["sha256/<base64>"]}
+func LoadPins(path string) (map[string][]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make(map[string][]string)
+	if err := json.Unmarshal(b, &pins); err != nil {
+		return nil, fmt.Errorf("invalid pins file %v: %w", path, err)
+	}
+
+	for endpoint, v := range pins {
+		if err := validatePins(v); err != nil {
+			return nil, fmt.Errorf("invalid pins for %v: %w", endpoint, err)
+		}
+	}
+
+	return pins, nil
+}
+
+func validatePins(pins []string) error {
+	for _, v := range pins {
+		hash := v
+		switch {
+		case strings.HasPrefix(v, CertPinPrefix):
+			hash = strings.TrimPrefix(v, CertPinPrefix)
+		case strings.HasPrefix(v, SpkiPinPrefix):
+			hash = strings.TrimPrefix(v, SpkiPinPrefix)
+		default:
+			return fmt.Errorf(
+				"pin %v must start with %v or %v", v, SpkiPinPrefix, CertPinPrefix,
+			)
+		}
+
+		b, err := base64.StdEncoding.DecodeString(hash)
+		if err != nil || len(b) != sha256.Size {
+			return fmt.Errorf("pin %v is not a base64 sha256 hash", v)
+		}
+	}
+
+	return nil
+}
+
+// tlsPins holds the pins of the providers by host, the ones of the override
+// file take precedence over the ones published in the registry
+type tlsPins struct {
+	lock     sync.RWMutex
+	override map[string][]string
+	registry map[string][]string
+}
+
+func newTLSPins(override map[string][]string) *tlsPins {
+	p := &tlsPins{
+		override: make(map[string][]string),
+		registry: make(map[string][]string),
+	}
+	for endpoint, pins := range override {
+		p.override[endpointHost(endpoint)] = pins
+	}
+
+	return p
+}
+
+func (p *tlsPins) get(host string) []string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if pins, ok := p.override[host]; ok {
+		return pins
+	}
+	return p.registry[host]
+}
+
+// setRegistry stores the pins published in the registry for endpoint, it
+// returns whether they changed
+func (p *tlsPins) setRegistry(endpoint string, pins []string) bool {
+	host := endpointHost(endpoint)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if reflect.DeepEqual(p.registry[host], pins) {
+		return false
+	}
+	if len(pins) == 0 {
+		delete(p.registry, host)
+	} else {
+		p.registry[host] = pins
+	}
+	_, overridden := p.override[host]
+
+	return !overridden
+}
+
+// tlsConfig returns the config used for connecting to the given https url,
+// certificates are verified against the system roots, unless pins are
+// configured for the provider, in which case the pinned certificates are the
+// only roots the leaf is verified against, this way self-signed certificates
+// can be pinned
+func (t *tdexMarketLoaderService) tlsConfig(rawUrl string) *tls.Config {
+	host := endpointHost(rawUrl)
+	pins := t.pins.get(host)
+	if len(pins) == 0 {
+		return &tls.Config{}
+	}
+
+	return &tls.Config{
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verifyPins(pins, hostname(host)),
+	}
+}
+
+// setRegistryPins stores the pins of a provider published in the registry,
+// the pooled connections are dropped if they changed, so that the next call
+// verifies the provider with the new ones
+func (t *tdexMarketLoaderService) setRegistryPins(provider LiquidityProvider) {
+	if err := validatePins(provider.Pins); err != nil {
+		log.Warnf(
+			"ignoring pins of liquidity provider %v: %v", provider.Name, err,
+		)
+		provider.Pins = nil
+	}
+
+	if t.pins.setRegistry(provider.Endpoint, provider.Pins) {
+		t.connPool.drop(provider.Endpoint)
+		t.httpClients.drop(provider.Endpoint)
+	}
+}
+
+// verifyPins returns the func verifying that the leaf presented by the
+// server is valid for serverName and chains up to a presented certificate
+// matching one of the pins, this one can be the leaf itself
+func verifyPins(
+	pins []string,
+	serverName string,
+) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errPinMismatch
+		}
+
+		roots := x509.NewCertPool()
+		intermediates := x509.NewCertPool()
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		pinned := false
+		for _, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+
+			if matchesPins(cert, pins) {
+				roots.AddCert(cert)
+				pinned = true
+			} else {
+				intermediates.AddCert(cert)
+			}
+		}
+		if !pinned {
+			return errPinMismatch
+		}
+
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         roots,
+			Intermediates: intermediates,
+		})
+		return err
+	}
+}
+
+func matchesPins(cert *x509.Certificate, pins []string) bool {
+	spki := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	der := sha256.Sum256(cert.Raw)
+	spkiPin := SpkiPinPrefix + base64.StdEncoding.EncodeToString(spki[:])
+	certPin := CertPinPrefix + base64.StdEncoding.EncodeToString(der[:])
+	for _, v := range pins {
+		if v == spkiPin || v == certPin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTLSError returns whether err is due to the verification of the provider
+// certificate, gRPC flattens handshake errors into its status message
+func isTLSError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var (
+		unknownAuthorityErr x509.UnknownAuthorityError
+		hostnameErr         x509.HostnameError
+		invalidErr          x509.CertificateInvalidError
+		recordHeaderErr     tls.RecordHeaderError
+	)
+	switch {
+	case errors.Is(err, ErrTLSVerification),
+		errors.Is(err, errPinMismatch),
+		errors.As(err, &unknownAuthorityErr),
+		errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr),
+		errors.As(err, &recordHeaderErr):
+		return true
+	}
+
+	return strings.Contains(err.Error(), grpcHandshakeFailure)
+}
+
+// hostname returns host without port
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return strings.Trim(host, "[]")
+}
+
+// endpointHost returns the host:port of endpoint, with or without scheme
+func endpointHost(endpoint string) string {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = httpsRegex + endpoint
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+
+	return u.Host
+}
@@ -0,0 +1,168 @@
+package tdexmarketloader
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSPinning(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("{}"))
+		},
+	))
+	defer server.Close()
+
+	cert := server.Certificate()
+	spki := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	der := sha256.Sum256(cert.Raw)
+	spkiPin := SpkiPinPrefix + base64.StdEncoding.EncodeToString(spki[:])
+	certPin := CertPinPrefix + base64.StdEncoding.EncodeToString(der[:])
+	otherPin := SpkiPinPrefix + base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	svc := &tdexMarketLoaderService{
+		pins:     newTLSPins(nil),
+		connPool: newConnPool(time.Minute, nil),
+	}
+	svc.httpClients = newHttpClients(time.Minute, svc.newHttpTransport)
+	ctx := context.Background()
+
+	// self-signed certificates are rejected unless pinned
+	_, err := svc.http1Req(ctx, server.URL, "POST", nil)
+	require.Error(t, err)
+	assert.True(t, isTLSError(err))
+
+	for _, pin := range []string{spkiPin, certPin} {
+		svc.setRegistryPins(LiquidityProvider{Endpoint: server.URL, Pins: []string{pin}})
+		_, err = svc.http1Req(ctx, server.URL+"/v2/markets", "POST", nil)
+		assert.NoError(t, err)
+	}
+
+	svc.setRegistryPins(LiquidityProvider{Endpoint: server.URL, Pins: []string{otherPin}})
+	_, err = svc.http1Req(ctx, server.URL, "POST", nil)
+	require.Error(t, err)
+	assert.True(t, isTLSError(err))
+
+	// pins of the override file take precedence over the registry ones
+	svc.pins = newTLSPins(map[string][]string{server.URL: {spkiPin}})
+	svc.httpClients = newHttpClients(time.Minute, svc.newHttpTransport)
+	svc.setRegistryPins(LiquidityProvider{Endpoint: server.URL, Pins: []string{otherPin}})
+	_, err = svc.http1Req(ctx, server.URL, "POST", nil)
+	assert.NoError(t, err)
+
+	// invalid pins published in the registry are ignored
+	svc.pins = newTLSPins(nil)
+	svc.setRegistryPins(LiquidityProvider{Endpoint: server.URL, Pins: []string{"invalid"}})
+	assert.Empty(t, svc.pins.get(endpointHost(server.URL)))
+}
+
+func TestTLSPinningUnpinnedLeaf(t *testing.T) {
+	pinned := httptest.NewTLSServer(http.NotFoundHandler())
+	pinned.Close()
+	pinnedCert := pinned.Certificate()
+	spki := sha256.Sum256(pinnedCert.RawSubjectPublicKeyInfo)
+	der := sha256.Sum256(pinnedCert.Raw)
+
+	// the server presents a leaf of its own followed by the pinned
+	//certificate, which did not sign it
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leaf, err := x509.CreateCertificate(
+		rand.Reader, template, template, &key.PublicKey, key,
+	)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("{}"))
+		},
+	))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{leaf, pinnedCert.Raw},
+			PrivateKey:  key,
+		}},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	svc := &tdexMarketLoaderService{
+		pins:     newTLSPins(nil),
+		connPool: newConnPool(time.Minute, nil),
+	}
+	svc.httpClients = newHttpClients(time.Minute, svc.newHttpTransport)
+	ctx := context.Background()
+
+	for _, pin := range []string{
+		SpkiPinPrefix + base64.StdEncoding.EncodeToString(spki[:]),
+		CertPinPrefix + base64.StdEncoding.EncodeToString(der[:]),
+	} {
+		svc.setRegistryPins(LiquidityProvider{Endpoint: server.URL, Pins: []string{pin}})
+		_, err = svc.http1Req(ctx, server.URL, "POST", nil)
+		require.Error(t, err)
+		assert.True(t, isTLSError(err))
+	}
+}
+
+func TestLoadPins(t *testing.T) {
+	dir := t.TempDir()
+	pin := SpkiPinPrefix + base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	path := filepath.Join(dir, "pins.json")
+	require.NoError(t, os.WriteFile(
+		path, []byte(`{"https://provider.com:9945": ["`+pin+`"]}`), 0600,
+	))
+	pins, err := LoadPins(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"https://provider.com:9945": {pin}}, pins)
+
+	for _, v := range []string{
+		`{"https://provider.com:9945": ["md5/AAAA"]}`,
+		`{"https://provider.com:9945": ["sha256/AAAA"]}`,
+		`["sha256/AAAA"]`,
+	} {
+		require.NoError(t, os.WriteFile(path, []byte(v), 0600))
+		_, err = LoadPins(path)
+		assert.Error(t, err)
+	}
+}
+
+func TestEndpointHost(t *testing.T) {
+	assert.Equal(t, "provider.com:9945", endpointHost("https://provider.com:9945"))
+	assert.Equal(t, "provider.com:9945", endpointHost("https://provider.com:9945/v2/markets"))
+	assert.Equal(t, "provider.onion", endpointHost("http://provider.onion"))
+	assert.Equal(t, "provider.com:9945", endpointHost("provider.com:9945"))
+}
+
+func TestHostname(t *testing.T) {
+	assert.Equal(t, "provider.com", hostname("provider.com:9945"))
+	assert.Equal(t, "provider.onion", hostname("provider.onion"))
+	assert.Equal(t, "::1", hostname("[::1]:9945"))
+}
@@ -1,25 +1,111 @@
 package tdexmarketloader
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ProtocolV2 and ProtocolV1 are the tdex protocol versions served over
+	//gRPC, ProtocolV2Http1 and ProtocolV1Http1 the same served over HTTP/1,
+	//ProtocolV1Preview is the v1 trade preview used when market price is not
+	//served
+	ProtocolV2        = "v2"
+	ProtocolV2Http1   = "v2/http1"
+	ProtocolV1        = "v1"
+	ProtocolV1Http1   = "v1/http1"
+	ProtocolV1Preview = "v1/preview"
+
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
 
 type Market struct {
 	Url        string
 	QuoteAsset string
 	BaseAsset  string
+	// Fee is returned only by providers supporting v2 protocol
+	Fee *Fee
 }
 
 type LiquidityProvider struct {
 	Name     string `json:"name"`
 	Endpoint string `json:"endpoint"`
-	Markets  []Market
+	// Pins are the optional SpkiPinPrefix or CertPinPrefix prefixed hashes
+	//of the certificates the provider is trusted with
+	Pins []string `json:"pins,omitempty"`
+	// Adapter is the name of the SourceAdapter the provider is tracked with,
+	//AdapterTdex if empty
+	Adapter string `json:"adapter,omitempty"`
+	Markets []Market
 }
 
 type Balance struct {
 	BaseBalance  decimal.Decimal
 	QuoteBalance decimal.Decimal
+	// Protocol is the protocol path the balance was fetched with
+	Protocol string
 }
 
 type Price struct {
 	BasePrice  decimal.Decimal
 	QuotePrice decimal.Decimal
+	// Protocol is the protocol path the price was fetched with
+	Protocol string
+}
+
+// Snapshot is the price and balance of a market fetched in one pass
+type Snapshot struct {
+	Price   Price
+	Balance Balance
+}
+
+// Fee is the fee charged by a market, percentage fees are expressed in basis
+// points and fixed fees in satoshis of the respective asset
+type Fee struct {
+	BasePercentageFee  int64
+	QuotePercentageFee int64
+	BaseFixedFee       int64
+	QuoteFixedFee      int64
+	// PreviewFeeAmount and PreviewFeeAsset are the fee charged for selling
+	//the configured price amount of base asset, they are set only if the fee
+	//is fetched from a trade preview
+	PreviewFeeAmount uint64
+	PreviewFeeAsset  string
+}
+
+type TradeType int
+
+const (
+	TradeTypeBuy TradeType = iota
+	TradeTypeSell
+)
+
+func (t TradeType) String() string {
+	if t == TradeTypeSell {
+		return "sell"
+	}
+	return "buy"
+}
+
+// DepthLevel is the effective price, fees included, of buying or selling
+// Amount satoshis of market base asset
+type DepthLevel struct {
+	TradeType TradeType
+	Amount    uint64
+	// Price is the amount of quote asset paid or received per unit of base asset
+	Price decimal.Decimal
+}
+
+// CircuitBreakerStatus is the health of a provider as seen by its circuit
+// breaker
+type CircuitBreakerStatus struct {
+	State               string
+	ConsecutiveFailures int
+	LastError           string
+	// RetryAt is when the provider is probed again, zero if the breaker is
+	//not open
+	RetryAt time.Time
 }
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+var (
+	// ErrInvalidScheme is returned for webhook urls other than http(s) ones
+	ErrInvalidScheme = errors.New("webhook url must be http or https")
+	// ErrNonPublicHost is returned for webhook urls, or addresses they resolve
+	//to, that are loopback, link-local or private
+	ErrNonPublicHost = errors.New(
+		"webhook url must not point to a loopback, link-local or private host",
+	)
+
+	// nonPublicNetworks are the ranges webhooks are not delivered to, besides
+	//the loopback, link-local, multicast and unspecified ones
+	nonPublicNetworks = parseCIDRs(
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"fc00::/7",
+	)
+)
+
+// ValidateUrl returns an error if rawUrl is not an http(s) url or its host is
+// a loopback, link-local or private address, hostnames are checked once
+// resolved, when webhooks are delivered
+func ValidateUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalidScheme
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" {
+		return errors.New("webhook url must have a host")
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrNonPublicHost
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return ErrNonPublicHost
+	}
+
+	return nil
+}
+
+// isPublicIP returns whether ip is routable on the internet
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, v := range nonPublicNetworks {
+		if v.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dialPublicOnly is the control of the dialer of the webhooks, it refuses
+// connecting to non public addresses, so that hostnames resolving to them
+// are not reachable either
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return ErrNonPublicHost
+	}
+
+	return nil
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, v := range cidrs {
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/port"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body
+	//computed with the secret of the alert rule, prefixed with "sha256="
+	SignatureHeader = "X-Tdexa-Signature"
+)
+
+type webhookService struct {
+	httpClient *http.Client
+}
+
+// NewService returns the service delivering webhooks, only to public
+// addresses
+func NewService(timeout time.Duration) port.WebhookService {
+	return newService(timeout, dialPublicOnly)
+}
+
+func newService(
+	timeout time.Duration,
+	control func(network, address string, c syscall.RawConn) error,
+) *webhookService {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed in place of the webhook host
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &webhookService{
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+	}
+}
+
+func (w *webhookService) Send(
+	ctx context.Context,
+	url string,
+	secret string,
+	payload []byte,
+) (int, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(payload),
+	)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, payload))
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain body so that the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf(
+			"webhook responded with status %v", resp.StatusCode,
+		)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the value of SignatureHeader for payload, receivers verify
+// deliveries by computing it with the same secret
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUrl(t *testing.T) {
+	for _, v := range []string{
+		"https://example.com/hook",
+		"http://example.com:8080/hook",
+		"https://8.8.8.8/hook",
+	} {
+		require.NoError(t, ValidateUrl(v), v)
+	}
+
+	for _, v := range []string{
+		"ftp://example.com/hook",
+		"file:///etc/passwd",
+		"example.com/hook",
+	} {
+		require.ErrorIs(t, ValidateUrl(v), ErrInvalidScheme, v)
+	}
+
+	for _, v := range []string{
+		"http://localhost:8080/hook",
+		"http://api.localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://[::1]/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+		"http://172.16.5.4/hook",
+		"http://192.168.1.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		require.ErrorIs(t, ValidateUrl(v), ErrNonPublicHost, v)
+	}
+}
+
+func TestSendNonPublicHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {},
+	))
+	defer server.Close()
+
+	svc := NewService(time.Second)
+
+	_, err := svc.Send(context.Background(), server.URL, "secret", nil)
+	require.ErrorIs(t, err, ErrNonPublicHost)
+
+	// hostnames resolving to non public addresses are refused when dialed
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	_, err = svc.Send(
+		context.Background(), "http://localhost:"+port, "secret", nil,
+	)
+	require.ErrorIs(t, err, ErrNonPublicHost)
+}
+
+func TestSend(t *testing.T) {
+	payload := []byte(`{"rule_id":1}`)
+	var signature string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			signature = r.Header.Get(SignatureHeader)
+			body, _ = ioutil.ReadAll(r.Body)
+			if r.URL.Path == "/fail" {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		},
+	))
+	defer server.Close()
+
+	// the test server listens on loopback
+	svc := newService(time.Second, nil)
+
+	status, err := svc.Send(context.Background(), server.URL, "secret", payload)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, payload, body)
+	require.Equal(t, Sign("secret", payload), signature)
+	require.NotEqual(t, Sign("other", payload), signature)
+
+	status, err = svc.Send(
+		context.Background(), server.URL+"/fail", "secret", payload,
+	)
+	require.Error(t, err)
+	require.Equal(t, http.StatusInternalServerError, status)
+}
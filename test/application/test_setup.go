@@ -2,32 +2,37 @@ package applicationtest
 
 import (
 	"context"
-	"os"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-analytics/internal/core/application"
-	dbinflux "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/influx"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"github.com/tdex-network/tdex-analytics/internal/infrastructure/db/inmemory"
 	dbpg "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg"
 	"github.com/tdex-network/tdex-analytics/pkg/rater"
 	tdexmarketloader "github.com/tdex-network/tdex-analytics/pkg/tdex-market-loader"
+	"github.com/tdex-network/tdex-analytics/pkg/webhook"
 
 	"github.com/stretchr/testify/suite"
 )
 
 var (
-	influxDbSvc       dbinflux.Service
-	marketBalanceSvc  application.MarketBalanceService
-	marketPriceSvc    application.MarketPriceService
-	marketLoaderSvc   application.MarketsLoaderService
-	marketSvc         application.MarketService
-	marketRepository  dbpg.Service
-	ctx               = context.Background()
-	nilPp             = application.NIL
-	lastHourPp        = application.LastHour
-	lastDayPp         = application.LastDay
-	lastMonthPp       = application.LastMonth
-	lastThreeMonthsPp = application.LastThreeMonths
-	yearToDatePp      = application.YearToDate
-	allPp             = application.All
+	marketPriceRepository   domain.MarketPriceRepository
+	marketBalanceRepository domain.MarketBalanceRepository
+	rateRepository          domain.RateRepository
+	marketBalanceSvc        application.MarketBalanceService
+	marketPriceSvc          application.MarketPriceService
+	marketLoaderSvc         application.MarketsLoaderService
+	marketSvc               application.MarketService
+	marketRepository        dbpg.Service
+	ctx                     = context.Background()
+	nilPp                   = application.NIL
+	lastHourPp              = application.LastHour
+	lastDayPp               = application.LastDay
+	lastMonthPp             = application.LastMonth
+	lastThreeMonthsPp       = application.LastThreeMonths
+	yearToDatePp            = application.YearToDate
+	allPp                   = application.All
 )
 
 type AppSvcTestSuit struct {
@@ -35,23 +40,16 @@ type AppSvcTestSuit struct {
 }
 
 func (a *AppSvcTestSuit) SetupSuite() {
-	token := os.Getenv("TDEXA_INFLUXDB_TOKEN")
-	if token == "" {
-		a.FailNow("TDEXA_INFLUXDB_TOKEN not set")
-	}
-
-	db, err := dbinflux.New(dbinflux.Config{
-		Org:             "tdex-network",
-		AuthToken:       token,
-		DbUrl:           "http://localhost:8086",
-		AnalyticsBucket: "analytics",
-	})
-	if err != nil {
+	// time series are kept in memory, so that only postgres is needed
+	marketBalanceRepository = inmemory.NewMarketBalanceRepository()
+	marketPriceRepository = inmemory.NewMarketPriceRepository(
+		marketBalanceRepository,
+	)
+	rateRepository = inmemory.NewRateRepository()
+	if err := generateTimeSeries(); err != nil {
 		a.FailNow(err.Error())
 	}
 
-	influxDbSvc = db
-
 	mr, err := dbpg.New(dbpg.DbConfig{
 		DbUser:     "root",
 		DbPassword: "secret",
@@ -74,11 +72,20 @@ func (a *AppSvcTestSuit) SetupSuite() {
 	}
 	marketRepository = mr
 
-	tdexMarketLoaderSvc := tdexmarketloader.NewService(
-		"127.0.0.1:9050",
-		"https://api.github.com/repos/tdex-network/tdex-registry/contents/registry.json",
-		1000,
-	)
+	tdexMarketLoaderSvc := tdexmarketloader.NewService(tdexmarketloader.Config{
+		TorProxyUrl: "127.0.0.1:9050",
+		Registries: []tdexmarketloader.RegistrySource{
+			tdexmarketloader.NewHttpRegistrySource(
+				"https://api.github.com/repos/tdex-network/tdex-registry/contents/registry.json",
+				nil,
+			),
+		},
+		PriceAmount:      1000,
+		ConnIdleTimeout:  time.Minute,
+		RequestTimeout:   30 * time.Second,
+		BreakerThreshold: 5,
+		BreakerCooldown:  time.Minute,
+	})
 
 	raterSvc, err := rater.NewExchangeRateClient(map[string]string{
 		"5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225": "bitcoin",
@@ -92,28 +99,33 @@ func (a *AppSvcTestSuit) SetupSuite() {
 		a.FailNow(err.Error())
 	}
 
+	alertSvc := application.NewAlertService(
+		marketRepository,
+		marketRepository,
+		marketPriceRepository,
+		webhook.NewService(time.Second),
+	)
 	marketLoaderSvc = application.NewMarketsLoaderService(
+		marketRepository,
 		marketRepository,
 		tdexMarketLoaderSvc,
+		alertSvc,
 	)
 	marketBalanceSvc = application.NewMarketBalanceService(
-		influxDbSvc,
+		marketBalanceRepository,
 		marketRepository,
-		tdexMarketLoaderSvc,
-		"5",
 	)
 	marketPriceSvc = application.NewMarketPriceService(
-		influxDbSvc,
+		marketPriceRepository,
 		marketRepository,
-		tdexMarketLoaderSvc,
-		"5",
 		raterSvc,
+		rateRepository,
 	)
 	marketSvc = application.NewMarketService(marketRepository)
 }
 
 func (a *AppSvcTestSuit) TearDownSuite() {
-	influxDbSvc.Close()
+	marketRepository.Close()
 }
 
 func (a *AppSvcTestSuit) BeforeTest(suiteName, testName string) {
@@ -123,3 +135,34 @@ func (a *AppSvcTestSuit) BeforeTest(suiteName, testName string) {
 }
 
 func (a *AppSvcTestSuit) AfterTest(suiteName, testName string) {}
+
+// generateTimeSeries stores prices and balances of markets 1 and 2 every 5
+// minutes for the 4 previous months, like script/data_generator.go does for
+// influxdb
+func generateTimeSeries() error {
+	now := time.Now()
+	approxFourMonths := 24 * 30 * 4 * time.Hour
+	for t := now.Add(-approxFourMonths); !t.After(now); t = t.Add(5 * time.Minute) {
+		for _, marketID := range []string{"1", "2"} {
+			if err := marketPriceRepository.InsertPrice(ctx, domain.MarketPrice{
+				MarketID:   marketID,
+				BasePrice:  decimal.NewFromInt(51),
+				QuotePrice: decimal.NewFromInt(501),
+				Time:       t,
+			}); err != nil {
+				return err
+			}
+
+			if err := marketBalanceRepository.InsertBalance(ctx, domain.MarketBalance{
+				MarketID:     marketID,
+				BaseBalance:  decimal.NewFromInt(52),
+				QuoteBalance: decimal.NewFromInt(502),
+				Time:         t,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
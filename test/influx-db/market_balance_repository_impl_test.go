@@ -188,3 +188,82 @@ func (idb *InfluxDBTestSuit) TestGetMarketBalanceWithoutGroupBy() {
 
 	idb.Equal(10, len(marketsBalances[marketID]))
 }
+
+func (idb *InfluxDBTestSuit) TestGetLatestBalances() {
+	ctx := context.Background()
+
+	marketID := "90001"
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := dbSvc.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  decimal.NewFromInt(int64(50 + i)),
+			QuoteBalance: decimal.NewFromInt(int64(500 + i)),
+			Time:         now.Add(time.Duration(i-3) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	balances, err := dbSvc.GetLatestBalances(ctx, marketID)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.True(decimal.NewFromInt(52).Equal(balances[marketID].BaseBalance))
+	idb.True(decimal.NewFromInt(502).Equal(balances[marketID].QuoteBalance))
+}
+
+func (idb *InfluxDBTestSuit) TestLargeBalancesAreExact() {
+	ctx := context.Background()
+
+	marketID := "90002"
+	now := time.Now()
+	// above float64 precision
+	baseBalance, _ := decimal.NewFromString("9007199254740993")
+	quoteBalance, _ := decimal.NewFromString("9223372036854775807")
+	for i := 0; i < 2; i++ {
+		if err := dbSvc.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  baseBalance,
+			QuoteBalance: quoteBalance,
+			Time:         now.Add(time.Duration(i-2) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	balances, err := dbSvc.GetLatestBalances(ctx, marketID)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+	idb.True(baseBalance.Equal(balances[marketID].BaseBalance))
+	idb.True(quoteBalance.Equal(balances[marketID].QuoteBalance))
+
+	marketsBalances, err := dbSvc.GetBalancesForMarkets(
+		ctx,
+		now.Add(-time.Hour),
+		now,
+		domain.Page{Number: 1, Size: 10},
+		"1d",
+		marketID,
+	)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+	for _, v := range marketsBalances[marketID] {
+		if v.BaseBalance.IsZero() {
+			continue
+		}
+		idb.True(baseBalance.Equal(v.BaseBalance), v.BaseBalance.String())
+		idb.True(quoteBalance.Equal(v.QuoteBalance), v.QuoteBalance.String())
+	}
+
+	err = dbSvc.InsertBalance(ctx, domain.MarketBalance{
+		MarketID:     marketID,
+		BaseBalance:  decimal.NewFromFloat(0.5),
+		QuoteBalance: quoteBalance,
+		Time:         now,
+	})
+	idb.ErrorIs(err, dbinflux.ErrInvalidBalance)
+}
@@ -0,0 +1,45 @@
+package influxdbtest
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (idb *InfluxDBTestSuit) TestGetMarketDepth() {
+	ctx := context.Background()
+
+	marketID := "90003"
+	now := time.Now().Add(-time.Minute)
+	depth := make([]domain.MarketDepth, 0)
+	for _, amount := range []int64{1000000, 100000000} {
+		depth = append(depth, domain.MarketDepth{
+			MarketID:  marketID,
+			TradeType: domain.TradeTypeBuy,
+			Amount:    decimal.NewFromInt(amount),
+			Price:     decimal.NewFromInt(30100),
+			Time:      now,
+		}, domain.MarketDepth{
+			MarketID:  marketID,
+			TradeType: domain.TradeTypeSell,
+			Amount:    decimal.NewFromInt(amount),
+			Price:     decimal.NewFromInt(29900),
+			Time:      now,
+		})
+	}
+
+	if err := dbSvc.InsertDepth(ctx, depth); err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	result, err := dbSvc.GetDepthForMarkets(ctx, now.Add(-time.Hour), time.Now(), "", marketID)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.Len(result[marketID], 4)
+	for _, v := range result[marketID] {
+		idb.True(v.Time.Equal(now))
+	}
+}
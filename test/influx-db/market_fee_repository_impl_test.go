@@ -0,0 +1,46 @@
+package influxdbtest
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (idb *InfluxDBTestSuit) TestGetMarketFees() {
+	ctx := context.Background()
+
+	marketID := "90002"
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := dbSvc.InsertFee(ctx, domain.MarketFee{
+			MarketID:           marketID,
+			BasePercentageFee:  decimal.NewFromInt(int64(25 + i)),
+			QuotePercentageFee: decimal.NewFromInt(int64(25 + i)),
+			BaseFixedFee:       decimal.NewFromInt(100),
+			QuoteFixedFee:      decimal.NewFromInt(200),
+			PreviewFeeAmount:   decimal.NewFromInt(2500),
+			PreviewFeeAsset:    "quote_asset",
+			Time:               now.Add(time.Duration(i-3) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	fees, err := dbSvc.GetFeesForMarkets(
+		ctx,
+		now.Add(-time.Hour),
+		now,
+		domain.NewPage(1, 10),
+		"",
+		marketID,
+	)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.Len(fees[marketID], 3)
+	idb.True(decimal.NewFromInt(27).Equal(fees[marketID][2].BasePercentageFee))
+	idb.True(decimal.NewFromInt(200).Equal(fees[marketID][2].QuoteFixedFee))
+	idb.Equal("quote_asset", fees[marketID][2].PreviewFeeAsset)
+}
@@ -195,3 +195,70 @@ func (idb *InfluxDBTestSuit) TestGetMarketPriceWithoutGrouping() {
 
 	idb.Equal(10, len(marketsPrices[marketID]))
 }
+
+func (idb *InfluxDBTestSuit) TestGetMarketCandles() {
+	ctx := context.Background()
+
+	marketID := "9998"
+	bucketStart := time.Now().UTC().Truncate(time.Hour).Add(-2 * time.Hour)
+	quotePrices := []int64{500, 520, 480, 510}
+	for i, v := range quotePrices {
+		if err := dbSvc.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimal.NewFromInt(1).Div(decimal.NewFromInt(v)),
+			QuotePrice: decimal.NewFromInt(v),
+			Time:       bucketStart.Add(time.Duration(i+1) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	page := domain.Page{
+		Number: 1,
+		Size:   10,
+	}
+
+	marketsCandles, err := dbSvc.GetCandlesForMarkets(
+		ctx,
+		bucketStart,
+		bucketStart.Add(time.Hour),
+		page,
+		"1h",
+		[]string{marketID}...,
+	)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.Equal(1, len(marketsCandles[marketID]))
+	candle := marketsCandles[marketID][0]
+	idb.True(decimal.NewFromInt(500).Equal(candle.Open))
+	idb.True(decimal.NewFromInt(520).Equal(candle.High))
+	idb.True(decimal.NewFromInt(480).Equal(candle.Low))
+	idb.True(decimal.NewFromInt(510).Equal(candle.Close))
+}
+
+func (idb *InfluxDBTestSuit) TestGetLatestPrices() {
+	ctx := context.Background()
+
+	marketID := "9997"
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := dbSvc.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimal.NewFromInt(int64(50 + i)),
+			QuotePrice: decimal.NewFromInt(int64(500 + i)),
+			Time:       now.Add(time.Duration(i-3) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	prices, err := dbSvc.GetLatestPrices(ctx, marketID)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.True(decimal.NewFromInt(52).Equal(prices[marketID].BasePrice))
+	idb.True(decimal.NewFromInt(502).Equal(prices[marketID].QuotePrice))
+}
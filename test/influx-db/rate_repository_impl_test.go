@@ -0,0 +1,33 @@
+package influxdbtest
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (idb *InfluxDBTestSuit) TestGetRates() {
+	ctx := context.Background()
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := dbSvc.InsertRate(ctx, domain.Rate{
+			Source: "bitcoin",
+			Target: "eur",
+			Value:  decimal.NewFromInt(int64(30000 + i)),
+			Time:   now.Add(time.Duration(i-3) * time.Minute),
+		}); err != nil {
+			idb.FailNow(err.Error())
+		}
+	}
+
+	rates, err := dbSvc.GetRates(ctx, "bitcoin", "eur", now.Add(-time.Hour), now)
+	if err != nil {
+		idb.FailNow(err.Error())
+	}
+
+	idb.Len(rates, 3)
+	idb.True(decimal.NewFromInt(30000).Equal(rates[0].Value))
+	idb.True(decimal.NewFromInt(30002).Equal(rates[2].Value))
+}
@@ -0,0 +1,65 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (s *PgDbTestSuite) TestInsertAndGetAlertRules() {
+	ctx := context.Background()
+	rule := domain.AlertRule{
+		MarketID:      "1",
+		Type:          domain.AlertRulePriceChange,
+		Threshold:     decimal.NewFromFloat(2.5),
+		WindowMinutes: 60,
+		WebhookUrl:    "https://example.com/hook",
+		Secret:        "secret",
+		CreatedAt:     time.Now(),
+	}
+	id, err := pgDbSvc.InsertAlertRule(ctx, rule)
+	s.NoError(err)
+
+	rules, err := pgDbSvc.GetAlertRules(ctx, "1")
+	s.NoError(err)
+	s.Equal(1, len(rules))
+	s.Equal(id, rules[0].ID)
+	s.True(decimal.NewFromFloat(2.5).Equal(rules[0].Threshold))
+	s.False(rules[0].Triggered)
+
+	s.NoError(pgDbSvc.UpdateAlertRuleTriggered(ctx, id, true))
+	stored, err := pgDbSvc.GetAlertRule(ctx, id)
+	s.NoError(err)
+	s.True(stored.Triggered)
+
+	rule.ID = id
+	rule.WindowMinutes = 30
+	s.NoError(pgDbSvc.UpdateAlertRule(ctx, rule))
+	stored, err = pgDbSvc.GetAlertRule(ctx, id)
+	s.NoError(err)
+	s.Equal(30, stored.WindowMinutes)
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		s.NoError(pgDbSvc.InsertAlertDelivery(ctx, domain.AlertDelivery{
+			RuleID:     id,
+			Payload:    "{}",
+			Attempts:   i + 1,
+			StatusCode: 200,
+			Delivered:  true,
+			Time:       now.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	deliveries, err := pgDbSvc.GetAlertDeliveries(ctx, id, domain.NewPage(1, 2))
+	s.NoError(err)
+	s.Equal(2, len(deliveries))
+	s.Equal(3, deliveries[0].Attempts)
+
+	s.NoError(pgDbSvc.DeleteAlertRule(ctx, id))
+	s.ErrorIs(pgDbSvc.DeleteAlertRule(ctx, id), domain.ErrAlertRuleNotFound)
+	_, err = pgDbSvc.GetAlertRule(ctx, id)
+	s.ErrorIs(err, domain.ErrAlertRuleNotFound)
+}
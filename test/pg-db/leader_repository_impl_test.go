@@ -0,0 +1,54 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	dbpg "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg"
+)
+
+func (s *PgDbTestSuite) TestLeaderElection() {
+	ctx := context.Background()
+	// every replica has its own db sessions
+	replica, err := dbpg.New(dbConfig)
+	s.NoError(err)
+	defer replica.Close()
+
+	isLeader, err := pgDbSvc.TryAcquireLeadership(ctx, "node1")
+	s.NoError(err)
+	s.True(isLeader)
+	isLeader, err = replica.TryAcquireLeadership(ctx, "node2")
+	s.NoError(err)
+	s.False(isLeader)
+
+	// the leader renews the leadership
+	isLeader, err = pgDbSvc.TryAcquireLeadership(ctx, "node1")
+	s.NoError(err)
+	s.True(isLeader)
+
+	leader, err := replica.GetLeader(ctx, time.Time{})
+	s.NoError(err)
+	s.NotNil(leader)
+	s.Equal("node1", leader.NodeID)
+	s.False(leader.RenewedAt.Before(leader.ElectedAt))
+
+	// leadership not renewed since the given time is expired
+	leader, err = replica.GetLeader(ctx, time.Now().Add(time.Minute))
+	s.NoError(err)
+	s.Nil(leader)
+
+	// the lock is released together with the leader session
+	s.NoError(pgDbSvc.ReleaseLeadership(ctx, "node1"))
+	leader, err = replica.GetLeader(ctx, time.Time{})
+	s.NoError(err)
+	s.Nil(leader)
+
+	isLeader, err = replica.TryAcquireLeadership(ctx, "node2")
+	s.NoError(err)
+	s.True(isLeader)
+	isLeader, err = pgDbSvc.TryAcquireLeadership(ctx, "node1")
+	s.NoError(err)
+	s.False(isLeader)
+
+	s.NoError(replica.ReleaseLeadership(ctx, "node2"))
+}
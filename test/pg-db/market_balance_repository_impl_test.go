@@ -0,0 +1,61 @@
+package pgtest
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	dbpg "github.com/tdex-network/tdex-analytics/internal/infrastructure/db/pg"
+	"time"
+)
+
+func (s *PgDbTestSuite) TestInsertAndGetBalances() {
+	ctx := context.Background()
+	marketID := "pg-balance-1"
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	largeBalance, _ := decimal.NewFromString("18446744073709551615")
+	for i := 0; i < 3; i++ {
+		if err := pgDbSvc.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  decimal.NewFromInt(int64(100 + i)),
+			QuoteBalance: largeBalance,
+			Time:         startTime.Add(time.Duration(i*10) * time.Minute),
+		}); err != nil {
+			s.FailNow(err.Error())
+		}
+	}
+
+	err := pgDbSvc.InsertBalance(ctx, domain.MarketBalance{
+		MarketID:     marketID,
+		BaseBalance:  decimal.NewFromFloat(0.5),
+		QuoteBalance: largeBalance,
+		Time:         startTime,
+	})
+	s.ErrorIs(err, dbpg.ErrInvalidBalance)
+
+	endTime := startTime.Add(time.Hour)
+	page := domain.Page{Number: 1, Size: 10}
+
+	balances, err := pgDbSvc.GetBalancesForMarkets(
+		ctx, startTime, endTime, page, "", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(3, len(balances[marketID]))
+
+	balances, err = pgDbSvc.GetBalancesForMarkets(
+		ctx, startTime, endTime, page, "1h", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(1, len(balances[marketID]))
+	s.True(decimal.NewFromInt(101).Equal(balances[marketID][0].BaseBalance))
+	s.True(largeBalance.Equal(balances[marketID][0].QuoteBalance))
+
+	latest, err := pgDbSvc.GetLatestBalances(ctx, marketID)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.True(decimal.NewFromInt(102).Equal(latest[marketID].BaseBalance))
+}
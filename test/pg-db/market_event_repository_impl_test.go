@@ -0,0 +1,33 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (s *PgDbTestSuite) TestInsertAndGetMarketEvents() {
+	ctx := context.Background()
+	// market ids are not in fixtures, so that no other event is returned
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.MarketEvent{
+		{MarketID: 1001, Type: domain.MarketEventDiscovered, Time: start},
+		{MarketID: 1002, Type: domain.MarketEventDiscovered, Time: start.Add(time.Hour)},
+		{MarketID: 1001, Type: domain.MarketEventDeactivated, Time: start.Add(2 * time.Hour)},
+	}
+	for _, v := range events {
+		s.NoError(pgDbSvc.InsertMarketEvent(ctx, v))
+	}
+
+	stored, err := pgDbSvc.GetMarketEvents(ctx, start.Add(2*time.Hour), 1001, 1002)
+	s.NoError(err)
+	s.Equal(2, len(stored))
+	s.Equal(1001, stored[0].MarketID)
+	s.Equal(1002, stored[1].MarketID)
+
+	stored, err = pgDbSvc.GetMarketEvents(ctx, start.Add(3*time.Hour), 1001)
+	s.NoError(err)
+	s.Equal(2, len(stored))
+	s.Equal(domain.MarketEventDeactivated, stored[1].Type)
+}
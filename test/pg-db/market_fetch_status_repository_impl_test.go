@@ -0,0 +1,44 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (s *PgDbTestSuite) TestUpsertAndGetFetchStatus() {
+	ctx := context.Background()
+	// market ids are not in fixtures, so that no other status is returned
+	status, err := pgDbSvc.GetFetchStatus(ctx, 1001, domain.FetchJobPrice)
+	s.NoError(err)
+	s.Equal(1001, status.MarketID)
+	s.Equal(0, status.ConsecutiveFailures)
+
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	status.RecordFailure(domain.FetchErrorTLS, "unavailable", now)
+	s.NoError(pgDbSvc.UpsertFetchStatus(ctx, *status))
+	status.RecordFailure(domain.FetchErrorOther, "timeout", now.Add(time.Minute))
+	s.NoError(pgDbSvc.UpsertFetchStatus(ctx, *status))
+	s.NoError(pgDbSvc.UpsertFetchStatus(ctx, domain.MarketFetchStatus{
+		MarketID:    1002,
+		Job:         domain.FetchJobBalance,
+		LastAttempt: now,
+		LastSuccess: now,
+		Protocol:    "v2",
+	}))
+
+	status, err = pgDbSvc.GetFetchStatus(ctx, 1001, domain.FetchJobPrice)
+	s.NoError(err)
+	s.Equal(2, status.ConsecutiveFailures)
+	s.Equal("timeout", status.LastError)
+	s.Equal(domain.FetchErrorOther, status.LastErrorType)
+	s.True(now.Equal(status.FailingSince))
+	s.True(status.LastSuccess.IsZero())
+
+	statuses, err := pgDbSvc.GetFetchStatuses(ctx, 1001, 1002)
+	s.NoError(err)
+	s.Equal(2, len(statuses))
+	s.Equal(1002, statuses[1].MarketID)
+	s.Equal("v2", statuses[1].Protocol)
+}
@@ -0,0 +1,126 @@
+package pgtest
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+	"time"
+)
+
+func (s *PgDbTestSuite) TestInsertAndGetPrices() {
+	ctx := context.Background()
+	marketID := "pg-price-1"
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		if err := pgDbSvc.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimal.NewFromFloat(0.00005),
+			QuotePrice: decimal.NewFromInt(int64(20000 + i*1000)),
+			Time:       startTime.Add(time.Duration(i*30) * time.Minute),
+		}); err != nil {
+			s.FailNow(err.Error())
+		}
+	}
+
+	endTime := startTime.Add(2 * time.Hour)
+	page := domain.Page{Number: 1, Size: 10}
+
+	prices, err := pgDbSvc.GetPricesForMarkets(
+		ctx, startTime, endTime, page, "", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(4, len(prices[marketID]))
+	s.True(decimal.NewFromInt(20000).Equal(prices[marketID][0].QuotePrice))
+
+	prices, err = pgDbSvc.GetPricesForMarkets(
+		ctx, startTime, endTime, page, "1h", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(2, len(prices[marketID]))
+	s.True(decimal.NewFromInt(20500).Equal(prices[marketID][0].QuotePrice))
+	s.Equal(startTime.Add(time.Hour), prices[marketID][0].Time.UTC())
+
+	prices, err = pgDbSvc.GetPricesForMarkets(
+		ctx, startTime, endTime, domain.Page{Number: 2, Size: 1}, "1h", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(1, len(prices[marketID]))
+	s.True(decimal.NewFromInt(22500).Equal(prices[marketID][0].QuotePrice))
+
+	candles, err := pgDbSvc.GetCandlesForMarkets(
+		ctx, startTime, endTime, page, "1h", marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.Equal(2, len(candles[marketID]))
+	s.True(decimal.NewFromInt(22000).Equal(candles[marketID][1].Open))
+	s.True(decimal.NewFromInt(23000).Equal(candles[marketID][1].Close))
+
+	latest, err := pgDbSvc.GetLatestPrices(ctx, marketID)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	s.True(decimal.NewFromInt(23000).Equal(latest[marketID].QuotePrice))
+}
+
+func (s *PgDbTestSuite) TestCalculateVWAP() {
+	ctx := context.Background()
+	marketID := "pg-vwap-1"
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		tm := startTime.Add(time.Duration(i) * time.Hour)
+		if err := pgDbSvc.InsertPrice(ctx, domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimal.NewFromFloat(0.00005),
+			QuotePrice: decimal.NewFromInt(int64(20000 + i*10000)),
+			Time:       tm,
+		}); err != nil {
+			s.FailNow(err.Error())
+		}
+		if err := pgDbSvc.InsertBalance(ctx, domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  decimal.NewFromInt(int64(100 + i*200)),
+			QuoteBalance: decimal.NewFromInt(1000),
+			Time:         tm,
+		}); err != nil {
+			s.FailNow(err.Error())
+		}
+	}
+
+	// rows of a snapshot are paired by snapshot id, not averaged by window
+	snapshotTime := startTime.Add(30 * time.Minute)
+	if err := pgDbSvc.InsertPrice(ctx, domain.MarketPrice{
+		MarketID:   marketID,
+		BasePrice:  decimal.NewFromFloat(0.000025),
+		QuotePrice: decimal.NewFromInt(40000),
+		Time:       snapshotTime,
+		SnapshotID: "pg-vwap-snapshot",
+	}); err != nil {
+		s.FailNow(err.Error())
+	}
+	if err := pgDbSvc.InsertBalance(ctx, domain.MarketBalance{
+		MarketID:     marketID,
+		BaseBalance:  decimal.NewFromInt(100),
+		QuoteBalance: decimal.NewFromInt(1000),
+		Time:         snapshotTime,
+		SnapshotID:   "pg-vwap-snapshot",
+	}); err != nil {
+		s.FailNow(err.Error())
+	}
+
+	vwap, err := pgDbSvc.CalculateVWAP(
+		ctx, "1h", startTime, startTime.Add(2*time.Hour), marketID,
+	)
+	if err != nil {
+		s.FailNow(err.Error())
+	}
+	// (20000*100 + 30000*300 + 40000*100) / 500
+	s.True(decimal.NewFromInt(30000).Equal(vwap), vwap.String())
+}
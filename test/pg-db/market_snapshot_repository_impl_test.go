@@ -0,0 +1,41 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (s *PgDbTestSuite) TestInsertSnapshot() {
+	ctx := context.Background()
+	marketID := "pg-snapshot-1"
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot, err := domain.NewMarketSnapshot(
+		domain.MarketPrice{
+			MarketID:   marketID,
+			BasePrice:  decimal.NewFromFloat(0.00005),
+			QuotePrice: decimal.NewFromInt(20000),
+		},
+		domain.MarketBalance{
+			MarketID:     marketID,
+			BaseBalance:  decimal.NewFromInt(100),
+			QuoteBalance: decimal.NewFromInt(2000000),
+		},
+		now,
+	)
+	s.NoError(err)
+	s.NoError(pgDbSvc.InsertSnapshot(ctx, *snapshot))
+
+	prices, err := pgDbSvc.GetLatestPrices(ctx, marketID)
+	s.NoError(err)
+	balances, err := pgDbSvc.GetLatestBalances(ctx, marketID)
+	s.NoError(err)
+
+	price, balance := prices[marketID], balances[marketID]
+	s.Equal(snapshot.ID, price.SnapshotID)
+	s.Equal(snapshot.ID, balance.SnapshotID)
+	s.True(now.Equal(price.Time))
+	s.True(now.Equal(balance.Time))
+}
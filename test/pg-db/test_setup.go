@@ -15,15 +15,8 @@ const (
 )
 
 var (
-	pgDbSvc dbpg.Service
-)
-
-type PgDbTestSuite struct {
-	suite.Suite
-}
-
-func (s *PgDbTestSuite) SetupSuite() {
-	svc, err := dbpg.New(dbpg.DbConfig{
+	pgDbSvc  dbpg.Service
+	dbConfig = dbpg.DbConfig{
 		DbUser:     "root",
 		DbPassword: "secret",
 		DbHost:     "127.0.0.1",
@@ -32,7 +25,15 @@ func (s *PgDbTestSuite) SetupSuite() {
 		MigrationSourceURL: "file://../.." +
 			"/internal/infrastructure/db/pg/migrations",
 		DbInsecure: true,
-	})
+	}
+)
+
+type PgDbTestSuite struct {
+	suite.Suite
+}
+
+func (s *PgDbTestSuite) SetupSuite() {
+	svc, err := dbpg.New(dbConfig)
 	if err != nil {
 		s.FailNow(err.Error())
 	}
@@ -0,0 +1,47 @@
+package pgtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdex-network/tdex-analytics/internal/core/domain"
+)
+
+func (s *PgDbTestSuite) TestHeartbeatAndGetWorkers() {
+	ctx := context.Background()
+	// heartbeats are in the future, so that workers of other tests are not
+	//returned
+	now := time.Now().Add(time.Hour).Truncate(time.Second)
+	s.NoError(pgDbSvc.Heartbeat(ctx, domain.Worker{
+		NodeID: "pg-worker-2", StartedAt: now, HeartbeatAt: now,
+	}))
+	s.NoError(pgDbSvc.Heartbeat(ctx, domain.Worker{
+		NodeID: "pg-worker-1", StartedAt: now, HeartbeatAt: now.Add(-time.Minute),
+	}))
+
+	workers, err := pgDbSvc.GetWorkers(ctx, now.Add(-time.Second))
+	s.NoError(err)
+	s.Equal(1, len(workers))
+	s.Equal("pg-worker-2", workers[0].NodeID)
+
+	s.NoError(pgDbSvc.Heartbeat(ctx, domain.Worker{
+		NodeID: "pg-worker-1", StartedAt: now, HeartbeatAt: now,
+		CircuitBreakers: map[string]domain.CircuitBreaker{
+			"provider1": {State: "open", ConsecutiveFailures: 5, RetryAt: now},
+		},
+	}))
+	workers, err = pgDbSvc.GetWorkers(ctx, now.Add(-time.Second))
+	s.NoError(err)
+	s.Equal(2, len(workers))
+	s.Equal("pg-worker-1", workers[0].NodeID)
+	s.True(now.Equal(workers[0].HeartbeatAt))
+	s.Equal(5, workers[0].CircuitBreakers["provider1"].ConsecutiveFailures)
+	s.True(now.Equal(workers[0].CircuitBreakers["provider1"].RetryAt))
+	s.Empty(workers[1].CircuitBreakers)
+
+	s.NoError(pgDbSvc.DeleteWorker(ctx, "pg-worker-1"))
+	s.NoError(pgDbSvc.DeleteWorker(ctx, "pg-worker-2"))
+	workers, err = pgDbSvc.GetWorkers(ctx, now.Add(-time.Second))
+	s.NoError(err)
+	s.Equal(0, len(workers))
+}